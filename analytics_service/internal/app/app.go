@@ -9,6 +9,8 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	// The alpine image has no timezone database, time series are bucketed in any timezone
+	_ "time/tzdata"
 
 	"analytics_service/internal/config"
 	"analytics_service/internal/converter"
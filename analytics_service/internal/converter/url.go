@@ -14,10 +14,13 @@ func NewTopURLConverter() TopURLConverter {
 
 func (c *TopURLConverter) MapDomainToPb(d domain.TopURLData) *analytics.TopUrlData {
 	return &analytics.TopUrlData{
-		LongUrl:     d.LongURL,
-		ShortUrl:    d.ShortURL,
-		FollowCount: d.FollowCount,
-		CreateCount: d.CreateCount,
+		LongUrl:          d.LongURL,
+		ShortUrl:         d.ShortURL,
+		Domain:           d.Domain,
+		FollowCount:      d.FollowCount,
+		CreateCount:      d.CreateCount,
+		HumanFollowCount: d.HumanFollowCount,
+		BotFollowCount:   d.BotFollowCount,
 	}
 }
 
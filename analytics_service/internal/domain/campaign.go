@@ -0,0 +1,7 @@
+package domain
+
+type CampaignStats struct {
+	CampaignID  string
+	FollowCount int64
+	CreateCount int64
+}
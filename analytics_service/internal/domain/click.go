@@ -0,0 +1,17 @@
+package domain
+
+// ClickDimension is a user agent class clicks are grouped by, its value is the url_clicks column
+type ClickDimension string
+
+const (
+	ClickDimensionDeviceType    ClickDimension = "device_type"
+	ClickDimensionOSFamily      ClickDimension = "os_family"
+	ClickDimensionBrowserFamily ClickDimension = "browser_family"
+	ClickDimensionBot           ClickDimension = "is_bot"
+)
+
+// ClickBreakdownRow is the number of clicks with the same values of the requested dimensions
+type ClickBreakdownRow struct {
+	Dimensions map[ClickDimension]string
+	Clicks     int64
+}
@@ -0,0 +1,13 @@
+package domain
+
+// ErasedURL is a link to erase, the same short url on another domain is another link
+type ErasedURL struct {
+	Domain   string
+	ShortURL string
+}
+
+// ErasedRows is the number of rows an erasure deleted from a table
+type ErasedRows struct {
+	Table string
+	Rows  int64
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// TimeSeriesGranularity is the length of a time series bucket
+type TimeSeriesGranularity int
+
+const (
+	GranularityMinute TimeSeriesGranularity = iota + 1
+	GranularityHour
+	GranularityDay
+	// GranularityWeek buckets start on Monday
+	GranularityWeek
+)
+
+// TimeSeriesQuery asks for the buckets of a link that start within [From, To).
+// Hours, days and weeks start in Location
+type TimeSeriesQuery struct {
+	ShortURL    string
+	Domain      string
+	Granularity TimeSeriesGranularity
+	From        time.Time
+	To          time.Time
+	Location    *time.Location
+}
+
+type TimeSeriesPoint struct {
+	Start       time.Time
+	FollowCount int64
+	CreateCount int64
+}
@@ -1,8 +1,33 @@
 package domain
 
+import "time"
+
 type TopURLData struct {
-	LongURL     string
-	ShortURL    string
-	FollowCount int64
-	CreateCount int64
+	LongURL  string
+	ShortURL string
+	// Domain is the key of the domain ShortURL is on, empty for the default one
+	Domain           string
+	FollowCount      int64
+	CreateCount      int64
+	HumanFollowCount int64
+	BotFollowCount   int64
+}
+
+// TopURLsRank is the follow count top urls are ranked by
+type TopURLsRank int
+
+const (
+	RankByAllFollows TopURLsRank = iota
+	// RankByHumanFollows leaves out the follows the gateway took for bots
+	RankByHumanFollows
+)
+
+// TopURLsWindow limits top urls to the events within [From, To), the zero window is all time
+type TopURLsWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+func (w TopURLsWindow) AllTime() bool {
+	return w.From.IsZero() && w.To.IsZero()
 }
@@ -0,0 +1,8 @@
+package errs
+
+import "errors"
+
+var (
+	ErrInvalidTimeRange = errors.New("time range must start before it ends")
+	ErrTooManyBuckets   = errors.New("time range has too many buckets for the granularity")
+)
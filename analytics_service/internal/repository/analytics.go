@@ -8,5 +8,19 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name AnalyticsRepo
 type AnalyticsRepo interface {
-	GetTopUrls(ctx context.Context, paginationParams domain.PaginationParams) ([]domain.TopURLData, error)
+	GetTopUrls(
+		ctx context.Context,
+		paginationParams domain.PaginationParams,
+		rank domain.TopURLsRank,
+		window domain.TopURLsWindow,
+	) ([]domain.TopURLData, error)
+	GetCampaignStats(ctx context.Context, campaignID string) (domain.CampaignStats, error)
+	EraseURLs(ctx context.Context, erasedURLs []domain.ErasedURL) ([]domain.ErasedRows, error)
+	GetClickBreakdown(
+		ctx context.Context,
+		shortURL string,
+		urlDomain string,
+		dimensions []domain.ClickDimension,
+	) ([]domain.ClickBreakdownRow, error)
+	GetURLTimeSeries(ctx context.Context, query domain.TimeSeriesQuery) ([]domain.TimeSeriesPoint, error)
 }
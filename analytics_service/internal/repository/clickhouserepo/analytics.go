@@ -2,10 +2,14 @@ package clickhouserepo
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"analytics_service/internal/domain"
 	"analytics_service/internal/repository"
+	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 )
 
@@ -45,18 +49,61 @@ func NewAnalyticsRepoClickhouse(
 	}, nil
 }
 
-const getTopUrlsQuery = `select long_url, short_url, follow_count, create_count from url_events_counter FINAL 
-ORDER BY (follow_count, create_count) DESC 
+const getTopUrlsQuery = `select long_url, short_url, domain, follow_count, create_count, human_follow_count, bot_follow_count 
+from url_events_counter FINAL 
+ORDER BY %s 
 LIMIT $1
 OFFSET $2;`
 
+// topURLsOrder ends with the key of a row, so the links with equal counts keep their place between pages
+var topURLsOrder = map[domain.TopURLsRank]string{
+	domain.RankByAllFollows:   "(follow_count, create_count) DESC, long_url, short_url, domain",
+	domain.RankByHumanFollows: "(human_follow_count, follow_count, create_count) DESC, long_url, short_url, domain",
+}
+
+const getWindowTopUrlsQuery = `select long_url, short_url, domain, sum(follow_count) as follows, sum(create_count) as creates,
+sum(human_follow_count) as human_follows, sum(bot_follow_count) as bot_follows
+from %s
+WHERE bucket >= $1 AND bucket < $2
+GROUP BY long_url, short_url, domain
+ORDER BY %s
+LIMIT $3
+OFFSET $4;`
+
+// windowTopURLsOrder ranks by the sums, their aliases differ from the columns so the sums are not summed again.
+// Ties are broken by the group key like in topURLsOrder
+var windowTopURLsOrder = map[domain.TopURLsRank]string{
+	domain.RankByAllFollows:   "(follows, creates) DESC, long_url, short_url, domain",
+	domain.RankByHumanFollows: "(human_follows, follows, creates) DESC, long_url, short_url, domain",
+}
+
+// GetTopUrls ranks the links by their totals, or by their events within the window when it is not all time
 func (r *analyticsRepoClickhouse) GetTopUrls(
 	ctx context.Context,
 	paginationParams domain.PaginationParams,
+	rank domain.TopURLsRank,
+	window domain.TopURLsWindow,
 ) ([]domain.TopURLData, error) {
+	orders := topURLsOrder
+	if !window.AllTime() {
+		orders = windowTopURLsOrder
+	}
+	order, ok := orders[rank]
+	if !ok {
+		return nil, fmt.Errorf("unknown top urls rank: %d", rank)
+	}
 	offset := paginationParams.Limit * (paginationParams.Page - 1)
 
-	rows, err := r.conn.Query(ctx, getTopUrlsQuery, paginationParams.Limit, offset)
+	var rows driver.Rows
+	var err error
+	if window.AllTime() {
+		rows, err = r.conn.Query(ctx, fmt.Sprintf(getTopUrlsQuery, order), paginationParams.Limit, offset)
+	} else {
+		table, from := topURLsWindowTable(window)
+		rows, err = r.conn.Query(
+			ctx, fmt.Sprintf(getWindowTopUrlsQuery, table, order), from, window.To.UTC(), paginationParams.Limit, offset,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +118,10 @@ func (r *analyticsRepoClickhouse) GetTopUrls(
 	topURLs := make([]domain.TopURLData, 0)
 	for rows.Next() {
 		var urlData domain.TopURLData
-		err = rows.Scan(&urlData.LongURL, &urlData.ShortURL, &urlData.FollowCount, &urlData.CreateCount)
+		err = rows.Scan(
+			&urlData.LongURL, &urlData.ShortURL, &urlData.Domain, &urlData.FollowCount, &urlData.CreateCount,
+			&urlData.HumanFollowCount, &urlData.BotFollowCount,
+		)
 		if err != nil {
 			r.logger.Error(err.Error())
 			continue
@@ -82,3 +132,227 @@ func (r *analyticsRepoClickhouse) GetTopUrls(
 
 	return topURLs, nil
 }
+
+const getCampaignStatsQuery = `select sum(follow_count), sum(create_count) from campaign_events_counter 
+WHERE campaign_id = $1;`
+
+func (r *analyticsRepoClickhouse) GetCampaignStats(ctx context.Context, campaignID string) (domain.CampaignStats, error) {
+	stats := domain.CampaignStats{CampaignID: campaignID}
+
+	row := r.conn.QueryRow(ctx, getCampaignStatsQuery, campaignID)
+	err := row.Scan(&stats.FollowCount, &stats.CreateCount)
+	if err != nil {
+		return domain.CampaignStats{}, err
+	}
+
+	return stats, nil
+}
+
+// erasableTables keep rows per link. campaign_events_counter only has totals per campaign, so it is not erased
+var erasableTables = []string{
+	"url_clicks",
+	"url_events_counter",
+	"url_preview_counter",
+	"url_events_raw",
+	"url_events_minutely",
+	"url_events_hourly",
+	"url_top_minutely",
+	"url_top_hourly",
+	"url_top_events",
+}
+
+// eraseURLsCondition matches the rows of the links, $1 and $2 are their domains and short urls
+const eraseURLsCondition = "has(arrayZip($1, $2), (domain, short_url))"
+
+// EraseURLs deletes every row of the links with a mutation per table and waits for the mutations to finish,
+// so the rows are gone once it returns. Rows are counted before the delete, they are not merged yet
+func (r *analyticsRepoClickhouse) EraseURLs(ctx context.Context, erasedURLs []domain.ErasedURL) ([]domain.ErasedRows, error) {
+	ctx = clickhouse.Context(ctx, clickhouse.WithSettings(clickhouse.Settings{
+		"mutations_sync": 2,
+	}))
+
+	domains := make([]string, len(erasedURLs))
+	shortURLs := make([]string, len(erasedURLs))
+	for i, erasedURL := range erasedURLs {
+		domains[i] = erasedURL.Domain
+		shortURLs[i] = erasedURL.ShortURL
+	}
+
+	erasedRows := make([]domain.ErasedRows, 0, len(erasableTables))
+	for _, table := range erasableTables {
+		var rows uint64
+		row := r.conn.QueryRow(ctx, fmt.Sprintf("SELECT count() FROM %s WHERE %s", table, eraseURLsCondition), domains, shortURLs)
+		err := row.Scan(&rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if rows > 0 {
+			err = r.conn.Exec(ctx, fmt.Sprintf("ALTER TABLE %s DELETE WHERE %s", table, eraseURLsCondition), domains, shortURLs)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		erasedRows = append(erasedRows, domain.ErasedRows{Table: table, Rows: int64(rows)})
+	}
+
+	return erasedRows, nil
+}
+
+// clickDimensionColumns are the url_clicks expressions of the dimensions, is_bot is grouped as "true" or "false"
+var clickDimensionColumns = map[domain.ClickDimension]string{
+	domain.ClickDimensionDeviceType:    "device_type",
+	domain.ClickDimensionOSFamily:      "os_family",
+	domain.ClickDimensionBrowserFamily: "browser_family",
+	domain.ClickDimensionBot:           "toString(is_bot)",
+}
+
+// GetClickBreakdown counts the clicks of the link on urlDomain, or of every link when shortURL is empty,
+// grouped by the dimensions. The largest groups go first
+func (r *analyticsRepoClickhouse) GetClickBreakdown(
+	ctx context.Context,
+	shortURL string,
+	urlDomain string,
+	dimensions []domain.ClickDimension,
+) ([]domain.ClickBreakdownRow, error) {
+	columns := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		column, ok := clickDimensionColumns[dimension]
+		if !ok {
+			return nil, fmt.Errorf("unknown click dimension: %s", dimension)
+		}
+		columns[i] = column
+	}
+	groupBy := strings.Join(columns, ", ")
+
+	query := fmt.Sprintf(`SELECT %s, count() AS clicks FROM url_clicks FINAL
+WHERE $1 = '' OR (short_url = $1 AND domain = $2)
+GROUP BY %s
+ORDER BY clicks DESC, %s;`, groupBy, groupBy, groupBy)
+
+	rows, err := r.conn.Query(ctx, query, shortURL, urlDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			r.logger.Error(err.Error())
+		}
+	}()
+
+	breakdown := make([]domain.ClickBreakdownRow, 0)
+	for rows.Next() {
+		values := make([]string, len(dimensions))
+		var clicks uint64
+
+		dest := make([]any, 0, len(dimensions)+1)
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		dest = append(dest, &clicks)
+
+		err = rows.Scan(dest...)
+		if err != nil {
+			r.logger.Error(err.Error())
+			continue
+		}
+
+		row := domain.ClickBreakdownRow{
+			Dimensions: make(map[domain.ClickDimension]string, len(dimensions)),
+			Clicks:     int64(clicks),
+		}
+		for i, dimension := range dimensions {
+			row.Dimensions[dimension] = values[i]
+		}
+		breakdown = append(breakdown, row)
+	}
+
+	return breakdown, rows.Err()
+}
+
+const getURLTimeSeriesQuery = `SELECT %s AS bucket_start, sum(follow_count), sum(create_count) FROM %s
+WHERE short_url = $1 AND domain = $2 AND bucket >= $3 AND bucket < $4
+GROUP BY bucket_start
+ORDER BY bucket_start;`
+
+// GetURLTimeSeries sums the stored buckets into the buckets of the query, only the buckets with events are returned.
+// Hourly buckets are summed when the timezone is a whole number of hours off UTC over the range
+func (r *analyticsRepoClickhouse) GetURLTimeSeries(
+	ctx context.Context,
+	query domain.TimeSeriesQuery,
+) ([]domain.TimeSeriesPoint, error) {
+	// The location was loaded by its name, so the name is a known timezone and is safe to put into the query
+	timezone := query.Location.String()
+
+	var bucketStart string
+	switch query.Granularity {
+	case domain.GranularityMinute:
+		bucketStart = "bucket"
+	case domain.GranularityHour:
+		bucketStart = fmt.Sprintf("toStartOfHour(bucket, '%s')", timezone)
+	case domain.GranularityDay:
+		bucketStart = fmt.Sprintf("toStartOfDay(bucket, '%s')", timezone)
+	case domain.GranularityWeek:
+		bucketStart = fmt.Sprintf("toDateTime(toMonday(bucket, '%s'), '%s')", timezone, timezone)
+	default:
+		return nil, fmt.Errorf("unknown time series granularity: %d", query.Granularity)
+	}
+
+	table := "url_events_minutely"
+	if query.Granularity != domain.GranularityMinute &&
+		wholeHoursOffUTC(query.From, query.Location) && wholeHoursOffUTC(query.To, query.Location) {
+		table = "url_events_hourly"
+	}
+
+	rows, err := r.conn.Query(
+		ctx, fmt.Sprintf(getURLTimeSeriesQuery, bucketStart, table), query.ShortURL, query.Domain,
+		query.From.UTC(), query.To.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err := rows.Close()
+		if err != nil {
+			r.logger.Error(err.Error())
+		}
+	}()
+
+	points := make([]domain.TimeSeriesPoint, 0)
+	for rows.Next() {
+		var point domain.TimeSeriesPoint
+		err = rows.Scan(&point.Start, &point.FollowCount, &point.CreateCount)
+		if err != nil {
+			r.logger.Error(err.Error())
+			continue
+		}
+
+		points = append(points, point)
+	}
+
+	return points, rows.Err()
+}
+
+func wholeHoursOffUTC(t time.Time, loc *time.Location) bool {
+	_, offset := t.In(loc).Zone()
+	return offset%3600 == 0
+}
+
+// topURLsMinutelyRetention is the TTL of url_top_minutely
+const topURLsMinutelyRetention = 3 * 24 * time.Hour
+
+// topURLsWindowTable picks the buckets a window is summed from and the bucket the window starts in.
+// Windows of up to a day within the retention are summed from minutes, the longer ones from hours,
+// so a window that does not start on the hour counts the whole first hour
+func topURLsWindowTable(window domain.TopURLsWindow) (string, time.Time) {
+	from := window.From.UTC()
+	if window.To.Sub(window.From) <= 24*time.Hour && time.Since(window.From) < topURLsMinutelyRetention {
+		return "url_top_minutely", from.Truncate(time.Minute)
+	}
+
+	return "url_top_hourly", from.Truncate(time.Hour)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"analytics_service/internal/domain"
 	"analytics_service/internal/repository"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 )
@@ -30,3 +31,18 @@ func (r *paginationRepoClickhouse) GetRecordsCount(table string) (int, error) {
 
 	return int(recordsCount), nil
 }
+
+// GetTopURLsWindowCount counts the links with events within the window, from the buckets GetTopUrls sums
+func (r *paginationRepoClickhouse) GetTopURLsWindowCount(window domain.TopURLsWindow) (int, error) {
+	table, from := topURLsWindowTable(window)
+	sqlTableQuery := fmt.Sprintf("SELECT uniqExact(long_url, short_url, domain) FROM %s WHERE bucket >= $1 AND bucket < $2", table)
+	row := r.conn.QueryRow(context.Background(), sqlTableQuery, from, window.To.UTC())
+
+	var recordsCount uint64
+	err := row.Scan(&recordsCount)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(recordsCount), nil
+}
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	domain "analytics_service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // PaginationRepo is an autogenerated mock type for the PaginationRepo type
 type PaginationRepo struct {
@@ -37,6 +41,34 @@ func (_m *PaginationRepo) GetRecordsCount(table string) (int, error) {
 	return r0, r1
 }
 
+// GetTopURLsWindowCount provides a mock function with given fields: window
+func (_m *PaginationRepo) GetTopURLsWindowCount(window domain.TopURLsWindow) (int, error) {
+	ret := _m.Called(window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopURLsWindowCount")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TopURLsWindow) (int, error)); ok {
+		return rf(window)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TopURLsWindow) int); ok {
+		r0 = rf(window)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TopURLsWindow) error); ok {
+		r1 = rf(window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewPaginationRepo creates a new instance of PaginationRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaginationRepo(t interface {
@@ -1,6 +1,9 @@
 package repository
 
+import "analytics_service/internal/domain"
+
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name PaginationRepo
 type PaginationRepo interface {
 	GetRecordsCount(table string) (int, error)
+	GetTopURLsWindowCount(window domain.TopURLsWindow) (int, error)
 }
@@ -4,12 +4,27 @@ import (
 	"context"
 
 	"analytics_service/internal/domain"
+	"analytics_service/internal/errs"
 	"analytics_service/internal/repository"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name AnalyticsService
 type AnalyticsService interface {
-	GetTopUrls(ctx context.Context, paginationParams domain.PaginationParams) ([]domain.TopURLData, error)
+	GetTopUrls(
+		ctx context.Context,
+		paginationParams domain.PaginationParams,
+		rank domain.TopURLsRank,
+		window domain.TopURLsWindow,
+	) ([]domain.TopURLData, error)
+	GetCampaignStats(ctx context.Context, campaignID string) (domain.CampaignStats, error)
+	EraseURLs(ctx context.Context, erasedURLs []domain.ErasedURL) ([]domain.ErasedRows, error)
+	GetClickBreakdown(
+		ctx context.Context,
+		shortURL string,
+		urlDomain string,
+		dimensions []domain.ClickDimension,
+	) ([]domain.ClickBreakdownRow, error)
+	GetURLTimeSeries(ctx context.Context, query domain.TimeSeriesQuery) ([]domain.TimeSeriesPoint, error)
 }
 
 type analyticsService struct {
@@ -24,6 +39,56 @@ func NewAnalyticsService(
 	}
 }
 
-func (s *analyticsService) GetTopUrls(ctx context.Context, paginationParams domain.PaginationParams) ([]domain.TopURLData, error) {
-	return s.analyticsRepo.GetTopUrls(ctx, paginationParams)
+func (s *analyticsService) GetTopUrls(
+	ctx context.Context,
+	paginationParams domain.PaginationParams,
+	rank domain.TopURLsRank,
+	window domain.TopURLsWindow,
+) ([]domain.TopURLData, error) {
+	if !window.AllTime() && !window.From.Before(window.To) {
+		return nil, errs.ErrInvalidTimeRange
+	}
+
+	return s.analyticsRepo.GetTopUrls(ctx, paginationParams, rank, window)
+}
+
+func (s *analyticsService) GetCampaignStats(ctx context.Context, campaignID string) (domain.CampaignStats, error) {
+	return s.analyticsRepo.GetCampaignStats(ctx, campaignID)
+}
+
+func (s *analyticsService) EraseURLs(ctx context.Context, erasedURLs []domain.ErasedURL) ([]domain.ErasedRows, error) {
+	return s.analyticsRepo.EraseURLs(ctx, erasedURLs)
+}
+
+func (s *analyticsService) GetClickBreakdown(
+	ctx context.Context,
+	shortURL string,
+	urlDomain string,
+	dimensions []domain.ClickDimension,
+) ([]domain.ClickBreakdownRow, error) {
+	return s.analyticsRepo.GetClickBreakdown(ctx, shortURL, urlDomain, dimensions)
+}
+
+// GetURLTimeSeries returns every bucket of the range, the ones without events are zero
+func (s *analyticsService) GetURLTimeSeries(
+	ctx context.Context,
+	query domain.TimeSeriesQuery,
+) ([]domain.TimeSeriesPoint, error) {
+	if !query.From.Before(query.To) {
+		return nil, errs.ErrInvalidTimeRange
+	}
+
+	starts, ok := bucketStarts(query)
+	if !ok {
+		return nil, errs.ErrTooManyBuckets
+	}
+
+	// The first bucket is counted whole even when the range starts within it
+	query.From = starts[0]
+	points, err := s.analyticsRepo.GetURLTimeSeries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillTimeSeries(starts, points), nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"analytics_service/internal/domain"
+	"analytics_service/internal/errs"
 	"analytics_service/internal/repository"
 	"analytics_service/internal/repository/mocks"
 	"github.com/stretchr/testify/assert"
@@ -16,12 +18,17 @@ func TestGetTopUrls(t *testing.T) {
 	testTopUrlData := []domain.TopURLData{
 		{LongURL: "http://test.long", ShortURL: "test", FollowCount: 10, CreateCount: 2},
 	}
+	testWindow := domain.TopURLsWindow{
+		From: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+	}
 	errTest := errors.New("test error")
 
 	testCases := []struct {
 		name               string
 		buildAnalyticsRepo func() repository.AnalyticsRepo
 		paginationParams   domain.PaginationParams
+		window             domain.TopURLsWindow
 		expectedUrlData    []domain.TopURLData
 		expectedErr        error
 	}{
@@ -29,7 +36,7 @@ func TestGetTopUrls(t *testing.T) {
 			name: "get top urls without error",
 			buildAnalyticsRepo: func() repository.AnalyticsRepo {
 				mockRepo := mocks.NewAnalyticsRepo(t)
-				mockRepo.On("GetTopUrls", mock.Anything, mock.Anything).
+				mockRepo.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(testTopUrlData, nil)
 
 				return mockRepo
@@ -38,11 +45,35 @@ func TestGetTopUrls(t *testing.T) {
 			expectedUrlData:  testTopUrlData,
 			expectedErr:      nil,
 		},
+		{
+			name: "get top urls of a window",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, testWindow).
+					Return(testTopUrlData, nil)
+
+				return mockRepo
+			},
+			paginationParams: domain.PaginationParams{Page: 1, Limit: 10},
+			window:           testWindow,
+			expectedUrlData:  testTopUrlData,
+			expectedErr:      nil,
+		},
+		{
+			name: "window that ends before it starts",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				return mocks.NewAnalyticsRepo(t)
+			},
+			paginationParams: domain.PaginationParams{Page: 1, Limit: 10},
+			window:           domain.TopURLsWindow{From: testWindow.To, To: testWindow.From},
+			expectedUrlData:  nil,
+			expectedErr:      errs.ErrInvalidTimeRange,
+		},
 		{
 			name: "get top urls error occurred",
 			buildAnalyticsRepo: func() repository.AnalyticsRepo {
 				mockRepo := mocks.NewAnalyticsRepo(t)
-				mockRepo.On("GetTopUrls", mock.Anything, mock.Anything).
+				mockRepo.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil, errTest)
 
 				return mockRepo
@@ -57,9 +88,56 @@ func TestGetTopUrls(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			analyticsService := NewAnalyticsService(tc.buildAnalyticsRepo())
 
-			urlData, err := analyticsService.GetTopUrls(context.Background(), tc.paginationParams)
+			urlData, err := analyticsService.GetTopUrls(context.Background(), tc.paginationParams, domain.RankByAllFollows, tc.window)
 			assert.Equal(t, tc.expectedUrlData, urlData)
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
 }
+
+func TestGetCampaignStats(t *testing.T) {
+	testCampaignStats := domain.CampaignStats{CampaignID: "spring", FollowCount: 10, CreateCount: 2}
+	errTest := errors.New("test error")
+
+	testCases := []struct {
+		name               string
+		buildAnalyticsRepo func() repository.AnalyticsRepo
+		expectedStats      domain.CampaignStats
+		expectedErr        error
+	}{
+		{
+			name: "get campaign stats without error",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetCampaignStats", mock.Anything, "spring").
+					Return(testCampaignStats, nil)
+
+				return mockRepo
+			},
+			expectedStats: testCampaignStats,
+			expectedErr:   nil,
+		},
+		{
+			name: "get campaign stats error occurred",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetCampaignStats", mock.Anything, "spring").
+					Return(domain.CampaignStats{}, errTest)
+
+				return mockRepo
+			},
+			expectedStats: domain.CampaignStats{},
+			expectedErr:   errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			analyticsService := NewAnalyticsService(tc.buildAnalyticsRepo())
+
+			stats, err := analyticsService.GetCampaignStats(context.Background(), "spring")
+			assert.Equal(t, tc.expectedStats, stats)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
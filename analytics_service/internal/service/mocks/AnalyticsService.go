@@ -14,9 +14,97 @@ type AnalyticsService struct {
 	mock.Mock
 }
 
-// GetTopUrls provides a mock function with given fields: ctx, paginationParams
-func (_m *AnalyticsService) GetTopUrls(ctx context.Context, paginationParams domain.PaginationParams) ([]domain.TopURLData, error) {
-	ret := _m.Called(ctx, paginationParams)
+// EraseURLs provides a mock function with given fields: ctx, erasedURLs
+func (_m *AnalyticsService) EraseURLs(ctx context.Context, erasedURLs []domain.ErasedURL) ([]domain.ErasedRows, error) {
+	ret := _m.Called(ctx, erasedURLs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseURLs")
+	}
+
+	var r0 []domain.ErasedRows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ErasedURL) ([]domain.ErasedRows, error)); ok {
+		return rf(ctx, erasedURLs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ErasedURL) []domain.ErasedRows); ok {
+		r0 = rf(ctx, erasedURLs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ErasedRows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.ErasedURL) error); ok {
+		r1 = rf(ctx, erasedURLs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignStats provides a mock function with given fields: ctx, campaignID
+func (_m *AnalyticsService) GetCampaignStats(ctx context.Context, campaignID string) (domain.CampaignStats, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignStats")
+	}
+
+	var r0 domain.CampaignStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.CampaignStats, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.CampaignStats); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		r0 = ret.Get(0).(domain.CampaignStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClickBreakdown provides a mock function with given fields: ctx, shortURL, urlDomain, dimensions
+func (_m *AnalyticsService) GetClickBreakdown(ctx context.Context, shortURL string, urlDomain string, dimensions []domain.ClickDimension) ([]domain.ClickBreakdownRow, error) {
+	ret := _m.Called(ctx, shortURL, urlDomain, dimensions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClickBreakdown")
+	}
+
+	var r0 []domain.ClickBreakdownRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []domain.ClickDimension) ([]domain.ClickBreakdownRow, error)); ok {
+		return rf(ctx, shortURL, urlDomain, dimensions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []domain.ClickDimension) []domain.ClickBreakdownRow); ok {
+		r0 = rf(ctx, shortURL, urlDomain, dimensions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ClickBreakdownRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []domain.ClickDimension) error); ok {
+		r1 = rf(ctx, shortURL, urlDomain, dimensions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopUrls provides a mock function with given fields: ctx, paginationParams, rank, window
+func (_m *AnalyticsService) GetTopUrls(ctx context.Context, paginationParams domain.PaginationParams, rank domain.TopURLsRank, window domain.TopURLsWindow) ([]domain.TopURLData, error) {
+	ret := _m.Called(ctx, paginationParams, rank, window)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTopUrls")
@@ -24,19 +112,49 @@ func (_m *AnalyticsService) GetTopUrls(ctx context.Context, paginationParams dom
 
 	var r0 []domain.TopURLData
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationParams) ([]domain.TopURLData, error)); ok {
-		return rf(ctx, paginationParams)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationParams, domain.TopURLsRank, domain.TopURLsWindow) ([]domain.TopURLData, error)); ok {
+		return rf(ctx, paginationParams, rank, window)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationParams) []domain.TopURLData); ok {
-		r0 = rf(ctx, paginationParams)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.PaginationParams, domain.TopURLsRank, domain.TopURLsWindow) []domain.TopURLData); ok {
+		r0 = rf(ctx, paginationParams, rank, window)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.TopURLData)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationParams) error); ok {
-		r1 = rf(ctx, paginationParams)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.PaginationParams, domain.TopURLsRank, domain.TopURLsWindow) error); ok {
+		r1 = rf(ctx, paginationParams, rank, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLTimeSeries provides a mock function with given fields: ctx, query
+func (_m *AnalyticsService) GetURLTimeSeries(ctx context.Context, query domain.TimeSeriesQuery) ([]domain.TimeSeriesPoint, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLTimeSeries")
+	}
+
+	var r0 []domain.TimeSeriesPoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TimeSeriesQuery) ([]domain.TimeSeriesPoint, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.TimeSeriesQuery) []domain.TimeSeriesPoint); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TimeSeriesPoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.TimeSeriesQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
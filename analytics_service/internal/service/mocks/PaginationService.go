@@ -41,6 +41,34 @@ func (_m *PaginationService) GetPaginationInfo(table string, paginationParams do
 	return r0, r1
 }
 
+// GetTopURLsWindowPaginationInfo provides a mock function with given fields: window, paginationParams
+func (_m *PaginationService) GetTopURLsWindowPaginationInfo(window domain.TopURLsWindow, paginationParams domain.PaginationParams) (domain.Pagination, error) {
+	ret := _m.Called(window, paginationParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTopURLsWindowPaginationInfo")
+	}
+
+	var r0 domain.Pagination
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TopURLsWindow, domain.PaginationParams) (domain.Pagination, error)); ok {
+		return rf(window, paginationParams)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TopURLsWindow, domain.PaginationParams) domain.Pagination); ok {
+		r0 = rf(window, paginationParams)
+	} else {
+		r0 = ret.Get(0).(domain.Pagination)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TopURLsWindow, domain.PaginationParams) error); ok {
+		r1 = rf(window, paginationParams)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewPaginationService creates a new instance of PaginationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaginationService(t interface {
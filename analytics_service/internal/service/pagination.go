@@ -8,6 +8,10 @@ import (
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name PaginationService
 type PaginationService interface {
 	GetPaginationInfo(table string, paginationParams domain.PaginationParams) (domain.Pagination, error)
+	GetTopURLsWindowPaginationInfo(
+		window domain.TopURLsWindow,
+		paginationParams domain.PaginationParams,
+	) (domain.Pagination, error)
 }
 
 type paginationService struct {
@@ -31,6 +35,23 @@ func (s *paginationService) GetPaginationInfo(
 		return domain.Pagination{}, err
 	}
 
+	return paginate(recordsCount, paginationParams), nil
+}
+
+// GetTopURLsWindowPaginationInfo pages the links with events within the window
+func (s *paginationService) GetTopURLsWindowPaginationInfo(
+	window domain.TopURLsWindow,
+	paginationParams domain.PaginationParams,
+) (domain.Pagination, error) {
+	recordsCount, err := s.paginationRepo.GetTopURLsWindowCount(window)
+	if err != nil {
+		return domain.Pagination{}, err
+	}
+
+	return paginate(recordsCount, paginationParams), nil
+}
+
+func paginate(recordsCount int, paginationParams domain.PaginationParams) domain.Pagination {
 	var pagination domain.Pagination
 
 	// Set current/record per page data
@@ -57,5 +78,5 @@ func (s *paginationService) GetPaginationInfo(
 		pagination.Next = 0
 	}
 
-	return pagination, nil
+	return pagination
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"analytics_service/internal/domain"
 	"analytics_service/internal/repository"
@@ -168,3 +169,64 @@ func TestGetPaginationInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTopURLsWindowPaginationInfo(t *testing.T) {
+	window := domain.TopURLsWindow{
+		From: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+	}
+	errRowsCnt := errors.New("errors while getting cont")
+
+	testCases := []struct {
+		name                string
+		buildPaginationRepo func() repository.PaginationRepo
+		paginationParams    domain.PaginationParams
+		expectedPagination  domain.Pagination
+		expectedErr         error
+	}{
+		{
+			name: "25 links within the window",
+			buildPaginationRepo: func() repository.PaginationRepo {
+				mockRepo := mocks.NewPaginationRepo(t)
+
+				mockRepo.On("GetTopURLsWindowCount", window).
+					Return(25, nil)
+
+				return mockRepo
+			},
+			paginationParams: domain.PaginationParams{Page: 2, Limit: 10},
+			expectedPagination: domain.Pagination{
+				Next:          3,
+				Previous:      1,
+				RecordPerPage: 10,
+				CurrentPage:   2,
+				TotalPage:     3,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Error while counting links within the window",
+			buildPaginationRepo: func() repository.PaginationRepo {
+				mockRepo := mocks.NewPaginationRepo(t)
+
+				mockRepo.On("GetTopURLsWindowCount", window).
+					Return(0, errRowsCnt)
+
+				return mockRepo
+			},
+			paginationParams:   domain.PaginationParams{Page: 1, Limit: 10},
+			expectedPagination: domain.Pagination{},
+			expectedErr:        errRowsCnt,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paginationService := NewPaginationService(tc.buildPaginationRepo())
+
+			pagination, err := paginationService.GetTopURLsWindowPaginationInfo(window, tc.paginationParams)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedPagination, pagination)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"time"
+
+	"analytics_service/internal/domain"
+)
+
+// maxTimeSeriesBuckets bounds a response, a week of minutes or 27 years of days
+const maxTimeSeriesBuckets = 10080
+
+// bucketStart is the start of the bucket t falls into in loc
+func bucketStart(granularity domain.TimeSeriesGranularity, t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+
+	switch granularity {
+	case domain.GranularityMinute:
+		return t.Truncate(time.Minute)
+	case domain.GranularityHour:
+		// Hours are cut by the wall clock, a timezone may be off UTC by a part of an hour
+		return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second -
+			time.Duration(t.Nanosecond()))
+	case domain.GranularityDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case domain.GranularityWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, loc)
+	}
+
+	return t
+}
+
+// nextBucketStart follows a bucket start, days and weeks may be an hour shorter or longer across DST
+func nextBucketStart(granularity domain.TimeSeriesGranularity, start time.Time, loc *time.Location) time.Time {
+	switch granularity {
+	case domain.GranularityMinute:
+		return start.Add(time.Minute)
+	case domain.GranularityHour:
+		return start.Add(time.Hour)
+	case domain.GranularityDay:
+		return time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, loc)
+	case domain.GranularityWeek:
+		return time.Date(start.Year(), start.Month(), start.Day()+7, 0, 0, 0, 0, loc)
+	}
+
+	return start.Add(time.Minute)
+}
+
+// bucketStarts lists the buckets the range touches, ok is false when there are more than maxTimeSeriesBuckets
+func bucketStarts(query domain.TimeSeriesQuery) (starts []time.Time, ok bool) {
+	start := bucketStart(query.Granularity, query.From, query.Location)
+	for start.Before(query.To) {
+		if len(starts) == maxTimeSeriesBuckets {
+			return nil, false
+		}
+		starts = append(starts, start)
+		start = nextBucketStart(query.Granularity, start, query.Location)
+	}
+
+	return starts, true
+}
+
+// fillTimeSeries puts the points on the buckets and zeroes the buckets without events
+func fillTimeSeries(starts []time.Time, points []domain.TimeSeriesPoint) []domain.TimeSeriesPoint {
+	pointsByStart := make(map[int64]domain.TimeSeriesPoint, len(points))
+	for _, point := range points {
+		pointsByStart[point.Start.Unix()] = point
+	}
+
+	series := make([]domain.TimeSeriesPoint, len(starts))
+	for i, start := range starts {
+		point := pointsByStart[start.Unix()]
+		point.Start = start
+		series[i] = point
+	}
+
+	return series
+}
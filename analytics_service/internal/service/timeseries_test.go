@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"analytics_service/internal/domain"
+	"analytics_service/internal/errs"
+	"analytics_service/internal/repository"
+	"analytics_service/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetURLTimeSeries(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	assert.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	errTest := errors.New("test error")
+
+	testCases := []struct {
+		name               string
+		buildAnalyticsRepo func() repository.AnalyticsRepo
+		query              domain.TimeSeriesQuery
+		expectedPoints     []domain.TimeSeriesPoint
+		expectedErr        error
+	}{
+		{
+			name: "Gaps are filled with zeros and the first bucket is counted whole",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetURLTimeSeries", mock.Anything, mock.MatchedBy(func(query domain.TimeSeriesQuery) bool {
+					return query.From.Equal(time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC))
+				})).Return([]domain.TimeSeriesPoint{
+					{Start: time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC), FollowCount: 3, CreateCount: 1},
+					{Start: time.Date(2024, time.March, 10, 11, 0, 0, 0, time.UTC), FollowCount: 5},
+				}, nil)
+
+				return mockRepo
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityHour,
+				From:        time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC),
+				To:          time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC),
+				Location:    time.UTC,
+			},
+			expectedPoints: []domain.TimeSeriesPoint{
+				{Start: time.Date(2024, time.March, 10, 9, 0, 0, 0, time.UTC), FollowCount: 3, CreateCount: 1},
+				{Start: time.Date(2024, time.March, 10, 10, 0, 0, 0, time.UTC)},
+				{Start: time.Date(2024, time.March, 10, 11, 0, 0, 0, time.UTC), FollowCount: 5},
+			},
+		},
+		{
+			name: "Days start at midnight of the timezone",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetURLTimeSeries", mock.Anything, mock.Anything).
+					Return([]domain.TimeSeriesPoint{
+						{Start: time.Date(2024, time.March, 9, 21, 0, 0, 0, time.UTC), FollowCount: 2},
+					}, nil)
+
+				return mockRepo
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityDay,
+				From:        time.Date(2024, time.March, 9, 22, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+				Location:    moscow,
+			},
+			expectedPoints: []domain.TimeSeriesPoint{
+				{Start: time.Date(2024, time.March, 10, 0, 0, 0, 0, moscow), FollowCount: 2},
+				{Start: time.Date(2024, time.March, 11, 0, 0, 0, 0, moscow)},
+			},
+		},
+		{
+			name: "Weeks start on Monday of the timezone",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetURLTimeSeries", mock.Anything, mock.Anything).
+					Return([]domain.TimeSeriesPoint{}, nil)
+
+				return mockRepo
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityWeek,
+				From:        time.Date(2024, time.March, 10, 12, 0, 0, 0, newYork),
+				To:          time.Date(2024, time.March, 12, 0, 0, 0, 0, newYork),
+				Location:    newYork,
+			},
+			expectedPoints: []domain.TimeSeriesPoint{
+				{Start: time.Date(2024, time.March, 4, 0, 0, 0, 0, newYork)},
+				{Start: time.Date(2024, time.March, 11, 0, 0, 0, 0, newYork)},
+			},
+		},
+		{
+			name: "Range that ends before it starts",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				return mocks.NewAnalyticsRepo(t)
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityDay,
+				From:        time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+				Location:    time.UTC,
+			},
+			expectedErr: errs.ErrInvalidTimeRange,
+		},
+		{
+			name: "Too many buckets",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				return mocks.NewAnalyticsRepo(t)
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityMinute,
+				From:        time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+				Location:    time.UTC,
+			},
+			expectedErr: errs.ErrTooManyBuckets,
+		},
+		{
+			name: "Repo error",
+			buildAnalyticsRepo: func() repository.AnalyticsRepo {
+				mockRepo := mocks.NewAnalyticsRepo(t)
+				mockRepo.On("GetURLTimeSeries", mock.Anything, mock.Anything).
+					Return(nil, errTest)
+
+				return mockRepo
+			},
+			query: domain.TimeSeriesQuery{
+				ShortURL:    "short",
+				Granularity: domain.GranularityDay,
+				From:        time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+				Location:    time.UTC,
+			},
+			expectedErr: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			analyticsService := NewAnalyticsService(tc.buildAnalyticsRepo())
+
+			points, err := analyticsService.GetURLTimeSeries(context.Background(), tc.query)
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, len(tc.expectedPoints), len(points))
+			for i := range tc.expectedPoints {
+				assert.True(t, tc.expectedPoints[i].Start.Equal(points[i].Start), points[i].Start)
+				assert.Equal(t, tc.expectedPoints[i].FollowCount, points[i].FollowCount)
+				assert.Equal(t, tc.expectedPoints[i].CreateCount, points[i].CreateCount)
+			}
+		})
+	}
+}
+
+func TestBucketStartAcrossDST(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	dayBeforeDST := time.Date(2024, time.March, 9, 0, 0, 0, 0, newYork)
+	dstDay := nextBucketStart(domain.GranularityDay, dayBeforeDST, newYork)
+	dayAfterDST := nextBucketStart(domain.GranularityDay, dstDay, newYork)
+
+	assert.Equal(t, 24*time.Hour, dstDay.Sub(dayBeforeDST))
+	assert.Equal(t, 23*time.Hour, dayAfterDST.Sub(dstDay))
+	assert.Equal(t, dstDay, bucketStart(domain.GranularityDay, dstDay.Add(22*time.Hour+30*time.Minute), newYork))
+}
@@ -2,10 +2,13 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	"analytics_service/internal/converter"
 	"analytics_service/internal/domain"
+	"analytics_service/internal/errs"
 	"analytics_service/internal/service"
 	analytics "analytics_service/pkg/proto"
 	"google.golang.org/grpc/codes"
@@ -16,6 +19,33 @@ const (
 	urlEventsCounterTableName = "url_events_counter"
 )
 
+var pbTopURLsRanks = map[analytics.TopUrlsRank]domain.TopURLsRank{
+	analytics.TopUrlsRank_TOP_URLS_RANK_ALL_FOLLOWS:   domain.RankByAllFollows,
+	analytics.TopUrlsRank_TOP_URLS_RANK_HUMAN_FOLLOWS: domain.RankByHumanFollows,
+}
+
+// pbTopURLsWindows are the lengths of the windows that end now
+var pbTopURLsWindows = map[analytics.TopUrlsWindow]time.Duration{
+	analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_HOUR:  time.Hour,
+	analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_DAY:   24 * time.Hour,
+	analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_WEEK:  7 * 24 * time.Hour,
+	analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_MONTH: 30 * 24 * time.Hour,
+}
+
+var pbGranularities = map[analytics.TimeSeriesGranularity]domain.TimeSeriesGranularity{
+	analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MINUTE: domain.GranularityMinute,
+	analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR:   domain.GranularityHour,
+	analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY:    domain.GranularityDay,
+	analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK:   domain.GranularityWeek,
+}
+
+var pbClickDimensions = map[analytics.ClickDimension]domain.ClickDimension{
+	analytics.ClickDimension_CLICK_DIMENSION_DEVICE_TYPE:    domain.ClickDimensionDeviceType,
+	analytics.ClickDimension_CLICK_DIMENSION_OS_FAMILY:      domain.ClickDimensionOSFamily,
+	analytics.ClickDimension_CLICK_DIMENSION_BROWSER_FAMILY: domain.ClickDimensionBrowserFamily,
+	analytics.ClickDimension_CLICK_DIMENSION_BOT:            domain.ClickDimensionBot,
+}
+
 type AnalyticsServer struct {
 	logger              *slog.Logger
 	analyticsService    service.AnalyticsService
@@ -55,13 +85,23 @@ func (s *AnalyticsServer) GetTopUrls(
 		Limit: int(req.Limit),
 	}
 
-	topUrls, err := s.analyticsService.GetTopUrls(ctx, paginationParams)
+	window := topURLsWindow(req, time.Now())
+
+	topUrls, err := s.analyticsService.GetTopUrls(ctx, paginationParams, pbTopURLsRanks[req.RankBy], window)
+	if errors.Is(err, errs.ErrInvalidTimeRange) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	pagination, err := s.paginationService.GetPaginationInfo(urlEventsCounterTableName, paginationParams)
+	var pagination domain.Pagination
+	if window.AllTime() {
+		pagination, err = s.paginationService.GetPaginationInfo(urlEventsCounterTableName, paginationParams)
+	} else {
+		pagination, err = s.paginationService.GetTopURLsWindowPaginationInfo(window, paginationParams)
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, status.Error(codes.Internal, err.Error())
@@ -72,3 +112,157 @@ func (s *AnalyticsServer) GetTopUrls(
 		Pagination: s.paginationConverter.MapDomainToPb(pagination),
 	}, nil
 }
+
+// topURLsWindow resolves the window of the request, the last ones end at now
+func topURLsWindow(req *analytics.TopUrlsRequest, now time.Time) domain.TopURLsWindow {
+	if req.Window == analytics.TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM {
+		return domain.TopURLsWindow{
+			From: time.Unix(req.From, 0).UTC(),
+			To:   time.Unix(req.To, 0).UTC(),
+		}
+	}
+
+	length, ok := pbTopURLsWindows[req.Window]
+	if !ok {
+		return domain.TopURLsWindow{}
+	}
+
+	return domain.TopURLsWindow{From: now.Add(-length).UTC(), To: now.UTC()}
+}
+
+func (s *AnalyticsServer) GetCampaignStats(
+	ctx context.Context,
+	req *analytics.CampaignStatsRequest,
+) (*analytics.CampaignStatsResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := s.analyticsService.GetCampaignStats(ctx, req.CampaignId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &analytics.CampaignStatsResponse{
+		CampaignId:  stats.CampaignID,
+		FollowCount: stats.FollowCount,
+		CreateCount: stats.CreateCount,
+	}, nil
+}
+
+func (s *AnalyticsServer) EraseUrlAnalytics(
+	ctx context.Context,
+	req *analytics.EraseUrlAnalyticsRequest,
+) (*analytics.EraseUrlAnalyticsResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	erasedURLs := make([]domain.ErasedURL, len(req.Urls))
+	for i, pbURL := range req.Urls {
+		erasedURLs[i] = domain.ErasedURL{Domain: pbURL.Domain, ShortURL: pbURL.ShortUrl}
+	}
+
+	erasedRows, err := s.analyticsService.EraseURLs(ctx, erasedURLs)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbErasedRows := make([]*analytics.ErasedRows, len(erasedRows))
+	for i, tableRows := range erasedRows {
+		pbErasedRows[i] = &analytics.ErasedRows{
+			Table: tableRows.Table,
+			Rows:  tableRows.Rows,
+		}
+	}
+
+	return &analytics.EraseUrlAnalyticsResponse{
+		ErasedRows: pbErasedRows,
+	}, nil
+}
+
+func (s *AnalyticsServer) GetClickBreakdown(
+	ctx context.Context,
+	req *analytics.ClickBreakdownRequest,
+) (*analytics.ClickBreakdownResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dimensions := make([]domain.ClickDimension, len(req.Dimensions))
+	for i, pbDimension := range req.Dimensions {
+		dimensions[i] = pbClickDimensions[pbDimension]
+	}
+
+	breakdown, err := s.analyticsService.GetClickBreakdown(ctx, req.ShortUrl, req.Domain, dimensions)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRows := make([]*analytics.ClickBreakdownRow, len(breakdown))
+	for i, row := range breakdown {
+		pbDimensions := make(map[string]string, len(row.Dimensions))
+		for dimension, value := range row.Dimensions {
+			pbDimensions[string(dimension)] = value
+		}
+
+		pbRows[i] = &analytics.ClickBreakdownRow{
+			Dimensions: pbDimensions,
+			Clicks:     row.Clicks,
+		}
+	}
+
+	return &analytics.ClickBreakdownResponse{
+		Rows: pbRows,
+	}, nil
+}
+
+func (s *AnalyticsServer) GetUrlTimeSeries(
+	ctx context.Context,
+	req *analytics.UrlTimeSeriesRequest,
+) (*analytics.UrlTimeSeriesResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	location, err := time.LoadLocation(req.Timezone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	points, err := s.analyticsService.GetURLTimeSeries(ctx, domain.TimeSeriesQuery{
+		ShortURL:    req.ShortUrl,
+		Domain:      req.Domain,
+		Granularity: pbGranularities[req.Granularity],
+		From:        time.Unix(req.From, 0),
+		To:          time.Unix(req.To, 0),
+		Location:    location,
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidTimeRange) || errors.Is(err, errs.ErrTooManyBuckets) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbPoints := make([]*analytics.TimeSeriesPoint, len(points))
+	for i, point := range points {
+		pbPoints[i] = &analytics.TimeSeriesPoint{
+			Start:       point.Start.Unix(),
+			FollowCount: point.FollowCount,
+			CreateCount: point.CreateCount,
+		}
+	}
+
+	return &analytics.UrlTimeSeriesResponse{
+		Points: pbPoints,
+	}, nil
+}
@@ -8,9 +8,11 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"analytics_service/internal/converter"
 	"analytics_service/internal/domain"
+	"analytics_service/internal/errs"
 	"analytics_service/internal/service"
 	"analytics_service/internal/service/mocks"
 	analytics "analytics_service/pkg/proto"
@@ -84,14 +86,14 @@ func TestGetTopUrls(t *testing.T) {
 
 	testTopUrls := []domain.TopURLData{
 		{LongURL: "http://test.long1", ShortURL: "test", FollowCount: 10, CreateCount: 1},
-		{LongURL: "http://test.long2", ShortURL: "test2", FollowCount: 20, CreateCount: 2},
-		{LongURL: "http://test.long3", ShortURL: "tes3", FollowCount: 30, CreateCount: 3},
+		{LongURL: "http://test.long2", ShortURL: "test2", Domain: "sho.rt", FollowCount: 20, CreateCount: 2},
+		{LongURL: "http://test.long3", ShortURL: "tes3", FollowCount: 30, CreateCount: 3, HumanFollowCount: 25, BotFollowCount: 5},
 	}
 
 	testTopUrlsResp := []*analytics.TopUrlData{
 		{LongUrl: "http://test.long1", ShortUrl: "test", FollowCount: 10, CreateCount: 1},
-		{LongUrl: "http://test.long2", ShortUrl: "test2", FollowCount: 20, CreateCount: 2},
-		{LongUrl: "http://test.long3", ShortUrl: "tes3", FollowCount: 30, CreateCount: 3},
+		{LongUrl: "http://test.long2", ShortUrl: "test2", Domain: "sho.rt", FollowCount: 20, CreateCount: 2},
+		{LongUrl: "http://test.long3", ShortUrl: "tes3", FollowCount: 30, CreateCount: 3, HumanFollowCount: 25, BotFollowCount: 5},
 	}
 
 	testPagination := domain.Pagination{
@@ -116,7 +118,7 @@ func TestGetTopUrls(t *testing.T) {
 			name: "test get top urls without error",
 			buildAnalyticsService: func() service.AnalyticsService {
 				mockService := mocks.NewAnalyticsService(t)
-				mockService.On("GetTopUrls", mock.Anything, mock.Anything).
+				mockService.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(testTopUrls, nil)
 
 				return mockService
@@ -136,6 +138,137 @@ func TestGetTopUrls(t *testing.T) {
 			isErrExpected: false,
 			expectedCode:  codes.OK,
 		},
+		{
+			name: "test get top urls ranked by human follows",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetTopUrls", mock.Anything, testPaginationParams, domain.RankByHumanFollows, domain.TopURLsWindow{}).
+					Return(testTopUrls, nil)
+
+				return mockService
+			},
+			buildPaginationService: func() service.PaginationService {
+				mockService := mocks.NewPaginationService(t)
+				mockService.On("GetPaginationInfo", mock.Anything, testPaginationParams).
+					Return(testPagination, nil)
+
+				return mockService
+			},
+			request: &analytics.TopUrlsRequest{
+				Page: 1, Limit: 3, RankBy: analytics.TopUrlsRank_TOP_URLS_RANK_HUMAN_FOLLOWS,
+			},
+			expectedResp: &analytics.TopUrlsResponse{
+				TopUrlData: testTopUrlsResp,
+				Pagination: testPaginationResp,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "test get top urls of the last day",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetTopUrls", mock.Anything, testPaginationParams, domain.RankByAllFollows,
+					mock.MatchedBy(func(window domain.TopURLsWindow) bool {
+						return window.To.Sub(window.From) == 24*time.Hour && time.Since(window.To) < time.Minute
+					})).
+					Return(testTopUrls, nil)
+
+				return mockService
+			},
+			buildPaginationService: func() service.PaginationService {
+				mockService := mocks.NewPaginationService(t)
+				mockService.On("GetTopURLsWindowPaginationInfo", mock.Anything, testPaginationParams).
+					Return(testPagination, nil)
+
+				return mockService
+			},
+			request: &analytics.TopUrlsRequest{
+				Page: 1, Limit: 3, Window: analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_DAY,
+			},
+			expectedResp: &analytics.TopUrlsResponse{
+				TopUrlData: testTopUrlsResp,
+				Pagination: testPaginationResp,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "test get top urls of a custom window",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetTopUrls", mock.Anything, testPaginationParams, domain.RankByAllFollows, domain.TopURLsWindow{
+					From: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC),
+					To:   time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC),
+				}).
+					Return(testTopUrls, nil)
+
+				return mockService
+			},
+			buildPaginationService: func() service.PaginationService {
+				mockService := mocks.NewPaginationService(t)
+				mockService.On("GetTopURLsWindowPaginationInfo", mock.Anything, testPaginationParams).
+					Return(testPagination, nil)
+
+				return mockService
+			},
+			request: &analytics.TopUrlsRequest{
+				Page: 1, Limit: 3, Window: analytics.TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM,
+				From: time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC).Unix(),
+				To:   time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC).Unix(),
+			},
+			expectedResp: &analytics.TopUrlsResponse{
+				TopUrlData: testTopUrlsResp,
+				Pagination: testPaginationResp,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Given custom window that ends before it starts should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errs.ErrInvalidTimeRange)
+
+				return mockService
+			},
+			buildPaginationService: func() service.PaginationService {
+				return mocks.NewPaginationService(t)
+			},
+			request: &analytics.TopUrlsRequest{
+				Page: 1, Limit: 3, Window: analytics.TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM, From: 100, To: 100,
+			},
+			expectedResp:  &analytics.TopUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given unknown window should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			buildPaginationService: func() service.PaginationService {
+				return mocks.NewPaginationService(t)
+			},
+			request:       &analytics.TopUrlsRequest{Page: 1, Limit: 10, Window: 9},
+			expectedResp:  &analytics.TopUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given unknown rank should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			buildPaginationService: func() service.PaginationService {
+				return mocks.NewPaginationService(t)
+			},
+			request:       &analytics.TopUrlsRequest{Page: 1, Limit: 10, RankBy: 5},
+			expectedResp:  &analytics.TopUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
 		{
 			name: "Given empty page should return error. 3 Invalid Argument",
 			buildAnalyticsService: func() service.AnalyticsService {
@@ -170,7 +303,7 @@ func TestGetTopUrls(t *testing.T) {
 			name: "internal error when get top urls. 13 Internal",
 			buildAnalyticsService: func() service.AnalyticsService {
 				mockService := mocks.NewAnalyticsService(t)
-				mockService.On("GetTopUrls", mock.Anything, mock.Anything).
+				mockService.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil, testErr)
 
 				return mockService
@@ -188,7 +321,7 @@ func TestGetTopUrls(t *testing.T) {
 			name: "internal error when get pagination. 13 Internal",
 			buildAnalyticsService: func() service.AnalyticsService {
 				mockService := mocks.NewAnalyticsService(t)
-				mockService.On("GetTopUrls", mock.Anything, mock.Anything).
+				mockService.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(testTopUrls, nil)
 
 				return mockService
@@ -240,8 +373,11 @@ func TestGetTopUrls(t *testing.T) {
 
 				assert.Equal(t, expectedData.LongUrl, actualData.LongUrl)
 				assert.Equal(t, expectedData.ShortUrl, actualData.ShortUrl)
+				assert.Equal(t, expectedData.Domain, actualData.Domain)
 				assert.Equal(t, expectedData.FollowCount, actualData.FollowCount)
 				assert.Equal(t, expectedData.CreateCount, actualData.CreateCount)
+				assert.Equal(t, expectedData.HumanFollowCount, actualData.HumanFollowCount)
+				assert.Equal(t, expectedData.BotFollowCount, actualData.BotFollowCount)
 			}
 
 			assert.Equal(t, tc.expectedResp.Pagination.TotalPage, resp.Pagination.TotalPage)
@@ -252,3 +388,489 @@ func TestGetTopUrls(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCampaignStats(t *testing.T) {
+	testCampaignStats := domain.CampaignStats{CampaignID: "spring", FollowCount: 10, CreateCount: 2}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name                  string
+		buildAnalyticsService func() service.AnalyticsService
+		request               *analytics.CampaignStatsRequest
+		expectedResp          *analytics.CampaignStatsResponse
+		isErrExpected         bool
+		expectedCode          codes.Code
+	}{
+		{
+			name: "get campaign stats without error",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetCampaignStats", mock.Anything, "spring").
+					Return(testCampaignStats, nil)
+
+				return mockService
+			},
+			request:       &analytics.CampaignStatsRequest{CampaignId: "spring"},
+			expectedResp:  &analytics.CampaignStatsResponse{CampaignId: "spring", FollowCount: 10, CreateCount: 2},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Given empty campaign id should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				return mockService
+			},
+			request:       &analytics.CampaignStatsRequest{CampaignId: ""},
+			expectedResp:  &analytics.CampaignStatsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "internal error when get campaign stats. 13 Internal",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetCampaignStats", mock.Anything, mock.Anything).
+					Return(domain.CampaignStats{}, testErr)
+
+				return mockService
+			},
+			request:       &analytics.CampaignStatsRequest{CampaignId: "spring"},
+			expectedResp:  &analytics.CampaignStatsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			analyticsClient, cancel := initAnalyticsClient(
+				logger,
+				tc.buildAnalyticsService(),
+				mocks.NewPaginationService(t),
+			)
+			defer cancel()
+
+			resp, err := analyticsClient.GetCampaignStats(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.CampaignId, resp.CampaignId)
+			assert.Equal(t, tc.expectedResp.FollowCount, resp.FollowCount)
+			assert.Equal(t, tc.expectedResp.CreateCount, resp.CreateCount)
+		})
+	}
+}
+
+func TestEraseUrlAnalytics(t *testing.T) {
+	testErasedRows := []domain.ErasedRows{
+		{Table: "url_clicks", Rows: 12},
+		{Table: "url_events_counter", Rows: 3},
+		{Table: "url_preview_counter", Rows: 0},
+	}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name                  string
+		buildAnalyticsService func() service.AnalyticsService
+		request               *analytics.EraseUrlAnalyticsRequest
+		expectedResp          *analytics.EraseUrlAnalyticsResponse
+		isErrExpected         bool
+		expectedCode          codes.Code
+	}{
+		{
+			name: "erase url analytics without error",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("EraseURLs", mock.Anything, []domain.ErasedURL{
+					{ShortURL: "short"},
+					{Domain: "go.brand.com", ShortURL: "short"},
+				}).
+					Return(testErasedRows, nil)
+
+				return mockService
+			},
+			request: &analytics.EraseUrlAnalyticsRequest{Urls: []*analytics.ErasedUrl{
+				{ShortUrl: "short"},
+				{Domain: "go.brand.com", ShortUrl: "short"},
+			}},
+			expectedResp: &analytics.EraseUrlAnalyticsResponse{ErasedRows: []*analytics.ErasedRows{
+				{Table: "url_clicks", Rows: 12},
+				{Table: "url_events_counter", Rows: 3},
+				{Table: "url_preview_counter", Rows: 0},
+			}},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Given no short urls should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				return mockService
+			},
+			request:       &analytics.EraseUrlAnalyticsRequest{},
+			expectedResp:  &analytics.EraseUrlAnalyticsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given empty short url should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request:       &analytics.EraseUrlAnalyticsRequest{Urls: []*analytics.ErasedUrl{{Domain: "go.brand.com"}}},
+			expectedResp:  &analytics.EraseUrlAnalyticsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "internal error when erase url analytics. 13 Internal",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("EraseURLs", mock.Anything, mock.Anything).
+					Return(nil, testErr)
+
+				return mockService
+			},
+			request:       &analytics.EraseUrlAnalyticsRequest{Urls: []*analytics.ErasedUrl{{ShortUrl: "short"}}},
+			expectedResp:  &analytics.EraseUrlAnalyticsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			analyticsClient, cancel := initAnalyticsClient(
+				logger,
+				tc.buildAnalyticsService(),
+				mocks.NewPaginationService(t),
+			)
+			defer cancel()
+
+			resp, err := analyticsClient.EraseUrlAnalytics(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.ErasedRows), len(resp.ErasedRows))
+			for i := range tc.expectedResp.ErasedRows {
+				assert.Equal(t, tc.expectedResp.ErasedRows[i].Table, resp.ErasedRows[i].Table)
+				assert.Equal(t, tc.expectedResp.ErasedRows[i].Rows, resp.ErasedRows[i].Rows)
+			}
+		})
+	}
+}
+
+func TestGetClickBreakdown(t *testing.T) {
+	testBreakdown := []domain.ClickBreakdownRow{
+		{
+			Dimensions: map[domain.ClickDimension]string{
+				domain.ClickDimensionDeviceType: "mobile",
+				domain.ClickDimensionBot:        "false",
+			},
+			Clicks: 7,
+		},
+		{
+			Dimensions: map[domain.ClickDimension]string{
+				domain.ClickDimensionDeviceType: "bot",
+				domain.ClickDimensionBot:        "true",
+			},
+			Clicks: 2,
+		},
+	}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name                  string
+		buildAnalyticsService func() service.AnalyticsService
+		request               *analytics.ClickBreakdownRequest
+		expectedResp          *analytics.ClickBreakdownResponse
+		isErrExpected         bool
+		expectedCode          codes.Code
+	}{
+		{
+			name: "get click breakdown without error",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetClickBreakdown", mock.Anything, "short", "sho.rt",
+					[]domain.ClickDimension{domain.ClickDimensionDeviceType, domain.ClickDimensionBot}).
+					Return(testBreakdown, nil)
+
+				return mockService
+			},
+			request: &analytics.ClickBreakdownRequest{
+				ShortUrl: "short",
+				Domain:   "sho.rt",
+				Dimensions: []analytics.ClickDimension{
+					analytics.ClickDimension_CLICK_DIMENSION_DEVICE_TYPE,
+					analytics.ClickDimension_CLICK_DIMENSION_BOT,
+				},
+			},
+			expectedResp: &analytics.ClickBreakdownResponse{Rows: []*analytics.ClickBreakdownRow{
+				{Dimensions: map[string]string{"device_type": "mobile", "is_bot": "false"}, Clicks: 7},
+				{Dimensions: map[string]string{"device_type": "bot", "is_bot": "true"}, Clicks: 2},
+			}},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Given no dimensions should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request:       &analytics.ClickBreakdownRequest{ShortUrl: "short"},
+			expectedResp:  &analytics.ClickBreakdownResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given repeated dimension should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request: &analytics.ClickBreakdownRequest{Dimensions: []analytics.ClickDimension{
+				analytics.ClickDimension_CLICK_DIMENSION_OS_FAMILY,
+				analytics.ClickDimension_CLICK_DIMENSION_OS_FAMILY,
+			}},
+			expectedResp:  &analytics.ClickBreakdownResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given unspecified dimension should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request: &analytics.ClickBreakdownRequest{Dimensions: []analytics.ClickDimension{
+				analytics.ClickDimension_CLICK_DIMENSION_UNSPECIFIED,
+			}},
+			expectedResp:  &analytics.ClickBreakdownResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "internal error when get click breakdown. 13 Internal",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetClickBreakdown", mock.Anything, "", "", mock.Anything).
+					Return(nil, testErr)
+
+				return mockService
+			},
+			request: &analytics.ClickBreakdownRequest{Dimensions: []analytics.ClickDimension{
+				analytics.ClickDimension_CLICK_DIMENSION_BROWSER_FAMILY,
+			}},
+			expectedResp:  &analytics.ClickBreakdownResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			analyticsClient, cancel := initAnalyticsClient(
+				logger,
+				tc.buildAnalyticsService(),
+				mocks.NewPaginationService(t),
+			)
+			defer cancel()
+
+			resp, err := analyticsClient.GetClickBreakdown(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.Rows), len(resp.Rows))
+			for i := range tc.expectedResp.Rows {
+				assert.Equal(t, tc.expectedResp.Rows[i].Dimensions, resp.Rows[i].Dimensions)
+				assert.Equal(t, tc.expectedResp.Rows[i].Clicks, resp.Rows[i].Clicks)
+			}
+		})
+	}
+}
+
+func TestGetUrlTimeSeries(t *testing.T) {
+	testFrom := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	testPoints := []domain.TimeSeriesPoint{
+		{Start: testFrom, FollowCount: 4, CreateCount: 1},
+		{Start: testFrom.Add(time.Hour)},
+	}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name                  string
+		buildAnalyticsService func() service.AnalyticsService
+		request               *analytics.UrlTimeSeriesRequest
+		expectedResp          *analytics.UrlTimeSeriesResponse
+		isErrExpected         bool
+		expectedCode          codes.Code
+	}{
+		{
+			name: "get url time series without error",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetURLTimeSeries", mock.Anything, mock.MatchedBy(func(query domain.TimeSeriesQuery) bool {
+					return query.ShortURL == "short" &&
+						query.Domain == "sho.rt" &&
+						query.Granularity == domain.GranularityHour &&
+						query.From.Equal(testFrom) &&
+						query.To.Equal(testFrom.Add(2*time.Hour)) &&
+						query.Location.String() == "Europe/Moscow"
+				})).Return(testPoints, nil)
+
+				return mockService
+			},
+			request: &analytics.UrlTimeSeriesRequest{
+				ShortUrl:    "short",
+				Domain:      "sho.rt",
+				Granularity: analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR,
+				From:        testFrom.Unix(),
+				To:          testFrom.Add(2 * time.Hour).Unix(),
+				Timezone:    "Europe/Moscow",
+			},
+			expectedResp: &analytics.UrlTimeSeriesResponse{Points: []*analytics.TimeSeriesPoint{
+				{Start: testFrom.Unix(), FollowCount: 4, CreateCount: 1},
+				{Start: testFrom.Add(time.Hour).Unix()},
+			}},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "Given unspecified granularity should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request: &analytics.UrlTimeSeriesRequest{
+				ShortUrl: "short",
+				From:     testFrom.Unix(),
+				To:       testFrom.Add(time.Hour).Unix(),
+			},
+			expectedResp:  &analytics.UrlTimeSeriesResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given unknown timezone should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				return mocks.NewAnalyticsService(t)
+			},
+			request: &analytics.UrlTimeSeriesRequest{
+				ShortUrl:    "short",
+				Granularity: analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY,
+				From:        testFrom.Unix(),
+				To:          testFrom.Add(time.Hour).Unix(),
+				Timezone:    "Mars/Olympus_Mons",
+			},
+			expectedResp:  &analytics.UrlTimeSeriesResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "Given too many buckets should return error. 3 Invalid Argument",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetURLTimeSeries", mock.Anything, mock.Anything).
+					Return(nil, errs.ErrTooManyBuckets)
+
+				return mockService
+			},
+			request: &analytics.UrlTimeSeriesRequest{
+				ShortUrl:    "short",
+				Granularity: analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MINUTE,
+				From:        testFrom.Unix(),
+				To:          testFrom.AddDate(1, 0, 0).Unix(),
+			},
+			expectedResp:  &analytics.UrlTimeSeriesResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "internal error when get url time series. 13 Internal",
+			buildAnalyticsService: func() service.AnalyticsService {
+				mockService := mocks.NewAnalyticsService(t)
+				mockService.On("GetURLTimeSeries", mock.Anything, mock.Anything).
+					Return(nil, testErr)
+
+				return mockService
+			},
+			request: &analytics.UrlTimeSeriesRequest{
+				ShortUrl:    "short",
+				Granularity: analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK,
+				From:        testFrom.Unix(),
+				To:          testFrom.AddDate(0, 1, 0).Unix(),
+			},
+			expectedResp:  &analytics.UrlTimeSeriesResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			analyticsClient, cancel := initAnalyticsClient(
+				logger,
+				tc.buildAnalyticsService(),
+				mocks.NewPaginationService(t),
+			)
+			defer cancel()
+
+			resp, err := analyticsClient.GetUrlTimeSeries(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.Points), len(resp.Points))
+			for i := range tc.expectedResp.Points {
+				assert.Equal(t, tc.expectedResp.Points[i].Start, resp.Points[i].Start)
+				assert.Equal(t, tc.expectedResp.Points[i].FollowCount, resp.Points[i].FollowCount)
+				assert.Equal(t, tc.expectedResp.Points[i].CreateCount, resp.Points[i].CreateCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+DROP TABLE IF EXISTS url_clicks_mv;
+DROP TABLE IF EXISTS url_clicks;
+DROP TABLE IF EXISTS url_preview_counter_mv;
+DROP TABLE IF EXISTS campaign_events_counter_mv;
+DROP TABLE IF EXISTS url_events_counter_mv;
+DROP TABLE IF EXISTS url_events;
+
+-- schema_version is 0 for rows published before the versioned format
+CREATE TABLE IF NOT EXISTS url_events
+(
+    schema_version UInt32,
+    event_id       String,
+    long_url       String,
+    short_url      String,
+    event_time     TIMESTAMP,
+    event_time_ms  Int64,
+    event_type     Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    tags           Array(String),
+    campaign_id    String
+)
+    ENGINE = Kafka SETTINGS
+        kafka_broker_list = 'kafka1:9092',
+        kafka_topic_list = 'events',
+        kafka_group_name = 'group1',
+        kafka_format = 'JSONEachRow';
+
+CREATE MATERIALIZED VIEW url_events_counter_mv TO url_events_counter AS
+SELECT long_url,
+       short_url,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events
+WHERE schema_version <= 1
+GROUP BY long_url, short_url;
+
+CREATE MATERIALIZED VIEW campaign_events_counter_mv TO campaign_events_counter AS
+SELECT campaign_id,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events
+WHERE campaign_id != '' AND schema_version <= 1
+GROUP BY campaign_id;
+
+CREATE MATERIALIZED VIEW url_preview_counter_mv TO url_preview_counter AS
+SELECT long_url,
+       short_url,
+       COUNT() as preview_count
+FROM url_events
+WHERE event_type == 'preview' AND schema_version <= 1
+GROUP BY long_url, short_url
\ No newline at end of file
@@ -0,0 +1,95 @@
+DROP TABLE IF EXISTS url_clicks_mv;
+DROP TABLE IF EXISTS url_preview_counter_mv;
+DROP TABLE IF EXISTS campaign_events_counter_mv;
+DROP TABLE IF EXISTS url_events_counter_mv;
+DROP TABLE IF EXISTS url_events;
+
+-- schema_version is 0 for rows published before the versioned format
+CREATE TABLE IF NOT EXISTS url_events
+(
+    schema_version  UInt32,
+    event_id        String,
+    long_url        String,
+    short_url       String,
+    event_time      TIMESTAMP,
+    event_time_ms   Int64,
+    event_type      Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    tags            Array(String),
+    campaign_id     String,
+    referrer        String,
+    user_agent      String,
+    ip              String,
+    accept_language String,
+    host            String,
+    device_type     String,
+    os_family       String,
+    browser_family  String,
+    is_bot          Bool
+)
+    ENGINE = Kafka SETTINGS
+        kafka_broker_list = 'kafka1:9092',
+        kafka_topic_list = 'events',
+        kafka_group_name = 'group1',
+        kafka_format = 'JSONEachRow';
+
+CREATE TABLE url_events_counter_rebuilt
+(
+    long_url     String,
+    short_url    String,
+    follow_count Int64,
+    create_count Int64
+) ENGINE = SummingMergeTree((follow_count, create_count))
+      ORDER BY (long_url, short_url);
+
+INSERT INTO url_events_counter_rebuilt
+SELECT long_url, short_url, follow_count, create_count
+FROM url_events_counter;
+
+RENAME TABLE url_events_counter TO url_events_counter_old, url_events_counter_rebuilt TO url_events_counter;
+DROP TABLE url_events_counter_old;
+
+CREATE MATERIALIZED VIEW url_events_counter_mv TO url_events_counter AS
+SELECT long_url,
+       short_url,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events
+WHERE schema_version <= 1
+GROUP BY long_url, short_url;
+
+CREATE MATERIALIZED VIEW campaign_events_counter_mv TO campaign_events_counter AS
+SELECT campaign_id,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events
+WHERE campaign_id != '' AND schema_version <= 1
+GROUP BY campaign_id;
+
+CREATE MATERIALIZED VIEW url_preview_counter_mv TO url_preview_counter AS
+SELECT long_url,
+       short_url,
+       COUNT() as preview_count
+FROM url_events
+WHERE event_type == 'preview' AND schema_version <= 1
+GROUP BY long_url, short_url;
+
+ALTER TABLE url_clicks
+    DROP COLUMN IF EXISTS bot_score;
+
+CREATE MATERIALIZED VIEW url_clicks_mv TO url_clicks AS
+SELECT event_id,
+       long_url,
+       short_url,
+       fromUnixTimestamp64Milli(event_time_ms) as event_time,
+       campaign_id,
+       referrer,
+       user_agent,
+       ip,
+       accept_language,
+       host,
+       device_type,
+       os_family,
+       browser_family,
+       is_bot
+FROM url_events
+WHERE event_type == 'follow' AND schema_version == 1
\ No newline at end of file
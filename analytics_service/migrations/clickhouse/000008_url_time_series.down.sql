@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS url_events_hourly_mv;
+DROP TABLE IF EXISTS url_events_hourly;
+DROP TABLE IF EXISTS url_events_minutely_mv;
+DROP TABLE IF EXISTS url_events_minutely;
+DROP TABLE IF EXISTS url_events_raw_mv;
+DROP TABLE IF EXISTS url_events_raw;
@@ -0,0 +1,62 @@
+-- url_events_raw keeps every create and follow with its time, the time-bucketed tables are built from it.
+-- Events of schema_version 0 only have the time in seconds
+CREATE TABLE url_events_raw
+(
+    event_id   String,
+    short_url  String,
+    event_type Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    event_time DateTime64(3, 'UTC')
+) ENGINE = MergeTree
+      PARTITION BY toYYYYMM(event_time)
+      ORDER BY (short_url, event_time);
+
+CREATE MATERIALIZED VIEW url_events_raw_mv TO url_events_raw AS
+SELECT event_id,
+       short_url,
+       event_type,
+       if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3)) as event_time
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1;
+
+-- Buckets are kept in UTC. Hours, days and weeks of a timezone are summed from the hourly buckets,
+-- the minutely buckets serve minutes and the timezones that are not a whole number of hours off UTC
+CREATE TABLE url_events_minutely
+(
+    short_url    String,
+    bucket       DateTime('UTC'),
+    follow_count Int64,
+    create_count Int64
+) ENGINE = SummingMergeTree((follow_count, create_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (short_url, bucket);
+
+CREATE MATERIALIZED VIEW url_events_minutely_mv TO url_events_minutely AS
+SELECT short_url,
+       toStartOfMinute(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, bucket;
+
+CREATE TABLE url_events_hourly
+(
+    short_url    String,
+    bucket       DateTime('UTC'),
+    follow_count Int64,
+    create_count Int64
+) ENGINE = SummingMergeTree((follow_count, create_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (short_url, bucket);
+
+CREATE MATERIALIZED VIEW url_events_hourly_mv TO url_events_hourly AS
+SELECT short_url,
+       toStartOfHour(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, bucket;
+
+-- Follows are kept per event since url_clicks, creates before this migration only exist as totals
+INSERT INTO url_events_raw
+SELECT event_id, short_url, 'follow', event_time
+FROM url_clicks FINAL;
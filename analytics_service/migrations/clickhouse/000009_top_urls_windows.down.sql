@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS url_top_hourly_mv;
+DROP TABLE IF EXISTS url_top_hourly;
+DROP TABLE IF EXISTS url_top_minutely_mv;
+DROP TABLE IF EXISTS url_top_minutely
\ No newline at end of file
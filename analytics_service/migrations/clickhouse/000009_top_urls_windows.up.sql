@@ -0,0 +1,77 @@
+-- Top urls of a time window are summed from buckets. The tables are ordered by bucket first,
+-- so a window only reads its own buckets. Minutes serve the windows of up to a day and are kept for 3 days
+CREATE TABLE url_top_minutely
+(
+    bucket             DateTime('UTC'),
+    long_url           String,
+    short_url          String,
+    follow_count       Int64,
+    create_count       Int64,
+    human_follow_count Int64,
+    bot_follow_count   Int64
+) ENGINE = SummingMergeTree((follow_count, create_count, human_follow_count, bot_follow_count))
+      PARTITION BY toYYYYMMDD(bucket)
+      ORDER BY (bucket, long_url, short_url)
+      TTL bucket + INTERVAL 3 DAY;
+
+CREATE MATERIALIZED VIEW url_top_minutely_mv TO url_top_minutely AS
+SELECT toStartOfMinute(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url;
+
+CREATE TABLE url_top_hourly
+(
+    bucket             DateTime('UTC'),
+    long_url           String,
+    short_url          String,
+    follow_count       Int64,
+    create_count       Int64,
+    human_follow_count Int64,
+    bot_follow_count   Int64
+) ENGINE = SummingMergeTree((follow_count, create_count, human_follow_count, bot_follow_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (bucket, long_url, short_url);
+
+CREATE MATERIALIZED VIEW url_top_hourly_mv TO url_top_hourly AS
+SELECT toStartOfHour(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url;
+
+-- Past follows are summed from url_clicks, the only table with their bot flag.
+-- Past creates are left out, url_events_raw has no long url to rank them by
+INSERT INTO url_top_hourly
+SELECT toStartOfHour(event_time) as bucket,
+       long_url,
+       short_url,
+       count() as follow_count,
+       0 as create_count,
+       countIf(NOT is_bot) as human_follow_count,
+       countIf(is_bot) as bot_follow_count
+FROM url_clicks FINAL
+GROUP BY bucket, long_url, short_url;
+
+INSERT INTO url_top_minutely
+SELECT toStartOfMinute(event_time) as bucket,
+       long_url,
+       short_url,
+       count() as follow_count,
+       0 as create_count,
+       countIf(NOT is_bot) as human_follow_count,
+       countIf(is_bot) as bot_follow_count
+FROM url_clicks FINAL
+WHERE event_time >= now() - INTERVAL 3 DAY
+GROUP BY bucket, long_url, short_url
\ No newline at end of file
@@ -0,0 +1,176 @@
+DROP TABLE IF EXISTS url_top_hourly_mv;
+DROP TABLE IF EXISTS url_top_minutely_mv;
+DROP TABLE IF EXISTS url_events_hourly_mv;
+DROP TABLE IF EXISTS url_events_minutely_mv;
+DROP TABLE IF EXISTS url_events_raw_mv;
+DROP TABLE IF EXISTS url_clicks_mv;
+DROP TABLE IF EXISTS url_preview_counter_mv;
+DROP TABLE IF EXISTS campaign_events_counter_mv;
+DROP TABLE IF EXISTS url_events_counter_mv;
+DROP TABLE IF EXISTS url_events;
+
+-- The same short_url on two domains is two links, so domain joins the keys of every per link table.
+-- A key column can only be appended in the ALTER that adds it, rows from before get the default domain
+ALTER TABLE url_events_counter
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (long_url, short_url, domain);
+
+ALTER TABLE url_preview_counter
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (long_url, short_url, domain);
+
+ALTER TABLE url_clicks
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (short_url, event_time, event_id, domain);
+
+ALTER TABLE url_events_raw
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (short_url, event_time, domain);
+
+ALTER TABLE url_events_minutely
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (short_url, bucket, domain);
+
+ALTER TABLE url_events_hourly
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (short_url, bucket, domain);
+
+ALTER TABLE url_top_minutely
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (bucket, long_url, short_url, domain);
+
+ALTER TABLE url_top_hourly
+    ADD COLUMN domain String,
+    MODIFY ORDER BY (bucket, long_url, short_url, domain);
+
+-- domain is empty for the default domain and for rows published before the field
+CREATE TABLE IF NOT EXISTS url_events
+(
+    schema_version  UInt32,
+    event_id        String,
+    long_url        String,
+    short_url       String,
+    domain          String,
+    event_time      TIMESTAMP,
+    event_time_ms   Int64,
+    event_type      Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    tags            Array(String),
+    campaign_id     String,
+    referrer        String,
+    user_agent      String,
+    ip              String,
+    accept_language String,
+    host            String,
+    device_type     String,
+    os_family       String,
+    browser_family  String,
+    is_bot          Bool,
+    bot_score       UInt8
+)
+    ENGINE = Kafka SETTINGS
+        kafka_broker_list = 'kafka1:9092',
+        kafka_topic_list = 'events',
+        kafka_group_name = 'group1',
+        kafka_format = 'JSONEachRow';
+
+CREATE MATERIALIZED VIEW url_events_counter_mv TO url_events_counter AS
+SELECT long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE schema_version <= 1
+GROUP BY long_url, short_url, domain;
+
+CREATE MATERIALIZED VIEW campaign_events_counter_mv TO campaign_events_counter AS
+SELECT campaign_id,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events
+WHERE campaign_id != '' AND schema_version <= 1
+GROUP BY campaign_id;
+
+CREATE MATERIALIZED VIEW url_preview_counter_mv TO url_preview_counter AS
+SELECT long_url,
+       short_url,
+       domain,
+       COUNT() as preview_count
+FROM url_events
+WHERE event_type == 'preview' AND schema_version <= 1
+GROUP BY long_url, short_url, domain;
+
+CREATE MATERIALIZED VIEW url_clicks_mv TO url_clicks AS
+SELECT event_id,
+       long_url,
+       short_url,
+       domain,
+       fromUnixTimestamp64Milli(event_time_ms) as event_time,
+       campaign_id,
+       referrer,
+       user_agent,
+       ip,
+       accept_language,
+       host,
+       device_type,
+       os_family,
+       browser_family,
+       is_bot,
+       bot_score
+FROM url_events
+WHERE event_type == 'follow' AND schema_version == 1;
+
+CREATE MATERIALIZED VIEW url_events_raw_mv TO url_events_raw AS
+SELECT event_id,
+       short_url,
+       domain,
+       event_type,
+       if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3)) as event_time
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1;
+
+CREATE MATERIALIZED VIEW url_events_minutely_mv TO url_events_minutely AS
+SELECT short_url,
+       domain,
+       toStartOfMinute(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, domain, bucket;
+
+CREATE MATERIALIZED VIEW url_events_hourly_mv TO url_events_hourly AS
+SELECT short_url,
+       domain,
+       toStartOfHour(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, domain, bucket;
+
+CREATE MATERIALIZED VIEW url_top_minutely_mv TO url_top_minutely AS
+SELECT toStartOfMinute(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url, domain;
+
+CREATE MATERIALIZED VIEW url_top_hourly_mv TO url_top_hourly AS
+SELECT toStartOfHour(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url, domain
\ No newline at end of file
@@ -0,0 +1,3 @@
+-- The rebuilt tables and views have the names and queries of 000010, there is nothing to restore.
+-- The duplicated rows are not brought back
+SELECT 1
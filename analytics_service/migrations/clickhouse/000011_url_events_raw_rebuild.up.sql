@@ -0,0 +1,94 @@
+-- 000008 attached url_events_raw_mv before copying url_clicks, so follows consumed in between were
+-- stored twice and counted twice by the buckets. The old tables are moved aside and new ones take
+-- their names. The new view is attached before the old one is dropped, so no event is lost in between,
+-- and the rows of the old table are copied once per event_id. The views stay unfiltered, events that
+-- come late are stored like any other
+DROP TABLE url_events_hourly_mv;
+DROP TABLE url_events_minutely_mv;
+
+RENAME TABLE url_events_raw TO url_events_raw_duplicated,
+             url_events_minutely TO url_events_minutely_duplicated,
+             url_events_hourly TO url_events_hourly_duplicated;
+
+CREATE TABLE url_events_raw
+(
+    event_id   String,
+    short_url  String,
+    domain     String,
+    event_type Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    event_time DateTime64(3, 'UTC')
+) ENGINE = MergeTree
+      PARTITION BY toYYYYMM(event_time)
+      ORDER BY (short_url, event_time, domain);
+
+CREATE TABLE url_events_minutely
+(
+    short_url    String,
+    domain       String,
+    bucket       DateTime('UTC'),
+    follow_count Int64,
+    create_count Int64
+) ENGINE = SummingMergeTree((follow_count, create_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (short_url, bucket, domain);
+
+CREATE TABLE url_events_hourly
+(
+    short_url    String,
+    domain       String,
+    bucket       DateTime('UTC'),
+    follow_count Int64,
+    create_count Int64
+) ENGINE = SummingMergeTree((follow_count, create_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (short_url, bucket, domain);
+
+-- The buckets are summed from url_events_raw, so the copied rows reach them as well
+CREATE MATERIALIZED VIEW url_events_minutely_mv TO url_events_minutely AS
+SELECT short_url,
+       domain,
+       toStartOfMinute(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, domain, bucket;
+
+CREATE MATERIALIZED VIEW url_events_hourly_mv TO url_events_hourly AS
+SELECT short_url,
+       domain,
+       toStartOfHour(event_time) as bucket,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count
+FROM url_events_raw
+GROUP BY short_url, domain, bucket;
+
+CREATE MATERIALIZED VIEW url_events_raw_rebuilt_mv TO url_events_raw AS
+SELECT event_id,
+       short_url,
+       domain,
+       event_type,
+       if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3)) as event_time
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1;
+
+-- An event consumed while both views were attached is in both tables, the old copy is skipped.
+-- Nothing writes to url_events_raw_duplicated after the old view is dropped
+DROP TABLE url_events_raw_mv;
+
+RENAME TABLE url_events_raw_rebuilt_mv TO url_events_raw_mv;
+
+INSERT INTO url_events_raw (event_id, short_url, domain, event_type, event_time)
+SELECT event_id, short_url, domain, event_type, event_time
+FROM url_events_raw_duplicated
+WHERE event_id != '' AND event_id NOT IN (SELECT event_id FROM url_events_raw WHERE event_id != '')
+LIMIT 1 BY event_id;
+
+-- Events of schema_version 0 have no event_id, they were never copied from url_clicks
+INSERT INTO url_events_raw (event_id, short_url, domain, event_type, event_time)
+SELECT event_id, short_url, domain, event_type, event_time
+FROM url_events_raw_duplicated
+WHERE event_id = '';
+
+DROP TABLE url_events_hourly_duplicated;
+DROP TABLE url_events_minutely_duplicated;
+DROP TABLE url_events_raw_duplicated
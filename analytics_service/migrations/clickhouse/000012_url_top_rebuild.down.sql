@@ -0,0 +1,32 @@
+DROP TABLE IF EXISTS url_top_hourly_mv;
+DROP TABLE IF EXISTS url_top_minutely_mv;
+DROP TABLE IF EXISTS url_top_events_mv;
+DROP TABLE IF EXISTS url_top_events;
+DROP TABLE IF EXISTS url_top_backfill;
+
+-- The buckets keep the sums made from the events, only the views of 000010 come back
+CREATE MATERIALIZED VIEW url_top_minutely_mv TO url_top_minutely AS
+SELECT toStartOfMinute(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url, domain;
+
+CREATE MATERIALIZED VIEW url_top_hourly_mv TO url_top_hourly AS
+SELECT toStartOfHour(if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3))) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1
+GROUP BY bucket, long_url, short_url, domain
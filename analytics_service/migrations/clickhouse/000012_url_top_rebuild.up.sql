@@ -0,0 +1,149 @@
+-- 000009 attached the top views before summing url_clicks into the same buckets, so the follows
+-- consumed in between were summed twice. Sums can not be told apart afterwards, so both tables are
+-- summed again from the events: url_clicks holds every follow with its bot flag, url_events_raw holds
+-- every create. The buckets have no event ids, so the views sum url_top_events instead of url_events:
+-- it keeps the ids of the events summed by the views for a day, and the copy skips them
+DROP TABLE url_top_hourly_mv;
+DROP TABLE url_top_minutely_mv;
+
+DROP TABLE url_top_hourly;
+DROP TABLE url_top_minutely;
+
+CREATE TABLE url_top_minutely
+(
+    bucket             DateTime('UTC'),
+    long_url           String,
+    short_url          String,
+    domain             String,
+    follow_count       Int64,
+    create_count       Int64,
+    human_follow_count Int64,
+    bot_follow_count   Int64
+) ENGINE = SummingMergeTree((follow_count, create_count, human_follow_count, bot_follow_count))
+      PARTITION BY toYYYYMMDD(bucket)
+      ORDER BY (bucket, long_url, short_url, domain)
+      TTL bucket + INTERVAL 3 DAY;
+
+CREATE TABLE url_top_hourly
+(
+    bucket             DateTime('UTC'),
+    long_url           String,
+    short_url          String,
+    domain             String,
+    follow_count       Int64,
+    create_count       Int64,
+    human_follow_count Int64,
+    bot_follow_count   Int64
+) ENGINE = SummingMergeTree((follow_count, create_count, human_follow_count, bot_follow_count))
+      PARTITION BY toYYYYMM(bucket)
+      ORDER BY (bucket, long_url, short_url, domain);
+
+CREATE TABLE url_top_events
+(
+    event_id    String,
+    event_type  Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    event_time  DateTime64(3, 'UTC'),
+    long_url    String,
+    short_url   String,
+    domain      String,
+    is_bot      Bool,
+    inserted_at DateTime('UTC') DEFAULT now()
+) ENGINE = MergeTree
+      PARTITION BY toYYYYMMDD(inserted_at)
+      ORDER BY event_id
+      TTL inserted_at + INTERVAL 1 DAY;
+
+CREATE MATERIALIZED VIEW url_top_minutely_mv TO url_top_minutely AS
+SELECT toStartOfMinute(event_time) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_top_events
+GROUP BY bucket, long_url, short_url, domain;
+
+CREATE MATERIALIZED VIEW url_top_hourly_mv TO url_top_hourly AS
+SELECT toStartOfHour(event_time) as bucket,
+       long_url,
+       short_url,
+       domain,
+       SUM(if(event_type == 'follow', 1, 0)) as follow_count,
+       SUM(if(event_type == 'create', 1, 0)) as create_count,
+       SUM(if(event_type == 'follow' AND NOT is_bot, 1, 0)) as human_follow_count,
+       SUM(if(event_type == 'follow' AND is_bot, 1, 0)) as bot_follow_count
+FROM url_top_events
+GROUP BY bucket, long_url, short_url, domain;
+
+-- Unfiltered, events that come late are summed like any other
+CREATE MATERIALIZED VIEW url_top_events_mv TO url_top_events AS
+SELECT event_id,
+       event_type,
+       if(event_time_ms != 0, fromUnixTimestamp64Milli(event_time_ms), toDateTime64(event_time, 3)) as event_time,
+       long_url,
+       short_url,
+       domain,
+       is_bot
+FROM url_events
+WHERE event_type IN ('create', 'follow') AND schema_version <= 1;
+
+-- url_clicks and url_events_raw keep growing, they are read into url_top_backfill first and the
+-- ids summed by the views are looked up after that, so an event read from them is found if it was summed
+CREATE TABLE url_top_backfill
+(
+    event_id   String,
+    event_type Enum8('create' = 1, 'follow' = 2, 'preview' = 3),
+    event_time DateTime64(3, 'UTC'),
+    long_url   String,
+    short_url  String,
+    domain     String,
+    is_bot     Bool,
+    recent     Bool DEFAULT event_time >= now64(3, 'UTC') - INTERVAL 3 DAY
+) ENGINE = MergeTree
+      ORDER BY tuple();
+
+INSERT INTO url_top_backfill (event_id, event_type, event_time, long_url, short_url, domain, is_bot)
+SELECT event_id, 'follow', event_time, long_url, short_url, domain, is_bot
+FROM url_clicks FINAL;
+
+-- url_events_raw has no long url, a create is matched with the counter row it was summed into
+INSERT INTO url_top_backfill (event_id, event_type, event_time, long_url, short_url, domain, is_bot)
+SELECT creates.event_id, 'create', creates.event_time, links.long_url, creates.short_url, creates.domain, false
+FROM (
+    SELECT event_id, event_time, short_url, domain
+    FROM url_events_raw
+    WHERE event_type == 'create'
+) AS creates
+INNER JOIN (
+    SELECT short_url, domain, any(long_url) as long_url
+    FROM url_events_counter
+    WHERE create_count > 0
+    GROUP BY short_url, domain
+) AS links ON links.short_url = creates.short_url AND links.domain = creates.domain;
+
+-- Events of schema_version 0 have no event_id, the views never sum them twice. Only the recent events
+-- go through url_top_events, the minutely buckets keep 3 days and the older ones are summed hourly
+INSERT INTO url_top_events (event_id, event_type, event_time, long_url, short_url, domain, is_bot)
+SELECT event_id, event_type, event_time, long_url, short_url, domain, is_bot
+FROM url_top_backfill
+WHERE recent
+  AND (event_id = '' OR event_id NOT IN (SELECT event_id FROM url_top_events WHERE event_id != ''));
+
+INSERT INTO url_top_hourly
+    (bucket, long_url, short_url, domain, follow_count, create_count, human_follow_count, bot_follow_count)
+SELECT toStartOfHour(event_time) as bucket,
+       long_url,
+       short_url,
+       domain,
+       countIf(event_type == 'follow') as follow_count,
+       countIf(event_type == 'create') as create_count,
+       countIf(event_type == 'follow' AND NOT is_bot) as human_follow_count,
+       countIf(event_type == 'follow' AND is_bot) as bot_follow_count
+FROM url_top_backfill
+WHERE NOT recent
+  AND (event_id = '' OR event_id NOT IN (SELECT event_id FROM url_top_events WHERE event_id != ''))
+GROUP BY bucket, long_url, short_url, domain;
+
+DROP TABLE url_top_backfill
@@ -0,0 +1,94 @@
+package eventspb
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// The url shortener publishes the events, its golden files must stay readable here
+const (
+	producerProtoPath       = "../../../../url_shortener_service/pkg/proto/events/events.proto"
+	producerTestdataPath    = "../../../../url_shortener_service/pkg/proto/events/testdata"
+	clickhouseMigrationsDir = "../../../migrations/clickhouse"
+
+	supportedSchemaVersion = 1
+)
+
+var urlEventsTableRe = regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS url_events\s*\((.*?)\)\s*ENGINE`)
+
+// urlEventsColumns returns the columns of url_events in the latest clickhouse migration
+func urlEventsColumns(t *testing.T) []string {
+	paths, err := filepath.Glob(filepath.Join(clickhouseMigrationsDir, "*.up.sql"))
+	assert.NoError(t, err)
+	sort.Strings(paths)
+
+	var columns []string
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		assert.NoError(t, err)
+
+		match := urlEventsTableRe.FindSubmatch(content)
+		if match == nil {
+			continue
+		}
+		columns = columns[:0]
+		for _, line := range strings.Split(string(match[1]), "\n") {
+			fields := strings.Fields(strings.TrimSpace(line))
+			if len(fields) > 0 {
+				columns = append(columns, fields[0])
+			}
+		}
+	}
+
+	return columns
+}
+
+func TestEventsSchemaCompatibility(t *testing.T) {
+	t.Run("Schema is the same as the producer one", func(t *testing.T) {
+		producerProto, err := os.ReadFile(producerProtoPath)
+		assert.NoError(t, err)
+		consumerProto, err := os.ReadFile("events.proto")
+		assert.NoError(t, err)
+
+		assert.Equal(t, string(producerProto), string(consumerProto))
+	})
+
+	t.Run("Golden envelope is decoded", func(t *testing.T) {
+		golden, err := os.ReadFile(filepath.Join(producerTestdataPath, "url_event_v1.binpb"))
+		assert.NoError(t, err)
+
+		var envelope Envelope
+		assert.NoError(t, proto.Unmarshal(golden, &envelope))
+		assert.Equal(t, uint32(supportedSchemaVersion), envelope.GetSchemaVersion())
+		assert.Equal(t, "0f8fad5b-d9cb-469f-a165-70867728950e", envelope.GetEvent().GetEventId())
+		assert.Equal(t, int64(1718000000123), envelope.GetEvent().GetEventTimeMs())
+		assert.Equal(t, EventType_EVENT_TYPE_FOLLOW, envelope.GetEvent().GetEventType())
+		assert.Equal(t, "abc123", envelope.GetEvent().GetShortUrl())
+		assert.Equal(t, "sho.rt", envelope.GetEvent().GetDomain())
+		assert.Equal(t, "https://news.example.org/", envelope.GetEvent().GetReferrer())
+		assert.Equal(t, "203.0.113.0", envelope.GetEvent().GetIp())
+	})
+
+	t.Run("Golden json row has the columns of url_events", func(t *testing.T) {
+		golden, err := os.ReadFile(filepath.Join(producerTestdataPath, "url_event_v1.json"))
+		assert.NoError(t, err)
+
+		var row map[string]any
+		assert.NoError(t, json.Unmarshal(golden, &row))
+		fields := make([]string, 0, len(row))
+		for field := range row {
+			fields = append(fields, field)
+		}
+
+		assert.ElementsMatch(t, urlEventsColumns(t), fields)
+		assert.Equal(t, float64(supportedSchemaVersion), row["schema_version"])
+	})
+}
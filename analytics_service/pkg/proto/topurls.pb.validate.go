@@ -79,6 +79,32 @@ func (m *TopUrlsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := TopUrlsRank_name[int32(m.GetRankBy())]; !ok {
+		err := TopUrlsRequestValidationError{
+			field:  "RankBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TopUrlsWindow_name[int32(m.GetWindow())]; !ok {
+		err := TopUrlsRequestValidationError{
+			field:  "Window",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for From
+
+	// no validation rules for To
+
 	if len(errors) > 0 {
 		return TopUrlsRequestMultiError(errors)
 	}
@@ -296,6 +322,12 @@ func (m *TopUrlData) validate(all bool) error {
 
 	// no validation rules for CreateCount
 
+	// no validation rules for HumanFollowCount
+
+	// no validation rules for BotFollowCount
+
+	// no validation rules for Domain
+
 	if len(errors) > 0 {
 		return TopUrlDataMultiError(errors)
 	}
@@ -535,3 +567,1525 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TopUrlsResponseValidationError{}
+
+// Validate checks the field values on CampaignStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CampaignStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CampaignStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CampaignStatsRequestMultiError, or nil if none found.
+func (m *CampaignStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CampaignStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCampaignId()) < 1 {
+		err := CampaignStatsRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CampaignStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by CampaignStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CampaignStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignStatsRequestMultiError) AllErrors() []error { return m }
+
+// CampaignStatsRequestValidationError is the validation error returned by
+// CampaignStatsRequest.Validate if the designated constraints aren't met.
+type CampaignStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignStatsRequestValidationError) ErrorName() string {
+	return "CampaignStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CampaignStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaignStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignStatsRequestValidationError{}
+
+// Validate checks the field values on CampaignStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CampaignStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CampaignStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CampaignStatsResponseMultiError, or nil if none found.
+func (m *CampaignStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CampaignStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CampaignId
+
+	// no validation rules for FollowCount
+
+	// no validation rules for CreateCount
+
+	if len(errors) > 0 {
+		return CampaignStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CampaignStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by CampaignStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CampaignStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CampaignStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CampaignStatsResponseMultiError) AllErrors() []error { return m }
+
+// CampaignStatsResponseValidationError is the validation error returned by
+// CampaignStatsResponse.Validate if the designated constraints aren't met.
+type CampaignStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CampaignStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CampaignStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CampaignStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CampaignStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CampaignStatsResponseValidationError) ErrorName() string {
+	return "CampaignStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CampaignStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCampaignStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CampaignStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CampaignStatsResponseValidationError{}
+
+// Validate checks the field values on ErasedUrl with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasedUrl) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasedUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasedUrlMultiError, or nil
+// if none found.
+func (m *ErasedUrl) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasedUrl) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Domain
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := ErasedUrlValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ErasedUrlMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasedUrlMultiError is an error wrapping multiple validation errors returned
+// by ErasedUrl.ValidateAll() if the designated constraints aren't met.
+type ErasedUrlMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasedUrlMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasedUrlMultiError) AllErrors() []error { return m }
+
+// ErasedUrlValidationError is the validation error returned by
+// ErasedUrl.Validate if the designated constraints aren't met.
+type ErasedUrlValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasedUrlValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasedUrlValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasedUrlValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasedUrlValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasedUrlValidationError) ErrorName() string { return "ErasedUrlValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasedUrlValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasedUrl.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasedUrlValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasedUrlValidationError{}
+
+// Validate checks the field values on EraseUrlAnalyticsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUrlAnalyticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUrlAnalyticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUrlAnalyticsRequestMultiError, or nil if none found.
+func (m *EraseUrlAnalyticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUrlAnalyticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUrls()); l < 1 || l > 1000 {
+		err := EraseUrlAnalyticsRequestValidationError{
+			field:  "Urls",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EraseUrlAnalyticsRequestValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EraseUrlAnalyticsRequestValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EraseUrlAnalyticsRequestValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EraseUrlAnalyticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUrlAnalyticsRequestMultiError is an error wrapping multiple validation
+// errors returned by EraseUrlAnalyticsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUrlAnalyticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUrlAnalyticsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUrlAnalyticsRequestMultiError) AllErrors() []error { return m }
+
+// EraseUrlAnalyticsRequestValidationError is the validation error returned by
+// EraseUrlAnalyticsRequest.Validate if the designated constraints aren't met.
+type EraseUrlAnalyticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUrlAnalyticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUrlAnalyticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUrlAnalyticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUrlAnalyticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUrlAnalyticsRequestValidationError) ErrorName() string {
+	return "EraseUrlAnalyticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUrlAnalyticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUrlAnalyticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUrlAnalyticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUrlAnalyticsRequestValidationError{}
+
+// Validate checks the field values on ErasedRows with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasedRows) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasedRows with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasedRowsMultiError, or
+// nil if none found.
+func (m *ErasedRows) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasedRows) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Table
+
+	// no validation rules for Rows
+
+	if len(errors) > 0 {
+		return ErasedRowsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasedRowsMultiError is an error wrapping multiple validation errors
+// returned by ErasedRows.ValidateAll() if the designated constraints aren't met.
+type ErasedRowsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasedRowsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasedRowsMultiError) AllErrors() []error { return m }
+
+// ErasedRowsValidationError is the validation error returned by
+// ErasedRows.Validate if the designated constraints aren't met.
+type ErasedRowsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasedRowsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasedRowsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasedRowsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasedRowsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasedRowsValidationError) ErrorName() string { return "ErasedRowsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasedRowsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasedRows.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasedRowsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasedRowsValidationError{}
+
+// Validate checks the field values on EraseUrlAnalyticsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUrlAnalyticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUrlAnalyticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUrlAnalyticsResponseMultiError, or nil if none found.
+func (m *EraseUrlAnalyticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUrlAnalyticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetErasedRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EraseUrlAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ErasedRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EraseUrlAnalyticsResponseValidationError{
+						field:  fmt.Sprintf("ErasedRows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EraseUrlAnalyticsResponseValidationError{
+					field:  fmt.Sprintf("ErasedRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EraseUrlAnalyticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUrlAnalyticsResponseMultiError is an error wrapping multiple validation
+// errors returned by EraseUrlAnalyticsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type EraseUrlAnalyticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUrlAnalyticsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUrlAnalyticsResponseMultiError) AllErrors() []error { return m }
+
+// EraseUrlAnalyticsResponseValidationError is the validation error returned by
+// EraseUrlAnalyticsResponse.Validate if the designated constraints aren't met.
+type EraseUrlAnalyticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUrlAnalyticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUrlAnalyticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUrlAnalyticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUrlAnalyticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUrlAnalyticsResponseValidationError) ErrorName() string {
+	return "EraseUrlAnalyticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUrlAnalyticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUrlAnalyticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUrlAnalyticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUrlAnalyticsResponseValidationError{}
+
+// Validate checks the field values on ClickBreakdownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClickBreakdownRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickBreakdownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClickBreakdownRequestMultiError, or nil if none found.
+func (m *ClickBreakdownRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickBreakdownRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShortUrl
+
+	if len(m.GetDimensions()) < 1 {
+		err := ClickBreakdownRequestValidationError{
+			field:  "Dimensions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ClickBreakdownRequest_Dimensions_Unique := make(map[ClickDimension]struct{}, len(m.GetDimensions()))
+
+	for idx, item := range m.GetDimensions() {
+		_, _ = idx, item
+
+		if _, exists := _ClickBreakdownRequest_Dimensions_Unique[item]; exists {
+			err := ClickBreakdownRequestValidationError{
+				field:  fmt.Sprintf("Dimensions[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ClickBreakdownRequest_Dimensions_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _ClickBreakdownRequest_Dimensions_NotInLookup[item]; ok {
+			err := ClickBreakdownRequestValidationError{
+				field:  fmt.Sprintf("Dimensions[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := ClickDimension_name[int32(item)]; !ok {
+			err := ClickBreakdownRequestValidationError{
+				field:  fmt.Sprintf("Dimensions[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return ClickBreakdownRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickBreakdownRequestMultiError is an error wrapping multiple validation
+// errors returned by ClickBreakdownRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClickBreakdownRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickBreakdownRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickBreakdownRequestMultiError) AllErrors() []error { return m }
+
+// ClickBreakdownRequestValidationError is the validation error returned by
+// ClickBreakdownRequest.Validate if the designated constraints aren't met.
+type ClickBreakdownRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickBreakdownRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickBreakdownRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickBreakdownRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickBreakdownRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickBreakdownRequestValidationError) ErrorName() string {
+	return "ClickBreakdownRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClickBreakdownRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickBreakdownRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickBreakdownRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickBreakdownRequestValidationError{}
+
+var _ClickBreakdownRequest_Dimensions_NotInLookup = map[ClickDimension]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ClickBreakdownRow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClickBreakdownRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickBreakdownRow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClickBreakdownRowMultiError, or nil if none found.
+func (m *ClickBreakdownRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickBreakdownRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dimensions
+
+	// no validation rules for Clicks
+
+	if len(errors) > 0 {
+		return ClickBreakdownRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickBreakdownRowMultiError is an error wrapping multiple validation errors
+// returned by ClickBreakdownRow.ValidateAll() if the designated constraints
+// aren't met.
+type ClickBreakdownRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickBreakdownRowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickBreakdownRowMultiError) AllErrors() []error { return m }
+
+// ClickBreakdownRowValidationError is the validation error returned by
+// ClickBreakdownRow.Validate if the designated constraints aren't met.
+type ClickBreakdownRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickBreakdownRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickBreakdownRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickBreakdownRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickBreakdownRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickBreakdownRowValidationError) ErrorName() string {
+	return "ClickBreakdownRowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClickBreakdownRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickBreakdownRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickBreakdownRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickBreakdownRowValidationError{}
+
+// Validate checks the field values on ClickBreakdownResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClickBreakdownResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickBreakdownResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClickBreakdownResponseMultiError, or nil if none found.
+func (m *ClickBreakdownResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickBreakdownResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClickBreakdownResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClickBreakdownResponseValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClickBreakdownResponseValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClickBreakdownResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickBreakdownResponseMultiError is an error wrapping multiple validation
+// errors returned by ClickBreakdownResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ClickBreakdownResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickBreakdownResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickBreakdownResponseMultiError) AllErrors() []error { return m }
+
+// ClickBreakdownResponseValidationError is the validation error returned by
+// ClickBreakdownResponse.Validate if the designated constraints aren't met.
+type ClickBreakdownResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickBreakdownResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickBreakdownResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickBreakdownResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickBreakdownResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickBreakdownResponseValidationError) ErrorName() string {
+	return "ClickBreakdownResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClickBreakdownResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickBreakdownResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickBreakdownResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickBreakdownResponseValidationError{}
+
+// Validate checks the field values on UrlTimeSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UrlTimeSeriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlTimeSeriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlTimeSeriesRequestMultiError, or nil if none found.
+func (m *UrlTimeSeriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlTimeSeriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := UrlTimeSeriesRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UrlTimeSeriesRequest_Granularity_NotInLookup[m.GetGranularity()]; ok {
+		err := UrlTimeSeriesRequestValidationError{
+			field:  "Granularity",
+			reason: "value must not be in list [TIME_SERIES_GRANULARITY_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TimeSeriesGranularity_name[int32(m.GetGranularity())]; !ok {
+		err := UrlTimeSeriesRequestValidationError{
+			field:  "Granularity",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := UrlTimeSeriesRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return UrlTimeSeriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlTimeSeriesRequestMultiError is an error wrapping multiple validation
+// errors returned by UrlTimeSeriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UrlTimeSeriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlTimeSeriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlTimeSeriesRequestMultiError) AllErrors() []error { return m }
+
+// UrlTimeSeriesRequestValidationError is the validation error returned by
+// UrlTimeSeriesRequest.Validate if the designated constraints aren't met.
+type UrlTimeSeriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlTimeSeriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlTimeSeriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlTimeSeriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlTimeSeriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlTimeSeriesRequestValidationError) ErrorName() string {
+	return "UrlTimeSeriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UrlTimeSeriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlTimeSeriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlTimeSeriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlTimeSeriesRequestValidationError{}
+
+var _UrlTimeSeriesRequest_Granularity_NotInLookup = map[TimeSeriesGranularity]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on TimeSeriesPoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TimeSeriesPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSeriesPoint with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TimeSeriesPointMultiError, or nil if none found.
+func (m *TimeSeriesPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSeriesPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for FollowCount
+
+	// no validation rules for CreateCount
+
+	if len(errors) > 0 {
+		return TimeSeriesPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSeriesPointMultiError is an error wrapping multiple validation errors
+// returned by TimeSeriesPoint.ValidateAll() if the designated constraints
+// aren't met.
+type TimeSeriesPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSeriesPointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSeriesPointMultiError) AllErrors() []error { return m }
+
+// TimeSeriesPointValidationError is the validation error returned by
+// TimeSeriesPoint.Validate if the designated constraints aren't met.
+type TimeSeriesPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSeriesPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSeriesPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSeriesPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSeriesPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSeriesPointValidationError) ErrorName() string { return "TimeSeriesPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSeriesPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSeriesPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSeriesPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSeriesPointValidationError{}
+
+// Validate checks the field values on UrlTimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UrlTimeSeriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlTimeSeriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlTimeSeriesResponseMultiError, or nil if none found.
+func (m *UrlTimeSeriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlTimeSeriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UrlTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UrlTimeSeriesResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UrlTimeSeriesResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UrlTimeSeriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlTimeSeriesResponseMultiError is an error wrapping multiple validation
+// errors returned by UrlTimeSeriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UrlTimeSeriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlTimeSeriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlTimeSeriesResponseMultiError) AllErrors() []error { return m }
+
+// UrlTimeSeriesResponseValidationError is the validation error returned by
+// UrlTimeSeriesResponse.Validate if the designated constraints aren't met.
+type UrlTimeSeriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlTimeSeriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlTimeSeriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlTimeSeriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlTimeSeriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlTimeSeriesResponseValidationError) ErrorName() string {
+	return "UrlTimeSeriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UrlTimeSeriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlTimeSeriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlTimeSeriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlTimeSeriesResponseValidationError{}
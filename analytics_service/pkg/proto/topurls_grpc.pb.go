@@ -23,6 +23,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AnalyticsClient interface {
 	GetTopUrls(ctx context.Context, in *TopUrlsRequest, opts ...grpc.CallOption) (*TopUrlsResponse, error)
+	GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, opts ...grpc.CallOption) (*CampaignStatsResponse, error)
+	EraseUrlAnalytics(ctx context.Context, in *EraseUrlAnalyticsRequest, opts ...grpc.CallOption) (*EraseUrlAnalyticsResponse, error)
+	GetClickBreakdown(ctx context.Context, in *ClickBreakdownRequest, opts ...grpc.CallOption) (*ClickBreakdownResponse, error)
+	GetUrlTimeSeries(ctx context.Context, in *UrlTimeSeriesRequest, opts ...grpc.CallOption) (*UrlTimeSeriesResponse, error)
 }
 
 type analyticsClient struct {
@@ -42,11 +46,51 @@ func (c *analyticsClient) GetTopUrls(ctx context.Context, in *TopUrlsRequest, op
 	return out, nil
 }
 
+func (c *analyticsClient) GetCampaignStats(ctx context.Context, in *CampaignStatsRequest, opts ...grpc.CallOption) (*CampaignStatsResponse, error) {
+	out := new(CampaignStatsResponse)
+	err := c.cc.Invoke(ctx, "/analytics.Analytics/GetCampaignStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) EraseUrlAnalytics(ctx context.Context, in *EraseUrlAnalyticsRequest, opts ...grpc.CallOption) (*EraseUrlAnalyticsResponse, error) {
+	out := new(EraseUrlAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/analytics.Analytics/EraseUrlAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) GetClickBreakdown(ctx context.Context, in *ClickBreakdownRequest, opts ...grpc.CallOption) (*ClickBreakdownResponse, error) {
+	out := new(ClickBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/analytics.Analytics/GetClickBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyticsClient) GetUrlTimeSeries(ctx context.Context, in *UrlTimeSeriesRequest, opts ...grpc.CallOption) (*UrlTimeSeriesResponse, error) {
+	out := new(UrlTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/analytics.Analytics/GetUrlTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyticsServer is the server API for Analytics service.
 // All implementations must embed UnimplementedAnalyticsServer
 // for forward compatibility
 type AnalyticsServer interface {
 	GetTopUrls(context.Context, *TopUrlsRequest) (*TopUrlsResponse, error)
+	GetCampaignStats(context.Context, *CampaignStatsRequest) (*CampaignStatsResponse, error)
+	EraseUrlAnalytics(context.Context, *EraseUrlAnalyticsRequest) (*EraseUrlAnalyticsResponse, error)
+	GetClickBreakdown(context.Context, *ClickBreakdownRequest) (*ClickBreakdownResponse, error)
+	GetUrlTimeSeries(context.Context, *UrlTimeSeriesRequest) (*UrlTimeSeriesResponse, error)
 	mustEmbedUnimplementedAnalyticsServer()
 }
 
@@ -57,6 +101,18 @@ type UnimplementedAnalyticsServer struct {
 func (UnimplementedAnalyticsServer) GetTopUrls(context.Context, *TopUrlsRequest) (*TopUrlsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopUrls not implemented")
 }
+func (UnimplementedAnalyticsServer) GetCampaignStats(context.Context, *CampaignStatsRequest) (*CampaignStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCampaignStats not implemented")
+}
+func (UnimplementedAnalyticsServer) EraseUrlAnalytics(context.Context, *EraseUrlAnalyticsRequest) (*EraseUrlAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUrlAnalytics not implemented")
+}
+func (UnimplementedAnalyticsServer) GetClickBreakdown(context.Context, *ClickBreakdownRequest) (*ClickBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClickBreakdown not implemented")
+}
+func (UnimplementedAnalyticsServer) GetUrlTimeSeries(context.Context, *UrlTimeSeriesRequest) (*UrlTimeSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUrlTimeSeries not implemented")
+}
 func (UnimplementedAnalyticsServer) mustEmbedUnimplementedAnalyticsServer() {}
 
 // UnsafeAnalyticsServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +144,78 @@ func _Analytics_GetTopUrls_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Analytics_GetCampaignStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetCampaignStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/analytics.Analytics/GetCampaignStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetCampaignStats(ctx, req.(*CampaignStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_EraseUrlAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUrlAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).EraseUrlAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/analytics.Analytics/EraseUrlAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).EraseUrlAnalytics(ctx, req.(*EraseUrlAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_GetClickBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClickBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetClickBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/analytics.Analytics/GetClickBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetClickBreakdown(ctx, req.(*ClickBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Analytics_GetUrlTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyticsServer).GetUrlTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/analytics.Analytics/GetUrlTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyticsServer).GetUrlTimeSeries(ctx, req.(*UrlTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Analytics_ServiceDesc is the grpc.ServiceDesc for Analytics service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +227,22 @@ var Analytics_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTopUrls",
 			Handler:    _Analytics_GetTopUrls_Handler,
 		},
+		{
+			MethodName: "GetCampaignStats",
+			Handler:    _Analytics_GetCampaignStats_Handler,
+		},
+		{
+			MethodName: "EraseUrlAnalytics",
+			Handler:    _Analytics_EraseUrlAnalytics_Handler,
+		},
+		{
+			MethodName: "GetClickBreakdown",
+			Handler:    _Analytics_GetClickBreakdown_Handler,
+		},
+		{
+			MethodName: "GetUrlTimeSeries",
+			Handler:    _Analytics_GetUrlTimeSeries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "topurls.proto",
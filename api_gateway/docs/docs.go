@@ -15,9 +15,170 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/api/campaigns/{campaign_id}/stats": {
+            "get": {
+                "description": "Принимает id кампании в path параметрах. Возвращает суммарное количество созданий и переходов по ссылкам кампании",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "campaign"
+                ],
+                "summary": "Получение статистики кампании",
+                "operationId": "get-campaign-stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id кампании",
+                        "name": "campaign_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CampaignStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/erasure": {
+            "post": {
+                "description": "Доступно только операторам, принимает токен оператора в заголовке Authorization: Bearer. Принимает короткую ссылку или владельца (X-Actor, с которым создавались ссылки). Удаляет ссылки без возможности восстановления, обезличивает их записи в журнале изменений и удаляет их аналитику. Возвращает отчет о том, что было удалено",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Удаление данных ссылки или владельца",
+                "operationId": "erase-data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer токен оператора",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Что удалить",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErasureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ErasureReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/landing_pages": {
+            "post": {
+                "description": "Принимает заголовок, список ссылок с заголовками, необязательные alias и домен. Возвращает короткую ссылку на страницу",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Создание страницы со ссылками",
+                "operationId": "create-landing-page",
+                "parameters": [
+                    {
+                        "description": "Страница со ссылками",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LandingPageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.LandingPageData"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
         "/api/save_url": {
             "post": {
-                "description": "Принимает исходную ссылку, создает короткую ссылку и возвращает короткую ссылку",
+                "description": "Принимает исходную ссылку, необязательные alias, теги, id кампании, домен и запасную ссылку, создает короткую ссылку и возвращает короткую ссылку",
                 "consumes": [
                     "application/json"
                 ],
@@ -38,6 +199,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/dto.LongURLData"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности, повторный запрос с тем же ключом в течение 24 часов вернет первый ответ",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -53,6 +220,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.Body"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -77,7 +256,7 @@ const docTemplate = `{
         },
         "/api/top_urls": {
             "get": {
-                "description": "Принимает page и limit. Возвращает список популярных url. Поддерживает пагинацию",
+                "description": "Принимает page, limit, rank_by и window. Возвращает список популярных url с метаданными страниц назначения. С rank_by=human ссылки ранжируются только по переходам людей, без переходов ботов. С window считаются только события за последний час, сутки, 7 или 30 дней, а с window=custom за промежуток [from, to). Окна длиннее суток и окна старше 3 дней считаются по часам, поэтому первый час окна учитывается целиком. Поддерживает пагинацию",
                 "consumes": [
                     "application/json"
                 ],
@@ -101,6 +280,42 @@ const docTemplate = `{
                         "description": "Максимальное количество url на странице",
                         "name": "limit",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "human"
+                        ],
+                        "type": "string",
+                        "description": "По каким переходам ранжировать",
+                        "name": "rank_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "1h",
+                            "24h",
+                            "7d",
+                            "30d",
+                            "custom"
+                        ],
+                        "type": "string",
+                        "description": "За какое время считать события",
+                        "name": "window",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало окна custom в RFC3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец окна custom в RFC3339, не включается",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -125,26 +340,143 @@ const docTemplate = `{
                 }
             }
         },
-        "/{short_url}": {
+        "/api/urls": {
             "get": {
-                "description": "Принимает короткую ссылку в path параметрах и производит редирект на исходную ссылку",
+                "description": "Принимает необязательные фильтры: domain, tag, campaign_id, домен исходной ссылки, подстроку исходной ссылки и даты создания. Ссылки сортируются по дате создания, страницы листаются по cursor из предыдущего ответа или по page. Возвращает список ссылок",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "url"
                 ],
-                "summary": "Редирект с короткой ссылки на исходную ссылку",
-                "operationId": "follow-url",
+                "summary": "Получение списка ссылок",
+                "operationId": "list-urls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тег",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "id кампании",
+                        "name": "campaign_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен исходной ссылки, включая поддомены",
+                        "name": "destination_domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Подстрока исходной ссылки",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Первый день создания, YYYY-MM-DD",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Последний день создания, YYYY-MM-DD",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "Сортировка по дате создания: desc или asc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor из предыдущего ответа",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Страница, не учитывается вместе с cursor",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Максимальное количество ссылок на странице",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}": {
+            "get": {
+                "description": "Возвращает исходную ссылку, дату создания, тип перенаправления, статус и метаданные страницы. Переход по ссылке при этом не засчитывается",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Получение информации о короткой ссылке",
+                "operationId": "expand-url",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "короткая ссылка",
-                        "name": "id",
-                        "in": "query",
+                        "name": "short_url",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "302": {
-                        "description": "Found"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ExpandedURL"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -165,15 +497,785 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.LongURLData": {
+            },
+            "delete": {
+                "description": "Скрывает ссылку, переходы по ней перестают работать. Ссылку можно восстановить до restorable_until, после этого она удаляется навсегда",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Удаление короткой ссылки",
+                "operationId": "delete-url",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.DeletedURL"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/audit": {
+            "get": {
+                "description": "Возвращает записи о создании и изменениях ссылки: кто и с какого ip изменил ссылку, значения до и после изменения и время",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Получение истории изменений короткой ссылки",
+                "operationId": "get-url-audit-log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AuditLogResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/clicks": {
+            "get": {
+                "description": "Принимает короткую ссылку в path параметрах и список измерений через запятую в by: device_type, os_family, browser_family, is_bot. Возвращает количество переходов для каждого сочетания значений измерений",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Разбивка переходов по устройствам, ОС и браузерам",
+                "operationId": "get-click-breakdown",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "device_type",
+                        "description": "Измерения через запятую",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClickBreakdown"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/health": {
+            "get": {
+                "description": "Возвращает результат последней проверки исходной ссылки. Ссылка помечается, если несколько проверок подряд завершились ошибкой",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Получение состояния исходной ссылки",
+                "operationId": "get-url-health",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLHealth"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/preview": {
+            "put": {
+                "description": "Принимает заголовок, описание и картинку, которые боты соцсетей показывают в превью короткой ссылки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Изменение превью короткой ссылки",
+                "operationId": "update-url-preview",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Превью",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLPreview"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLPreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/restore": {
+            "post": {
+                "description": "Возвращает удаленную ссылку, если срок восстановления еще не прошел",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Восстановление удаленной короткой ссылки",
+                "operationId": "restore-url",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLInfo"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/urls/{short_url}/stats": {
+            "get": {
+                "description": "Принимает короткую ссылку в path параметрах, размер интервала (minute, hour, day, week), границы from и to в RFC3339 и часовой пояс tz (IANA, по умолчанию UTC). Возвращает количество переходов и созданий по интервалам от from до to, интервалы без событий заполнены нулями. Дни и недели (с понедельника) начинаются в часовом поясе tz. По умолчанию to текущее время, а from зависит от размера интервала: час для minute, сутки для hour, 30 дней для day и 12 недель для week",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "История переходов и созданий ссылки",
+                "operationId": "get-url-time-series",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Короткая ссылка",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Домен",
+                        "name": "domain",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "minute",
+                            "hour",
+                            "day",
+                            "week"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Размер интервала",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Начало, RFC3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Конец, RFC3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "UTC",
+                        "description": "Часовой пояс",
+                        "name": "tz",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.URLTimeSeries"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/l/{short_url}/{item}": {
+            "get": {
+                "description": "Принимает короткую ссылку страницы и номер ссылки на ней, учитывает переход и производит редирект",
+                "tags": [
+                    "url"
+                ],
+                "summary": "Редирект со ссылки на странице со ссылками",
+                "operationId": "follow-landing-item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка страницы",
+                        "name": "short_url",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "номер ссылки на странице",
+                        "name": "item",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        },
+        "/{short_url}": {
+            "get": {
+                "description": "Принимает короткую ссылку в path параметрах и производит редирект на исходную ссылку или отдает страницу со ссылками. Ссылка ищется среди ссылок домена из заголовка Host. Боты соцсетей получают страницу с Open Graph разметкой вместо редиректа. Если исходная ссылка не проходит проверки и включен запасной переход, редирект идет на запасную ссылку",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "url"
+                ],
+                "summary": "Редирект с короткой ссылки на исходную ссылку",
+                "operationId": "follow-url",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "короткая ссылка",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница со ссылками или Open Graph разметкой",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "302": {
+                        "description": "Found"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Body"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AuditLogResponse": {
+            "type": "object",
+            "properties": {
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.AuditRecord"
+                    }
+                }
+            }
+        },
+        "dto.AuditRecord": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "new_value": {
+                    "type": "object"
+                },
+                "old_value": {
+                    "type": "object"
+                },
+                "source_ip": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CampaignStats": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "string"
+                },
+                "create_count": {
+                    "type": "integer"
+                },
+                "follow_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ClickBreakdown": {
             "type": "object",
             "properties": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ClickBreakdownRow"
+                    }
+                },
+                "short_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ClickBreakdownRow": {
+            "type": "object",
+            "properties": {
+                "clicks": {
+                    "type": "integer"
+                },
+                "dimensions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.DeletedURL": {
+            "type": "object",
+            "properties": {
+                "restorable_until": {
+                    "type": "integer"
+                },
+                "short_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErasedRows": {
+            "type": "object",
+            "properties": {
+                "rows": {
+                    "type": "integer"
+                },
+                "table": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErasedURL": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "short_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ErasureReport": {
+            "type": "object",
+            "properties": {
+                "analytics_rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ErasedRows"
+                    }
+                },
+                "audit_records_redacted": {
+                    "type": "integer"
+                },
+                "links_deleted": {
+                    "type": "integer"
+                },
+                "outbox_events_deleted": {
+                    "type": "integer"
+                },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ErasedURL"
+                    }
+                }
+            }
+        },
+        "dto.ErasureRequest": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "short_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ExpandedURL": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "landing_page": {
+                    "$ref": "#/definitions/dto.LandingPage"
+                },
+                "long_url": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/dto.URLMetadata"
+                },
+                "redirect_type": {
+                    "type": "string"
+                },
+                "short_url": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.LandingItem": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LandingPage": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LandingItem"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LandingPageData": {
+            "type": "object",
+            "properties": {
+                "short_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LandingPageRequest": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.LandingItem"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LongURLData": {
+            "type": "object",
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "backup_url": {
+                    "type": "string"
+                },
+                "campaign_id": {
+                    "type": "string"
+                },
+                "domain": {
+                    "type": "string"
+                },
                 "long_url": {
                     "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -197,18 +1299,45 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.TimeSeriesPoint": {
+            "type": "object",
+            "properties": {
+                "create_count": {
+                    "type": "integer"
+                },
+                "follow_count": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.TopURLData": {
             "type": "object",
             "properties": {
+                "bot_follow_count": {
+                    "type": "integer"
+                },
                 "create_count": {
                     "type": "integer"
                 },
+                "domain": {
+                    "description": "Domain is the branded domain ShortURL is on, empty for the default one",
+                    "type": "string"
+                },
                 "follow_count": {
                     "type": "integer"
                 },
+                "human_follow_count": {
+                    "type": "integer"
+                },
                 "long_url": {
                     "type": "string"
                 },
+                "metadata": {
+                    "$ref": "#/definitions/dto.URLMetadata"
+                },
                 "short_url": {
                     "type": "string"
                 }
@@ -228,6 +1357,129 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.URLHealth": {
+            "type": "object",
+            "properties": {
+                "checked_at": {
+                    "type": "integer"
+                },
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "flagged": {
+                    "type": "boolean"
+                },
+                "last_success_at": {
+                    "type": "integer"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "status_code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.URLInfo": {
+            "type": "object",
+            "properties": {
+                "campaign_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "long_url": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/dto.URLMetadata"
+                },
+                "short_url": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.URLListResponse": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.URLInfo"
+                    }
+                }
+            }
+        },
+        "dto.URLMetadata": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "favicon_url": {
+                    "type": "string"
+                },
+                "fetched_at": {
+                    "type": "integer"
+                },
+                "final_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.URLPreview": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.URLTimeSeries": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "granularity": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TimeSeriesPoint"
+                    }
+                },
+                "short_url": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
         "dto.URlData": {
             "type": "object",
             "properties": {
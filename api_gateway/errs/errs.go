@@ -6,4 +6,6 @@ var (
 	ErrInternal        = errors.New("internal error")
 	ErrNotFound        = errors.New("not found")
 	ErrInvalidArgument = errors.New("invalid argument")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrKeyReused       = errors.New("idempotency key reused")
 )
@@ -5,14 +5,21 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	// The alpine image has no timezone database, time series are shown in any timezone
+	_ "time/tzdata"
 
+	"api_gateway/internal/clickfraud"
 	"api_gateway/internal/client"
 	"api_gateway/internal/config"
 	"api_gateway/internal/converter"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/privacy"
 	"api_gateway/internal/transport/rest"
 	"api_gateway/internal/transport/rest/middlewares"
+	"api_gateway/internal/useragent"
 	"api_gateway/pkg/proto/analytics"
 	"api_gateway/pkg/proto/url"
+	"github.com/redis/go-redis/v9"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
@@ -60,9 +67,79 @@ func setupLogger(env string) (*slog.Logger, error) {
 	return logger, nil
 }
 
+func setupDomainRegistry(cfg config.Config) *domains.Registry {
+	extraDomains := make([]domains.Domain, len(cfg.ExtraDomains))
+	for i, domainCfg := range cfg.ExtraDomains {
+		extraDomains[i] = domains.Domain{Host: domainCfg.Host, BaseURL: domainCfg.BaseURL}
+	}
+
+	return domains.NewRegistry(
+		domains.Domain{Host: cfg.ServerDomain.Host, BaseURL: cfg.ServerDomain.BaseURL},
+		extraDomains,
+	)
+}
+
+func setupIPAnonymizer(logger *slog.Logger, cfg config.PrivacyConfig) privacy.IPAnonymizer {
+	if cfg.IPAnonymization == config.IPAnonymizationTruncate {
+		return privacy.NewTruncatingAnonymizer()
+	}
+
+	if cfg.SaltRedis.Host == "" {
+		return privacy.NewHashingAnonymizer(logger, privacy.NewMemorySaltStore())
+	}
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", cfg.SaltRedis.Host, cfg.SaltRedis.Port),
+		Password: cfg.SaltRedis.Password,
+		DB:       0,
+	})
+
+	return privacy.NewHashingAnonymizer(logger, privacy.NewRedisSaltStore(redisClient))
+}
+
+func setupUserAgentParser(rulesPath string) *useragent.Parser {
+	if rulesPath == "" {
+		return useragent.NewDefaultParser()
+	}
+
+	rules, err := os.ReadFile(rulesPath)
+	if err != nil {
+		panic(err)
+	}
+
+	parser, err := useragent.NewParser(rules)
+	if err != nil {
+		panic(err)
+	}
+
+	return parser
+}
+
+func setupClickScorer(rulesPath string) *clickfraud.Scorer {
+	if rulesPath == "" {
+		return clickfraud.NewDefaultScorer()
+	}
+
+	rules, err := os.ReadFile(rulesPath)
+	if err != nil {
+		panic(err)
+	}
+
+	scorer, err := clickfraud.NewScorer(rules)
+	if err != nil {
+		panic(err)
+	}
+
+	return scorer
+}
+
 func runHttpServer(logger *slog.Logger, cfg config.Config) {
 	topUrlConverter := converter.NewTopURLConverter()
 	paginationConverter := converter.NewPaginationConverter()
+	urlInfoConverter := converter.NewURLInfoConverter()
+	landingPageConverter := converter.NewLandingPageConverter()
+	previewConverter := converter.NewPreviewConverter()
+	metadataConverter := converter.NewMetadataConverter()
 
 	urlTarget := fmt.Sprintf("%s:%s", cfg.UrlServiceConfig.Host, cfg.UrlServiceConfig.Port)
 	urlTransportOpt := grpc.WithTransportCredentials(insecure.NewCredentials())
@@ -89,18 +166,67 @@ func runHttpServer(logger *slog.Logger, cfg config.Config) {
 		logger, limiter,
 	)
 
-	urlClient := client.NewGrpcUrlClient(logger, grpcUrlClient)
-	urlHandler := rest.NewURLHandler(logger, urlClient, cfg.ServerDomain)
-	analyticsHandler := rest.NewAnalyticsHandler(logger, analyticsClient)
+	urlClient := client.NewGrpcUrlClient(
+		logger, grpcUrlClient, urlInfoConverter, landingPageConverter, previewConverter, metadataConverter,
+	)
+	domainRegistry := setupDomainRegistry(cfg)
+	urlHandler := rest.NewURLHandler(
+		logger, urlClient, domainRegistry, cfg.FallbackToBackup,
+		setupIPAnonymizer(logger, cfg.PrivacyConfig), setupUserAgentParser(cfg.UARulesPath),
+		setupClickScorer(cfg.ClickFraudRulesPath),
+	)
+	analyticsHandler := rest.NewAnalyticsHandler(logger, analyticsClient, urlClient, domainRegistry)
+	erasureHandler := rest.NewErasureHandler(
+		logger, urlClient, analyticsClient, domainRegistry, cfg.PrivacyConfig.ErasureOperators,
+	)
 
 	mux := http.NewServeMux()
 	mux.Handle("GET /api/top_urls", rateLimitMiddleware.RateLimit(
 		http.HandlerFunc(analyticsHandler.GetTopURLs),
 	))
+	mux.Handle("GET /api/campaigns/{campaign_id}/stats", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(analyticsHandler.GetCampaignStats),
+	))
+	mux.Handle("GET /api/urls", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.ListURLs),
+	))
 	mux.Handle("POST /api/save_url", rateLimitMiddleware.RateLimit(
 		http.HandlerFunc(urlHandler.SaveURL),
 	))
 	mux.HandleFunc("OPTIONS /api/save_url", urlHandler.SaveURLOptions)
+	mux.Handle("POST /api/landing_pages", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.CreateLandingPage),
+	))
+	mux.Handle("GET /api/urls/{short_url}", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.ExpandURL),
+	))
+	mux.Handle("PUT /api/urls/{short_url}/preview", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.UpdateURLPreview),
+	))
+	mux.Handle("GET /api/urls/{short_url}/health", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.GetURLHealth),
+	))
+	mux.Handle("GET /api/urls/{short_url}/stats", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(analyticsHandler.GetURLTimeSeries),
+	))
+	mux.Handle("GET /api/urls/{short_url}/clicks", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(analyticsHandler.GetClickBreakdown),
+	))
+	mux.Handle("GET /api/urls/{short_url}/audit", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.GetURLAuditLog),
+	))
+	mux.Handle("DELETE /api/urls/{short_url}", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.DeleteURL),
+	))
+	mux.Handle("POST /api/urls/{short_url}/restore", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.RestoreURL),
+	))
+	mux.Handle("POST /api/erasure", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(erasureHandler.EraseData),
+	))
+	mux.Handle("GET /l/{short_url}/{item}", rateLimitMiddleware.RateLimit(
+		http.HandlerFunc(urlHandler.FollowLandingItem),
+	))
 	mux.Handle("GET /{short_url}", rateLimitMiddleware.RateLimit(
 		http.HandlerFunc(urlHandler.FollowUrl),
 	))
@@ -0,0 +1,220 @@
+package clickfraud
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// MaxScore is the score of a click that is certainly not human
+const MaxScore = 100
+
+const (
+	ReasonKnownBot      = "known_bot"
+	ReasonBurst         = "burst"
+	reasonMissingPrefix = "missing_"
+)
+
+// defaultRules are the rules the gateway is built with, CLICK_FRAUD_RULES_PATH replaces them without a rebuild
+//
+//go:embed rules.json
+var defaultRules []byte
+
+// Click is what the scorer knows about a follow
+type Click struct {
+	// IP is the address the burst rate is counted for, it is only kept in memory for a burst window
+	IP       string
+	ShortURL string
+	Header   http.Header
+	// KnownBot is set when the User-Agent is one of a known crawler or http client
+	KnownBot bool
+}
+
+// Verdict is the sum of the scores of the rules a click matched, capped at MaxScore.
+// Bot is set once the score reaches the threshold
+type Verdict struct {
+	Score   int
+	Bot     bool
+	Reasons []string
+}
+
+// Scorer scores follows by rules. It counts the clicks of every ip on every link to catch bursts,
+// so a single scorer has to be shared by all the handlers
+type Scorer struct {
+	threshold      int
+	knownBotScore  int
+	missingHeaders []headerRule
+	burst          burstRule
+
+	mu        sync.Mutex
+	windows   map[burstKey]*burstWindow
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type headerRule struct {
+	header string
+	score  int
+}
+
+// burstRule scores every click of an ip on a link past the first clicks within window
+type burstRule struct {
+	clicks int
+	window time.Duration
+	score  int
+}
+
+type burstKey struct {
+	ip       string
+	shortURL string
+}
+
+type burstWindow struct {
+	start  time.Time
+	clicks int
+}
+
+type rulesFile struct {
+	Threshold      int          `json:"threshold"`
+	KnownBotScore  int          `json:"known_bot_score"`
+	MissingHeaders []headerJSON `json:"missing_headers"`
+	Burst          *burstJSON   `json:"burst"`
+}
+
+type headerJSON struct {
+	Header string `json:"header"`
+	Score  int    `json:"score"`
+}
+
+type burstJSON struct {
+	Clicks int    `json:"clicks"`
+	Window string `json:"window"`
+	Score  int    `json:"score"`
+}
+
+// NewScorer reads json rules, see rules.json for the format. A rule with no score is off
+func NewScorer(rulesJSON []byte) (*Scorer, error) {
+	var file rulesFile
+	err := json.Unmarshal(rulesJSON, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	if file.Threshold <= 0 || file.Threshold > MaxScore {
+		return nil, fmt.Errorf("threshold must be in 1..%d", MaxScore)
+	}
+
+	scorer := &Scorer{
+		threshold:     file.Threshold,
+		knownBotScore: file.KnownBotScore,
+		windows:       make(map[burstKey]*burstWindow),
+		now:           time.Now,
+	}
+
+	for _, entry := range file.MissingHeaders {
+		if entry.Header == "" {
+			return nil, errors.New("missing header rule without header")
+		}
+		scorer.missingHeaders = append(scorer.missingHeaders, headerRule{
+			header: http.CanonicalHeaderKey(entry.Header),
+			score:  entry.Score,
+		})
+	}
+
+	if file.Burst != nil && file.Burst.Score > 0 {
+		window, err := time.ParseDuration(file.Burst.Window)
+		if err != nil {
+			return nil, fmt.Errorf("burst window: %w", err)
+		}
+		if window <= 0 || file.Burst.Clicks <= 0 {
+			return nil, errors.New("burst clicks and window must be positive")
+		}
+
+		scorer.burst = burstRule{
+			clicks: file.Burst.Clicks,
+			window: window,
+			score:  file.Burst.Score,
+		}
+	}
+
+	return scorer, nil
+}
+
+// NewDefaultScorer uses the embedded rules
+func NewDefaultScorer() *Scorer {
+	scorer, err := NewScorer(defaultRules)
+	if err != nil {
+		panic(err)
+	}
+
+	return scorer
+}
+
+func (s *Scorer) Score(click Click) Verdict {
+	var verdict Verdict
+	add := func(score int, reason string) {
+		if score <= 0 {
+			return
+		}
+		verdict.Score += score
+		verdict.Reasons = append(verdict.Reasons, reason)
+	}
+
+	if click.KnownBot {
+		add(s.knownBotScore, ReasonKnownBot)
+	}
+	for _, rule := range s.missingHeaders {
+		if click.Header.Get(rule.header) == "" {
+			add(rule.score, reasonMissingPrefix+rule.header)
+		}
+	}
+	if s.countBurst(click) {
+		add(s.burst.score, ReasonBurst)
+	}
+
+	verdict.Score = min(verdict.Score, MaxScore)
+	verdict.Bot = verdict.Score >= s.threshold
+
+	return verdict
+}
+
+// countBurst counts the click and tells if the ip clicked the link more than allowed within the window
+func (s *Scorer) countBurst(click Click) bool {
+	if s.burst.score <= 0 || click.IP == "" {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	key := burstKey{ip: click.IP, shortURL: click.ShortURL}
+	window, ok := s.windows[key]
+	if !ok || now.Sub(window.start) >= s.burst.window {
+		window = &burstWindow{start: now}
+		s.windows[key] = window
+	}
+	window.clicks++
+
+	return window.clicks > s.burst.clicks
+}
+
+// sweep forgets the windows that are over, at most once per window
+func (s *Scorer) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.burst.window {
+		return
+	}
+	s.lastSweep = now
+
+	for key, window := range s.windows {
+		if now.Sub(window.start) >= s.burst.window {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package clickfraud
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordedClick is a follow recorded from real traffic, at_ms is the time since the first one
+type recordedClick struct {
+	Note            string            `json:"note"`
+	AtMs            int64             `json:"at_ms"`
+	IP              string            `json:"ip"`
+	ShortURL        string            `json:"short_url"`
+	Headers         map[string]string `json:"headers"`
+	ExpectedBot     bool              `json:"expected_bot"`
+	ExpectedReasons []string          `json:"expected_reasons"`
+}
+
+func TestScoreRecordedTraffic(t *testing.T) {
+	content, err := os.ReadFile("testdata/traffic.json")
+	assert.NoError(t, err)
+
+	var traffic []recordedClick
+	assert.NoError(t, json.Unmarshal(content, &traffic))
+
+	start := time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC)
+	var now time.Time
+
+	scorer := NewDefaultScorer()
+	scorer.now = func() time.Time { return now }
+	uaParser := useragent.NewDefaultParser()
+
+	// The clicks are replayed in order, bursts depend on the clicks before
+	for _, recorded := range traffic {
+		now = start.Add(time.Duration(recorded.AtMs) * time.Millisecond)
+
+		header := make(http.Header)
+		for key, value := range recorded.Headers {
+			header.Set(key, value)
+		}
+
+		verdict := scorer.Score(Click{
+			IP:       recorded.IP,
+			ShortURL: recorded.ShortURL,
+			Header:   header,
+			KnownBot: uaParser.Parse(header.Get("User-Agent")).Bot,
+		})
+
+		assert.Equal(t, recorded.ExpectedBot, verdict.Bot, recorded.Note)
+		assert.ElementsMatch(t, recorded.ExpectedReasons, verdict.Reasons, recorded.Note)
+		assert.LessOrEqual(t, verdict.Score, MaxScore, recorded.Note)
+	}
+}
+
+func TestNewScorer(t *testing.T) {
+	t.Run("Custom rules replace the embedded ones", func(t *testing.T) {
+		scorer, err := NewScorer([]byte(`{"threshold": 40, "missing_headers": [{"header": "referer", "score": 40}]}`))
+		assert.NoError(t, err)
+
+		verdict := scorer.Score(Click{IP: "203.0.113.1", ShortURL: "short", Header: http.Header{}, KnownBot: true})
+		assert.Equal(t, Verdict{Score: 40, Bot: true, Reasons: []string{"missing_Referer"}}, verdict)
+
+		verdict = scorer.Score(Click{Header: http.Header{"Referer": {"https://t.co/"}}})
+		assert.Equal(t, Verdict{}, verdict)
+	})
+
+	t.Run("Burst rule counts clicks per window", func(t *testing.T) {
+		scorer, err := NewScorer([]byte(`{"threshold": 50, "burst": {"clicks": 1, "window": "10s", "score": 50}}`))
+		assert.NoError(t, err)
+
+		now := time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC)
+		scorer.now = func() time.Time { return now }
+		click := Click{IP: "203.0.113.1", ShortURL: "short", Header: http.Header{}}
+
+		assert.False(t, scorer.Score(click).Bot)
+		assert.True(t, scorer.Score(click).Bot)
+
+		now = now.Add(10 * time.Second)
+		assert.False(t, scorer.Score(click).Bot)
+		assert.Len(t, scorer.windows, 1)
+	})
+
+	t.Run("Invalid threshold is rejected", func(t *testing.T) {
+		_, err := NewScorer([]byte(`{"threshold": 0}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid burst window is rejected", func(t *testing.T) {
+		_, err := NewScorer([]byte(`{"threshold": 70, "burst": {"clicks": 5, "window": "soon", "score": 70}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid json is rejected", func(t *testing.T) {
+		_, err := NewScorer([]byte(`{"threshold": `))
+		assert.Error(t, err)
+	})
+}
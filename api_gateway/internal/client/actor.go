@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Metadata keys read by the url service for the audit log
+const (
+	actorMetadataKey    = "x-actor"
+	sourceIPMetadataKey = "x-source-ip"
+)
+
+const idempotencyKeyMetadataKey = "idempotency-key"
+
+// WithActor passes the user behind a request to the url service, an empty actor stays anonymous
+func WithActor(ctx context.Context, actor string, sourceIP string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, actorMetadataKey, actor, sourceIPMetadataKey, sourceIP)
+}
+
+// WithIdempotencyKey makes the url service replay the first response to retries with the same key
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, idempotencyKeyMetadataKey, key)
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"api_gateway/errs"
 	"api_gateway/internal/converter"
@@ -14,7 +15,49 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name AnalyticsClient
 type AnalyticsClient interface {
-	GetTopUrls(ctx context.Context, page int64, limit int64) (dto.TopURLDataResponse, error)
+	GetTopUrls(ctx context.Context, topURLsRequest dto.TopURLsRequest) (dto.TopURLDataResponse, error)
+	GetCampaignStats(ctx context.Context, campaignID string) (dto.CampaignStats, error)
+	EraseUrlAnalytics(ctx context.Context, erasedURLs []dto.ErasedURL) ([]dto.ErasedRows, error)
+	GetClickBreakdown(
+		ctx context.Context,
+		urlDomain string,
+		shortURL string,
+		dimensions []string,
+	) ([]dto.ClickBreakdownRow, error)
+	GetUrlTimeSeries(ctx context.Context, timeSeriesRequest dto.TimeSeriesRequest) ([]dto.TimeSeriesPoint, error)
+}
+
+// topURLsRanks maps the rank_by values of the api to the analytics ranks, empty ranks by all follows
+var topURLsRanks = map[string]analytics.TopUrlsRank{
+	"":      analytics.TopUrlsRank_TOP_URLS_RANK_ALL_FOLLOWS,
+	"all":   analytics.TopUrlsRank_TOP_URLS_RANK_ALL_FOLLOWS,
+	"human": analytics.TopUrlsRank_TOP_URLS_RANK_HUMAN_FOLLOWS,
+}
+
+// topURLsWindows maps the window values of the api to the analytics windows, empty is all time
+var topURLsWindows = map[string]analytics.TopUrlsWindow{
+	"":       analytics.TopUrlsWindow_TOP_URLS_WINDOW_ALL_TIME,
+	"all":    analytics.TopUrlsWindow_TOP_URLS_WINDOW_ALL_TIME,
+	"1h":     analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_HOUR,
+	"24h":    analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_DAY,
+	"7d":     analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_WEEK,
+	"30d":    analytics.TopUrlsWindow_TOP_URLS_WINDOW_LAST_MONTH,
+	"custom": analytics.TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM,
+}
+
+var timeSeriesGranularities = map[string]analytics.TimeSeriesGranularity{
+	"minute": analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MINUTE,
+	"hour":   analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR,
+	"day":    analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY,
+	"week":   analytics.TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK,
+}
+
+// clickDimensions maps the dimension names of the api to the analytics ones
+var clickDimensions = map[string]analytics.ClickDimension{
+	"device_type":    analytics.ClickDimension_CLICK_DIMENSION_DEVICE_TYPE,
+	"os_family":      analytics.ClickDimension_CLICK_DIMENSION_OS_FAMILY,
+	"browser_family": analytics.ClickDimension_CLICK_DIMENSION_BROWSER_FAMILY,
+	"is_bot":         analytics.ClickDimension_CLICK_DIMENSION_BOT,
 }
 
 type grpcAnalyticsClient struct {
@@ -38,11 +81,31 @@ func NewGrpcAnalyticsClient(
 	}
 }
 
-func (g *grpcAnalyticsClient) GetTopUrls(ctx context.Context, page int64, limit int64) (dto.TopURLDataResponse, error) {
-	topUrlsGrpcResp, err := g.grpcClient.GetTopUrls(context.Background(), &analytics.TopUrlsRequest{
-		Page:  page,
-		Limit: limit,
-	})
+func (g *grpcAnalyticsClient) GetTopUrls(
+	ctx context.Context,
+	topURLsRequest dto.TopURLsRequest,
+) (dto.TopURLDataResponse, error) {
+	rank, ok := topURLsRanks[topURLsRequest.RankBy]
+	if !ok {
+		return dto.TopURLDataResponse{}, errs.ErrInvalidArgument
+	}
+	window, ok := topURLsWindows[topURLsRequest.Window]
+	if !ok {
+		return dto.TopURLDataResponse{}, errs.ErrInvalidArgument
+	}
+
+	pbRequest := &analytics.TopUrlsRequest{
+		Page:   topURLsRequest.Page,
+		Limit:  topURLsRequest.Limit,
+		RankBy: rank,
+		Window: window,
+	}
+	if window == analytics.TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM {
+		pbRequest.From = topURLsRequest.From.Unix()
+		pbRequest.To = topURLsRequest.To.Unix()
+	}
+
+	topUrlsGrpcResp, err := g.grpcClient.GetTopUrls(context.Background(), pbRequest)
 
 	if err != nil {
 		g.logger.Error(err.Error())
@@ -66,3 +129,161 @@ func (g *grpcAnalyticsClient) GetTopUrls(ctx context.Context, page int64, limit
 
 	return topUrlsResp, nil
 }
+
+func (g *grpcAnalyticsClient) GetCampaignStats(ctx context.Context, campaignID string) (dto.CampaignStats, error) {
+	statsGrpcResp, err := g.grpcClient.GetCampaignStats(context.Background(), &analytics.CampaignStatsRequest{
+		CampaignId: campaignID,
+	})
+
+	if err != nil {
+		g.logger.Error(err.Error())
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.CampaignStats{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			return dto.CampaignStats{}, errs.ErrInvalidArgument
+		}
+
+		return dto.CampaignStats{}, errs.ErrInternal
+	}
+
+	return dto.CampaignStats{
+		CampaignID:  statsGrpcResp.CampaignId,
+		FollowCount: statsGrpcResp.FollowCount,
+		CreateCount: statsGrpcResp.CreateCount,
+	}, nil
+}
+
+func (g *grpcAnalyticsClient) EraseUrlAnalytics(ctx context.Context, erasedURLs []dto.ErasedURL) ([]dto.ErasedRows, error) {
+	pbURLs := make([]*analytics.ErasedUrl, len(erasedURLs))
+	for i, erasedURL := range erasedURLs {
+		pbURLs[i] = &analytics.ErasedUrl{
+			Domain:   erasedURL.Domain,
+			ShortUrl: erasedURL.ShortURL,
+		}
+	}
+
+	eraseGrpcResp, err := g.grpcClient.EraseUrlAnalytics(ctx, &analytics.EraseUrlAnalyticsRequest{
+		Urls: pbURLs,
+	})
+
+	if err != nil {
+		g.logger.Error(err.Error())
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return nil, errs.ErrInternal
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			return nil, errs.ErrInvalidArgument
+		}
+
+		return nil, errs.ErrInternal
+	}
+
+	erasedRows := make([]dto.ErasedRows, len(eraseGrpcResp.ErasedRows))
+	for i, tableRows := range eraseGrpcResp.ErasedRows {
+		erasedRows[i] = dto.ErasedRows{
+			Table: tableRows.Table,
+			Rows:  tableRows.Rows,
+		}
+	}
+
+	return erasedRows, nil
+}
+
+func (g *grpcAnalyticsClient) GetClickBreakdown(
+	ctx context.Context,
+	urlDomain string,
+	shortURL string,
+	dimensions []string,
+) ([]dto.ClickBreakdownRow, error) {
+	pbDimensions := make([]analytics.ClickDimension, len(dimensions))
+	for i, dimension := range dimensions {
+		pbDimension, ok := clickDimensions[dimension]
+		if !ok {
+			return nil, errs.ErrInvalidArgument
+		}
+		pbDimensions[i] = pbDimension
+	}
+
+	breakdownGrpcResp, err := g.grpcClient.GetClickBreakdown(ctx, &analytics.ClickBreakdownRequest{
+		ShortUrl:   shortURL,
+		Dimensions: pbDimensions,
+		Domain:     urlDomain,
+	})
+
+	if err != nil {
+		g.logger.Error(err.Error())
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return nil, errs.ErrInternal
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			return nil, errs.ErrInvalidArgument
+		}
+
+		return nil, errs.ErrInternal
+	}
+
+	rows := make([]dto.ClickBreakdownRow, len(breakdownGrpcResp.Rows))
+	for i, row := range breakdownGrpcResp.Rows {
+		rows[i] = dto.ClickBreakdownRow{
+			Dimensions: row.Dimensions,
+			Clicks:     row.Clicks,
+		}
+	}
+
+	return rows, nil
+}
+
+func (g *grpcAnalyticsClient) GetUrlTimeSeries(
+	ctx context.Context,
+	timeSeriesRequest dto.TimeSeriesRequest,
+) ([]dto.TimeSeriesPoint, error) {
+	granularity, ok := timeSeriesGranularities[timeSeriesRequest.Granularity]
+	if !ok {
+		return nil, errs.ErrInvalidArgument
+	}
+
+	timeSeriesGrpcResp, err := g.grpcClient.GetUrlTimeSeries(ctx, &analytics.UrlTimeSeriesRequest{
+		ShortUrl:    timeSeriesRequest.ShortURL,
+		Domain:      timeSeriesRequest.Domain,
+		Granularity: granularity,
+		From:        timeSeriesRequest.From.Unix(),
+		To:          timeSeriesRequest.To.Unix(),
+		Timezone:    timeSeriesRequest.Timezone,
+	})
+
+	if err != nil {
+		g.logger.Error(err.Error())
+
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return nil, errs.ErrInternal
+		}
+
+		if st.Code() == codes.InvalidArgument {
+			return nil, errs.ErrInvalidArgument
+		}
+
+		return nil, errs.ErrInternal
+	}
+
+	points := make([]dto.TimeSeriesPoint, len(timeSeriesGrpcResp.Points))
+	for i, point := range timeSeriesGrpcResp.Points {
+		points[i] = dto.TimeSeriesPoint{
+			Start:       time.Unix(point.Start, 0),
+			FollowCount: point.FollowCount,
+			CreateCount: point.CreateCount,
+		}
+	}
+
+	return points, nil
+}
@@ -14,9 +14,97 @@ type AnalyticsClient struct {
 	mock.Mock
 }
 
-// GetTopUrls provides a mock function with given fields: ctx, page, limit
-func (_m *AnalyticsClient) GetTopUrls(ctx context.Context, page int64, limit int64) (dto.TopURLDataResponse, error) {
-	ret := _m.Called(ctx, page, limit)
+// EraseUrlAnalytics provides a mock function with given fields: ctx, erasedURLs
+func (_m *AnalyticsClient) EraseUrlAnalytics(ctx context.Context, erasedURLs []dto.ErasedURL) ([]dto.ErasedRows, error) {
+	ret := _m.Called(ctx, erasedURLs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUrlAnalytics")
+	}
+
+	var r0 []dto.ErasedRows
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.ErasedURL) ([]dto.ErasedRows, error)); ok {
+		return rf(ctx, erasedURLs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.ErasedURL) []dto.ErasedRows); ok {
+		r0 = rf(ctx, erasedURLs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ErasedRows)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []dto.ErasedURL) error); ok {
+		r1 = rf(ctx, erasedURLs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCampaignStats provides a mock function with given fields: ctx, campaignID
+func (_m *AnalyticsClient) GetCampaignStats(ctx context.Context, campaignID string) (dto.CampaignStats, error) {
+	ret := _m.Called(ctx, campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignStats")
+	}
+
+	var r0 dto.CampaignStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dto.CampaignStats, error)); ok {
+		return rf(ctx, campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dto.CampaignStats); ok {
+		r0 = rf(ctx, campaignID)
+	} else {
+		r0 = ret.Get(0).(dto.CampaignStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClickBreakdown provides a mock function with given fields: ctx, urlDomain, shortURL, dimensions
+func (_m *AnalyticsClient) GetClickBreakdown(ctx context.Context, urlDomain string, shortURL string, dimensions []string) ([]dto.ClickBreakdownRow, error) {
+	ret := _m.Called(ctx, urlDomain, shortURL, dimensions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClickBreakdown")
+	}
+
+	var r0 []dto.ClickBreakdownRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) ([]dto.ClickBreakdownRow, error)); ok {
+		return rf(ctx, urlDomain, shortURL, dimensions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) []dto.ClickBreakdownRow); ok {
+		r0 = rf(ctx, urlDomain, shortURL, dimensions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.ClickBreakdownRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, urlDomain, shortURL, dimensions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTopUrls provides a mock function with given fields: ctx, topURLsRequest
+func (_m *AnalyticsClient) GetTopUrls(ctx context.Context, topURLsRequest dto.TopURLsRequest) (dto.TopURLDataResponse, error) {
+	ret := _m.Called(ctx, topURLsRequest)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTopUrls")
@@ -24,17 +112,47 @@ func (_m *AnalyticsClient) GetTopUrls(ctx context.Context, page int64, limit int
 
 	var r0 dto.TopURLDataResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (dto.TopURLDataResponse, error)); ok {
-		return rf(ctx, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TopURLsRequest) (dto.TopURLDataResponse, error)); ok {
+		return rf(ctx, topURLsRequest)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) dto.TopURLDataResponse); ok {
-		r0 = rf(ctx, page, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TopURLsRequest) dto.TopURLDataResponse); ok {
+		r0 = rf(ctx, topURLsRequest)
 	} else {
 		r0 = ret.Get(0).(dto.TopURLDataResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = rf(ctx, page, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.TopURLsRequest) error); ok {
+		r1 = rf(ctx, topURLsRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrlTimeSeries provides a mock function with given fields: ctx, timeSeriesRequest
+func (_m *AnalyticsClient) GetUrlTimeSeries(ctx context.Context, timeSeriesRequest dto.TimeSeriesRequest) ([]dto.TimeSeriesPoint, error) {
+	ret := _m.Called(ctx, timeSeriesRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUrlTimeSeries")
+	}
+
+	var r0 []dto.TimeSeriesPoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TimeSeriesRequest) ([]dto.TimeSeriesPoint, error)); ok {
+		return rf(ctx, timeSeriesRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.TimeSeriesRequest) []dto.TimeSeriesPoint); ok {
+		r0 = rf(ctx, timeSeriesRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.TimeSeriesPoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.TimeSeriesRequest) error); ok {
+		r1 = rf(ctx, timeSeriesRequest)
 	} else {
 		r1 = ret.Error(1)
 	}
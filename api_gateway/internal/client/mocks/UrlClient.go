@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	dto "api_gateway/internal/transport/rest/dto"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,27 +14,281 @@ type UrlClient struct {
 	mock.Mock
 }
 
-// FollowUrl provides a mock function with given fields: ctx, shortUrl
-func (_m *UrlClient) FollowUrl(ctx context.Context, shortUrl string) (string, error) {
-	ret := _m.Called(ctx, shortUrl)
+// CreateLandingPage provides a mock function with given fields: ctx, landingPageRequest
+func (_m *UrlClient) CreateLandingPage(ctx context.Context, landingPageRequest dto.LandingPageRequest) (string, error) {
+	ret := _m.Called(ctx, landingPageRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FollowUrl")
+		panic("no return value specified for CreateLandingPage")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LandingPageRequest) (string, error)); ok {
+		return rf(ctx, landingPageRequest)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LandingPageRequest) string); ok {
+		r0 = rf(ctx, landingPageRequest)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.LandingPageRequest) error); ok {
+		r1 = rf(ctx, landingPageRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteUrl provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) DeleteUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.DeletedURL, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUrl")
+	}
+
+	var r0 dto.DeletedURL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.DeletedURL, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.DeletedURL); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.DeletedURL)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EraseUrlData provides a mock function with given fields: ctx, req
+func (_m *UrlClient) EraseUrlData(ctx context.Context, req dto.ErasureRequest) (dto.ErasureReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseUrlData")
+	}
+
+	var r0 dto.ErasureReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ErasureRequest) (dto.ErasureReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ErasureRequest) dto.ErasureReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(dto.ErasureReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ErasureRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpandUrl provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) ExpandUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.ExpandedURL, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandUrl")
+	}
+
+	var r0 dto.ExpandedURL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.ExpandedURL, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.ExpandedURL); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.ExpandedURL)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowLandingItem provides a mock function with given fields: ctx, urlDomain, shortUrl, position, click
+func (_m *UrlClient) FollowLandingItem(ctx context.Context, urlDomain string, shortUrl string, position int64, click dto.ClickContext) (string, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, position, click)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowLandingItem")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, dto.ClickContext) (string, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, position, click)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64, dto.ClickContext) string); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, position, click)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64, dto.ClickContext) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, position, click)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowUrl provides a mock function with given fields: ctx, urlDomain, shortUrl, preview, click
+func (_m *UrlClient) FollowUrl(ctx context.Context, urlDomain string, shortUrl string, preview bool, click dto.ClickContext) (dto.FollowData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, preview, click)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowUrl")
+	}
+
+	var r0 dto.FollowData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, dto.ClickContext) (dto.FollowData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, preview, click)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, dto.ClickContext) dto.FollowData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, preview, click)
+	} else {
+		r0 = ret.Get(0).(dto.FollowData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, dto.ClickContext) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, preview, click)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrlAuditLog provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) GetUrlAuditLog(ctx context.Context, urlDomain string, shortUrl string) (dto.AuditLogResponse, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUrlAuditLog")
+	}
+
+	var r0 dto.AuditLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.AuditLogResponse, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.AuditLogResponse); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.AuditLogResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrlHealth provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) GetUrlHealth(ctx context.Context, urlDomain string, shortUrl string) (dto.URLHealth, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUrlHealth")
+	}
+
+	var r0 dto.URLHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.URLHealth, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.URLHealth); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.URLHealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrlInfo provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) GetUrlInfo(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUrlInfo")
+	}
+
+	var r0 dto.URLInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.URLInfo, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.URLInfo); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.URLInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUrlInfos provides a mock function with given fields: ctx, keys
+func (_m *UrlClient) GetUrlInfos(ctx context.Context, keys []dto.URLKey) (map[dto.URLKey]dto.URLInfo, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUrlInfos")
+	}
+
+	var r0 map[dto.URLKey]dto.URLInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.URLKey) (map[dto.URLKey]dto.URLInfo, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []dto.URLKey) map[dto.URLKey]dto.URLInfo); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[dto.URLKey]dto.URLInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []dto.URLKey) error); ok {
+		r1 = rf(ctx, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +296,65 @@ func (_m *UrlClient) FollowUrl(ctx context.Context, shortUrl string) (string, er
 	return r0, r1
 }
 
-// ShortenUrl provides a mock function with given fields: ctx, longUrl
-func (_m *UrlClient) ShortenUrl(ctx context.Context, longUrl string) (string, error) {
-	ret := _m.Called(ctx, longUrl)
+// ListUrls provides a mock function with given fields: ctx, params
+func (_m *UrlClient) ListUrls(ctx context.Context, params dto.URLListParams) (dto.URLListResponse, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUrls")
+	}
+
+	var r0 dto.URLListResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.URLListParams) (dto.URLListResponse, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.URLListParams) dto.URLListResponse); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(dto.URLListResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.URLListParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreUrl provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlClient) RestoreUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUrl")
+	}
+
+	var r0 dto.URLInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dto.URLInfo, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dto.URLInfo); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(dto.URLInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShortenUrl provides a mock function with given fields: ctx, longURLData
+func (_m *UrlClient) ShortenUrl(ctx context.Context, longURLData dto.LongURLData) (string, error) {
+	ret := _m.Called(ctx, longURLData)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ShortenUrl")
@@ -51,17 +362,45 @@ func (_m *UrlClient) ShortenUrl(ctx context.Context, longUrl string) (string, er
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, longUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LongURLData) (string, error)); ok {
+		return rf(ctx, longURLData)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, longUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, dto.LongURLData) string); ok {
+		r0 = rf(ctx, longURLData)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, longUrl)
+	if rf, ok := ret.Get(1).(func(context.Context, dto.LongURLData) error); ok {
+		r1 = rf(ctx, longURLData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUrlPreview provides a mock function with given fields: ctx, urlDomain, shortUrl, preview
+func (_m *UrlClient) UpdateUrlPreview(ctx context.Context, urlDomain string, shortUrl string, preview dto.URLPreview) (dto.URLPreview, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUrlPreview")
+	}
+
+	var r0 dto.URLPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.URLPreview) (dto.URLPreview, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, dto.URLPreview) dto.URLPreview); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, preview)
+	} else {
+		r0 = ret.Get(0).(dto.URLPreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, dto.URLPreview) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, preview)
 	} else {
 		r1 = ret.Error(1)
 	}
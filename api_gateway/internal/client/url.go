@@ -2,9 +2,12 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 
 	"api_gateway/errs"
+	"api_gateway/internal/converter"
+	"api_gateway/internal/transport/rest/dto"
 	"api_gateway/pkg/proto/url"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,54 +15,168 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name UrlClient
 type UrlClient interface {
-	FollowUrl(ctx context.Context, shortUrl string) (string, error)
-	ShortenUrl(ctx context.Context, longUrl string) (string, error)
+	FollowUrl(
+		ctx context.Context, urlDomain string, shortUrl string, preview bool, click dto.ClickContext,
+	) (dto.FollowData, error)
+	ShortenUrl(ctx context.Context, longURLData dto.LongURLData) (string, error)
+	ListUrls(ctx context.Context, params dto.URLListParams) (dto.URLListResponse, error)
+	CreateLandingPage(ctx context.Context, landingPageRequest dto.LandingPageRequest) (string, error)
+	FollowLandingItem(
+		ctx context.Context, urlDomain string, shortUrl string, position int64, click dto.ClickContext,
+	) (string, error)
+	UpdateUrlPreview(ctx context.Context, urlDomain string, shortUrl string, preview dto.URLPreview) (dto.URLPreview, error)
+	GetUrlInfo(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error)
+	// GetUrlInfos looks the links up in one call, the links that are not found are left out of the map
+	GetUrlInfos(ctx context.Context, keys []dto.URLKey) (map[dto.URLKey]dto.URLInfo, error)
+	GetUrlHealth(ctx context.Context, urlDomain string, shortUrl string) (dto.URLHealth, error)
+	ExpandUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.ExpandedURL, error)
+	GetUrlAuditLog(ctx context.Context, urlDomain string, shortUrl string) (dto.AuditLogResponse, error)
+	DeleteUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.DeletedURL, error)
+	RestoreUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error)
+	// EraseUrlData fills everything in the report but the analytics rows
+	EraseUrlData(ctx context.Context, req dto.ErasureRequest) (dto.ErasureReport, error)
 }
 
 type grpcUrlClient struct {
-	logger        *slog.Logger
-	urlGrpcClient url.UrlClient
+	logger               *slog.Logger
+	urlGrpcClient        url.UrlClient
+	urlInfoConverter     converter.URLInfoConverter
+	landingPageConverter converter.LandingPageConverter
+	previewConverter     converter.PreviewConverter
+	metadataConverter    converter.MetadataConverter
 }
 
 func NewGrpcUrlClient(
 	logger *slog.Logger,
 	urlGrpcClient url.UrlClient,
-
+	urlInfoConverter converter.URLInfoConverter,
+	landingPageConverter converter.LandingPageConverter,
+	previewConverter converter.PreviewConverter,
+	metadataConverter converter.MetadataConverter,
 ) UrlClient {
 	return &grpcUrlClient{
-		logger:        logger,
-		urlGrpcClient: urlGrpcClient,
+		logger:               logger,
+		urlGrpcClient:        urlGrpcClient,
+		urlInfoConverter:     urlInfoConverter,
+		landingPageConverter: landingPageConverter,
+		previewConverter:     previewConverter,
+		metadataConverter:    metadataConverter,
 	}
 }
 
-func (u *grpcUrlClient) FollowUrl(ctx context.Context, shortUrl string) (string, error) {
+// FollowUrl with preview set is counted as a crawler hit instead of a follow
+func (u *grpcUrlClient) FollowUrl(
+	ctx context.Context,
+	urlDomain string,
+	shortUrl string,
+	preview bool,
+	click dto.ClickContext,
+) (dto.FollowData, error) {
 	longURLResp, err := u.urlGrpcClient.FollowUrl(ctx, &url.ShortUrlRequest{
 		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+		Preview:  preview,
+		Metadata: mapClickMetadata(click),
 	})
 
 	if err != nil {
 		u.logger.Error(err.Error())
 		st, ok := status.FromError(err)
 		if !ok || st.Code() == codes.Internal {
-			return "", errs.ErrInternal
+			return dto.FollowData{}, errs.ErrInternal
 		}
 
 		if st.Code() == codes.NotFound {
-			return "", errs.ErrNotFound
+			return dto.FollowData{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.FollowData{}, errs.ErrInvalidArgument
+		}
+
+		return dto.FollowData{}, errs.ErrInternal
+	}
+
+	return dto.FollowData{
+		LongURL:     longURLResp.LongUrl,
+		LandingPage: u.landingPageConverter.MapPbToDto(longURLResp.LandingPage),
+		Preview:     u.previewConverter.MapPbToDto(longURLResp.Preview),
+		BackupURL:   longURLResp.BackupUrl,
+		Flagged:     longURLResp.Flagged,
+	}, nil
+}
+
+func (u *grpcUrlClient) ShortenUrl(ctx context.Context, longURLData dto.LongURLData) (string, error) {
+	shortURLResp, err := u.urlGrpcClient.ShortenUrl(ctx, &url.LongUrlRequest{
+		LongUrl:    longURLData.LongURL,
+		Alias:      longURLData.Alias,
+		Tags:       longURLData.Tags,
+		CampaignId: longURLData.CampaignID,
+		Domain:     longURLData.Domain,
+		BackupUrl:  longURLData.BackupURL,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return "", errs.ErrInternal
 		}
 		if st.Code() == codes.InvalidArgument {
 			return "", errs.ErrInvalidArgument
 		}
+		if st.Code() == codes.AlreadyExists {
+			return "", errs.ErrAlreadyExists
+		}
+		if st.Code() == codes.FailedPrecondition {
+			return "", errs.ErrKeyReused
+		}
 
 		return "", errs.ErrInternal
 	}
 
-	return longURLResp.LongUrl, nil
+	return shortURLResp.ShortUrl, nil
+}
+
+func (u *grpcUrlClient) ListUrls(ctx context.Context, params dto.URLListParams) (dto.URLListResponse, error) {
+	listUrlsResp, err := u.urlGrpcClient.ListUrls(context.Background(), &url.ListUrlsRequest{
+		Tag:               params.Tag,
+		CampaignId:        params.CampaignID,
+		Page:              params.Page,
+		Limit:             params.Limit,
+		Domain:            params.Domain,
+		Cursor:            params.Cursor,
+		Order:             params.Order,
+		DestinationDomain: params.DestinationDomain,
+		Search:            params.Search,
+		CreatedFrom:       params.CreatedFrom,
+		CreatedTo:         params.CreatedTo,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.URLListResponse{}, errs.ErrInternal
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.URLListResponse{}, errs.ErrInvalidArgument
+		}
+
+		return dto.URLListResponse{}, errs.ErrInternal
+	}
+
+	return dto.URLListResponse{
+		URLs:       u.urlInfoConverter.MapSlicePbToDto(listUrlsResp.Urls),
+		NextCursor: listUrlsResp.NextCursor,
+	}, nil
 }
 
-func (u *grpcUrlClient) ShortenUrl(ctx context.Context, longUrl string) (string, error) {
-	shortURLResp, err := u.urlGrpcClient.ShortenUrl(context.Background(), &url.LongUrlRequest{
-		LongUrl: longUrl,
+func (u *grpcUrlClient) CreateLandingPage(ctx context.Context, landingPageRequest dto.LandingPageRequest) (string, error) {
+	shortURLResp, err := u.urlGrpcClient.CreateLandingPage(ctx, &url.LandingPageRequest{
+		Title:  landingPageRequest.Title,
+		Items:  u.landingPageConverter.MapItemsDtoToPb(landingPageRequest.Items),
+		Alias:  landingPageRequest.Alias,
+		Domain: landingPageRequest.Domain,
 	})
 
 	if err != nil {
@@ -71,9 +188,371 @@ func (u *grpcUrlClient) ShortenUrl(ctx context.Context, longUrl string) (string,
 		if st.Code() == codes.InvalidArgument {
 			return "", errs.ErrInvalidArgument
 		}
+		if st.Code() == codes.AlreadyExists {
+			return "", errs.ErrAlreadyExists
+		}
 
 		return "", errs.ErrInternal
 	}
 
 	return shortURLResp.ShortUrl, nil
 }
+
+func (u *grpcUrlClient) FollowLandingItem(
+	ctx context.Context,
+	urlDomain string,
+	shortUrl string,
+	position int64,
+	click dto.ClickContext,
+) (string, error) {
+	longURLResp, err := u.urlGrpcClient.FollowLandingItem(ctx, &url.LandingItemRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+		Position: position,
+		Metadata: mapClickMetadata(click),
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return "", errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return "", errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return "", errs.ErrInvalidArgument
+		}
+
+		return "", errs.ErrInternal
+	}
+
+	return longURLResp.LongUrl, nil
+}
+
+func (u *grpcUrlClient) UpdateUrlPreview(
+	ctx context.Context,
+	urlDomain string,
+	shortUrl string,
+	preview dto.URLPreview,
+) (dto.URLPreview, error) {
+	previewResp, err := u.urlGrpcClient.UpdateUrlPreview(ctx, &url.UrlPreviewRequest{
+		ShortUrl:    shortUrl,
+		Domain:      urlDomain,
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.URLPreview{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.URLPreview{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.URLPreview{}, errs.ErrInvalidArgument
+		}
+
+		return dto.URLPreview{}, errs.ErrInternal
+	}
+
+	return *u.previewConverter.MapPbToDto(previewResp), nil
+}
+
+func (u *grpcUrlClient) GetUrlInfo(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error) {
+	urlInfoResp, err := u.urlGrpcClient.GetUrlInfo(ctx, &url.UrlInfoRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.URLInfo{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.URLInfo{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.URLInfo{}, errs.ErrInvalidArgument
+		}
+
+		return dto.URLInfo{}, errs.ErrInternal
+	}
+
+	urlInfo := u.urlInfoConverter.MapPbToDto(urlInfoResp.Url)
+	urlInfo.Metadata = u.metadataConverter.MapPbToDto(urlInfoResp.Metadata)
+
+	return urlInfo, nil
+}
+
+func (u *grpcUrlClient) GetUrlInfos(ctx context.Context, keys []dto.URLKey) (map[dto.URLKey]dto.URLInfo, error) {
+	urlInfoReqs := make([]*url.UrlInfoRequest, len(keys))
+	for i, key := range keys {
+		urlInfoReqs[i] = &url.UrlInfoRequest{
+			ShortUrl: key.ShortURL,
+			Domain:   key.Domain,
+		}
+	}
+
+	urlInfosResp, err := u.urlGrpcClient.GetUrlInfos(ctx, &url.UrlInfosRequest{Urls: urlInfoReqs})
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if ok && st.Code() == codes.InvalidArgument {
+			return nil, errs.ErrInvalidArgument
+		}
+
+		return nil, errs.ErrInternal
+	}
+
+	urlInfos := make(map[dto.URLKey]dto.URLInfo, len(urlInfosResp.Urls))
+	for _, urlInfoResp := range urlInfosResp.Urls {
+		urlInfo := u.urlInfoConverter.MapPbToDto(urlInfoResp.Url)
+		urlInfo.Metadata = u.metadataConverter.MapPbToDto(urlInfoResp.Metadata)
+
+		key := dto.URLKey{Domain: urlInfoResp.Url.GetDomain(), ShortURL: urlInfoResp.Url.GetShortUrl()}
+		urlInfos[key] = urlInfo
+	}
+
+	return urlInfos, nil
+}
+
+func (u *grpcUrlClient) GetUrlHealth(ctx context.Context, urlDomain string, shortUrl string) (dto.URLHealth, error) {
+	healthResp, err := u.urlGrpcClient.GetUrlHealth(ctx, &url.UrlHealthRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.URLHealth{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.URLHealth{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.URLHealth{}, errs.ErrInvalidArgument
+		}
+
+		return dto.URLHealth{}, errs.ErrInternal
+	}
+
+	return dto.URLHealth{
+		StatusCode:          healthResp.StatusCode,
+		LatencyMs:           healthResp.LatencyMs,
+		CheckedAt:           healthResp.CheckedAt,
+		LastSuccessAt:       healthResp.LastSuccessAt,
+		ConsecutiveFailures: healthResp.ConsecutiveFailures,
+		Flagged:             healthResp.Flagged,
+	}, nil
+}
+
+func (u *grpcUrlClient) ExpandUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.ExpandedURL, error) {
+	expandResp, err := u.urlGrpcClient.ExpandUrl(ctx, &url.UrlInfoRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.ExpandedURL{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.ExpandedURL{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.ExpandedURL{}, errs.ErrInvalidArgument
+		}
+
+		return dto.ExpandedURL{}, errs.ErrInternal
+	}
+
+	urlInfo := u.urlInfoConverter.MapPbToDto(expandResp.Url)
+
+	return dto.ExpandedURL{
+		ShortURL:     urlInfo.ShortURL,
+		LongURL:      urlInfo.LongURL,
+		Tags:         urlInfo.Tags,
+		CampaignID:   urlInfo.CampaignID,
+		CreatedAt:    urlInfo.CreatedAt,
+		RedirectType: expandResp.RedirectType,
+		Status:       expandResp.Status,
+		Metadata:     u.metadataConverter.MapPbToDto(expandResp.Metadata),
+		LandingPage:  u.landingPageConverter.MapPbToDto(expandResp.LandingPage),
+	}, nil
+}
+
+func (u *grpcUrlClient) GetUrlAuditLog(ctx context.Context, urlDomain string, shortUrl string) (dto.AuditLogResponse, error) {
+	auditLogResp, err := u.urlGrpcClient.GetUrlAuditLog(ctx, &url.UrlAuditLogRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.AuditLogResponse{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.AuditLogResponse{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.AuditLogResponse{}, errs.ErrInvalidArgument
+		}
+
+		return dto.AuditLogResponse{}, errs.ErrInternal
+	}
+
+	records := make([]dto.AuditRecord, len(auditLogResp.Records))
+	for i, record := range auditLogResp.Records {
+		records[i] = dto.AuditRecord{
+			Action:    record.Action,
+			Actor:     record.Actor,
+			SourceIP:  record.SourceIp,
+			OldValue:  auditValue(record.OldValue),
+			NewValue:  auditValue(record.NewValue),
+			CreatedAt: record.CreatedAt,
+		}
+	}
+
+	return dto.AuditLogResponse{Records: records}, nil
+}
+
+func (u *grpcUrlClient) DeleteUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.DeletedURL, error) {
+	deleteResp, err := u.urlGrpcClient.DeleteUrl(ctx, &url.UrlInfoRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.DeletedURL{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.DeletedURL{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.DeletedURL{}, errs.ErrInvalidArgument
+		}
+
+		return dto.DeletedURL{}, errs.ErrInternal
+	}
+
+	return dto.DeletedURL{
+		ShortURL:        shortUrl,
+		RestorableUntil: deleteResp.RestorableUntil,
+	}, nil
+}
+
+func (u *grpcUrlClient) RestoreUrl(ctx context.Context, urlDomain string, shortUrl string) (dto.URLInfo, error) {
+	urlInfoResp, err := u.urlGrpcClient.RestoreUrl(ctx, &url.UrlInfoRequest{
+		ShortUrl: shortUrl,
+		Domain:   urlDomain,
+	})
+
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.URLInfo{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.URLInfo{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.URLInfo{}, errs.ErrInvalidArgument
+		}
+
+		return dto.URLInfo{}, errs.ErrInternal
+	}
+
+	return u.urlInfoConverter.MapPbToDto(urlInfoResp), nil
+}
+
+func (u *grpcUrlClient) EraseUrlData(ctx context.Context, req dto.ErasureRequest) (dto.ErasureReport, error) {
+	eraseReq := &url.EraseUrlDataRequest{Domain: req.Domain}
+	if req.Owner != "" {
+		eraseReq.Subject = &url.EraseUrlDataRequest_Owner{Owner: req.Owner}
+	} else {
+		eraseReq.Subject = &url.EraseUrlDataRequest_ShortUrl{ShortUrl: req.ShortURL}
+	}
+
+	eraseResp, err := u.urlGrpcClient.EraseUrlData(ctx, eraseReq)
+	if err != nil {
+		u.logger.Error(err.Error())
+		st, ok := status.FromError(err)
+		if !ok || st.Code() == codes.Internal {
+			return dto.ErasureReport{}, errs.ErrInternal
+		}
+
+		if st.Code() == codes.NotFound {
+			return dto.ErasureReport{}, errs.ErrNotFound
+		}
+		if st.Code() == codes.InvalidArgument {
+			return dto.ErasureReport{}, errs.ErrInvalidArgument
+		}
+
+		return dto.ErasureReport{}, errs.ErrInternal
+	}
+
+	erasedURLs := make([]dto.ErasedURL, len(eraseResp.Urls))
+	for i, erasedURL := range eraseResp.Urls {
+		erasedURLs[i] = dto.ErasedURL{
+			Domain:   erasedURL.Domain,
+			ShortURL: erasedURL.ShortUrl,
+		}
+	}
+
+	return dto.ErasureReport{
+		URLs:                 erasedURLs,
+		LinksDeleted:         eraseResp.LinksDeleted,
+		AuditRecordsRedacted: eraseResp.AuditRecordsRedacted,
+		OutboxEventsDeleted:  eraseResp.OutboxEventsDeleted,
+	}, nil
+}
+
+// auditValue leaves an empty value out of the response instead of writing invalid json
+func auditValue(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+
+	return json.RawMessage(value)
+}
+
+func mapClickMetadata(click dto.ClickContext) *url.ClickMetadata {
+	return &url.ClickMetadata{
+		Referrer:       click.Referrer,
+		UserAgent:      click.UserAgent,
+		Ip:             click.IP,
+		AcceptLanguage: click.AcceptLanguage,
+		Host:           click.Host,
+		DeviceType:     click.DeviceType,
+		OsFamily:       click.OSFamily,
+		BrowserFamily:  click.BrowserFamily,
+		Bot:            click.Bot,
+		BotScore:       uint32(click.BotScore),
+	}
+}
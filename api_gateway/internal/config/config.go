@@ -2,8 +2,10 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -15,18 +17,49 @@ const (
 	analyticsServiceHostKey = "ANALYTICS_SERVICE_HOST"
 	analyticsServicePortKey = "ANALYTICS_SERVICE_PORT"
 
-	serverDomainKey = "SERVER_DOMAIN"
+	serverDomainKey  = "SERVER_DOMAIN"
+	serverDomainsKey = "SERVER_DOMAINS"
 
 	rateLimitTokenPerSecondKey = "RATE_LIMIT_TOKEN_PER_SECOND"
 	rateLimitBurstSizeKey      = "RATE_LIMIT_BURST_SIZE"
+
+	fallbackToBackupKey = "FALLBACK_TO_BACKUP_URL"
+
+	ipAnonymizationKey  = "IP_ANONYMIZATION"
+	erasureOperatorsKey = "ERASURE_OPERATORS"
+
+	redisHostKey     = "REDIS_HOST"
+	redisPortKey     = "REDIS_PORT"
+	redisPasswordKey = "REDIS_PASSWORD"
+
+	uaRulesPathKey         = "UA_RULES_PATH"
+	clickFraudRulesPathKey = "CLICK_FRAUD_RULES_PATH"
+)
+
+const (
+	IPAnonymizationTruncate = "truncate"
+	IPAnonymizationHash     = "hash"
 )
 
 type Config struct {
 	Env                    string
-	ServerDomain           string
+	ServerDomain           DomainConfig
+	ExtraDomains           []DomainConfig
 	UrlServiceConfig       UrlServiceConfig
 	AnalyticsServiceConfig AnalyticsServiceConfig
 	RateLimitConfig        RateLimitConfig
+	// FallbackToBackup redirects links flagged by the url service health checks to their backup url
+	FallbackToBackup bool
+	PrivacyConfig    PrivacyConfig
+	// UARulesPath is a user agent rule database that replaces the embedded one, empty keeps the embedded one
+	UARulesPath string
+	// ClickFraudRulesPath are bot scoring rules that replace the embedded ones, empty keeps the embedded ones
+	ClickFraudRulesPath string
+}
+
+type DomainConfig struct {
+	Host    string
+	BaseURL string
 }
 
 type AnalyticsServiceConfig struct {
@@ -39,6 +72,22 @@ type UrlServiceConfig struct {
 	Port string
 }
 
+// PrivacyConfig sets how client ips are anonymized before they are passed with a click.
+// Hashed ips are salted with a random salt per day kept in SaltRedis, so all the replicas share it.
+// Without SaltRedis every replica keeps its own salt in memory.
+// ErasureOperators are the bearer tokens of the operators by name, without them nobody can erase data
+type PrivacyConfig struct {
+	IPAnonymization  string
+	SaltRedis        RedisConfig
+	ErasureOperators map[string]string
+}
+
+type RedisConfig struct {
+	Host     string
+	Port     string
+	Password string
+}
+
 type RateLimitConfig struct {
 	TokensPerSecond float64
 	BurstSize       int
@@ -70,11 +119,32 @@ func ParseConfig() (Config, error) {
 		return Config{}, fmt.Errorf("you did not provide env: %s", analyticsServicePortKey)
 	}
 
-	serverDomain := os.Getenv(serverDomainKey)
-	if serverDomain == "" {
+	serverDomainHost := os.Getenv(serverDomainKey)
+	if serverDomainHost == "" {
 		return Config{}, fmt.Errorf("you did not provide env: %s", serverDomainKey)
 	}
 
+	serverDomain := DomainConfig{
+		Host:    serverDomainHost,
+		BaseURL: "http://" + serverDomainHost,
+	}
+	var extraDomains []DomainConfig
+	serverDomainsRaw := os.Getenv(serverDomainsKey)
+	if serverDomainsRaw != "" {
+		domains, err := parseDomains(serverDomainsRaw)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid env %s: %w", serverDomainsKey, err)
+		}
+
+		for _, domain := range domains {
+			if strings.EqualFold(domain.Host, serverDomain.Host) {
+				serverDomain.BaseURL = domain.BaseURL
+				continue
+			}
+			extraDomains = append(extraDomains, domain)
+		}
+	}
+
 	rateLimitTokenPerSecondRaw := os.Getenv(rateLimitTokenPerSecondKey)
 	if rateLimitTokenPerSecondRaw == "" {
 		return Config{}, fmt.Errorf("you did not provide env: %s", rateLimitTokenPerSecondKey)
@@ -93,9 +163,38 @@ func ParseConfig() (Config, error) {
 		return Config{}, err
 	}
 
+	fallbackToBackup := false
+	fallbackToBackupRaw := os.Getenv(fallbackToBackupKey)
+	if fallbackToBackupRaw != "" {
+		fallbackToBackup, err = strconv.ParseBool(fallbackToBackupRaw)
+		if err != nil {
+			return Config{}, fmt.Errorf("incorrect %s: %s", fallbackToBackupKey, fallbackToBackupRaw)
+		}
+	}
+
+	ipAnonymization := os.Getenv(ipAnonymizationKey)
+	switch ipAnonymization {
+	case "":
+		ipAnonymization = IPAnonymizationTruncate
+	case IPAnonymizationTruncate, IPAnonymizationHash:
+	default:
+		return Config{}, fmt.Errorf("incorrect %s: %s", ipAnonymizationKey, ipAnonymization)
+	}
+
+	redisPort := os.Getenv(redisPortKey)
+	if redisPort == "" {
+		redisPort = "6379"
+	}
+
+	erasureOperators, err := parseOperators(os.Getenv(erasureOperatorsKey))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid env %s: %w", erasureOperatorsKey, err)
+	}
+
 	return Config{
 		Env:          env,
 		ServerDomain: serverDomain,
+		ExtraDomains: extraDomains,
 		UrlServiceConfig: UrlServiceConfig{
 			Host: urlServiceHost,
 			Port: urlServicePort,
@@ -108,5 +207,77 @@ func ParseConfig() (Config, error) {
 			TokensPerSecond: rateLimitTokenPerSecond,
 			BurstSize:       rateLimitBurstSize,
 		},
+		FallbackToBackup: fallbackToBackup,
+		PrivacyConfig: PrivacyConfig{
+			IPAnonymization: ipAnonymization,
+			SaltRedis: RedisConfig{
+				Host:     os.Getenv(redisHostKey),
+				Port:     redisPort,
+				Password: os.Getenv(redisPasswordKey),
+			},
+			ErasureOperators: erasureOperators,
+		},
+		UARulesPath:         os.Getenv(uaRulesPathKey),
+		ClickFraudRulesPath: os.Getenv(clickFraudRulesPathKey),
 	}, nil
 }
+
+// parseDomains reads a comma separated list of host[=base_url],
+// the base url defaults to plain http on the host
+func parseDomains(raw string) ([]DomainConfig, error) {
+	var domains []DomainConfig
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		host, baseURL, found := strings.Cut(entry, "=")
+		host = strings.TrimSpace(host)
+		if host == "" {
+			return nil, fmt.Errorf("empty host in %q", entry)
+		}
+		if !found {
+			domains = append(domains, DomainConfig{Host: host, BaseURL: "http://" + host})
+			continue
+		}
+
+		baseURL = strings.TrimSuffix(strings.TrimSpace(baseURL), "/")
+		parsedURL, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, err
+		}
+		if (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+			return nil, fmt.Errorf("base url %q must be an absolute http or https url", baseURL)
+		}
+
+		domains = append(domains, DomainConfig{Host: host, BaseURL: baseURL})
+	}
+
+	return domains, nil
+}
+
+// parseOperators reads a comma separated list of name=token
+func parseOperators(raw string) (map[string]string, error) {
+	operators := make(map[string]string)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, token, found := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		token = strings.TrimSpace(token)
+		if !found || name == "" || token == "" {
+			return nil, fmt.Errorf("%q must be name=token", name)
+		}
+		if _, ok := operators[name]; ok {
+			return nil, fmt.Errorf("operator %q is repeated", name)
+		}
+
+		operators[name] = token
+	}
+
+	return operators, nil
+}
@@ -0,0 +1,45 @@
+package converter
+
+import (
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/pkg/proto/url"
+)
+
+type LandingPageConverter struct {
+}
+
+func NewLandingPageConverter() LandingPageConverter {
+	return LandingPageConverter{}
+}
+
+func (c *LandingPageConverter) MapPbToDto(pb *url.LandingPage) *dto.LandingPage {
+	if pb == nil {
+		return nil
+	}
+
+	items := make([]dto.LandingItem, len(pb.Items))
+	for i := 0; i < len(pb.Items); i++ {
+		items[i] = dto.LandingItem{
+			Title: pb.Items[i].Title,
+			URL:   pb.Items[i].Url,
+		}
+	}
+
+	return &dto.LandingPage{
+		Title: pb.Title,
+		Items: items,
+	}
+}
+
+func (c *LandingPageConverter) MapItemsDtoToPb(dtos []dto.LandingItem) []*url.LandingItem {
+	pbs := make([]*url.LandingItem, len(dtos))
+
+	for i := 0; i < len(dtos); i++ {
+		pbs[i] = &url.LandingItem{
+			Title: dtos[i].Title,
+			Url:   dtos[i].URL,
+		}
+	}
+
+	return pbs
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/pkg/proto/url"
+)
+
+type MetadataConverter struct {
+}
+
+func NewMetadataConverter() MetadataConverter {
+	return MetadataConverter{}
+}
+
+func (c *MetadataConverter) MapPbToDto(pb *url.UrlMetadata) *dto.URLMetadata {
+	if pb == nil {
+		return nil
+	}
+
+	return &dto.URLMetadata{
+		Title:       pb.Title,
+		Description: pb.Description,
+		FaviconURL:  pb.FaviconUrl,
+		FinalURL:    pb.FinalUrl,
+		FetchedAt:   pb.FetchedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package converter
+
+import (
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/pkg/proto/url"
+)
+
+type PreviewConverter struct {
+}
+
+func NewPreviewConverter() PreviewConverter {
+	return PreviewConverter{}
+}
+
+func (c *PreviewConverter) MapPbToDto(pb *url.UrlPreview) *dto.URLPreview {
+	if pb == nil {
+		return nil
+	}
+
+	return &dto.URLPreview{
+		Title:       pb.Title,
+		Description: pb.Description,
+		ImageURL:    pb.ImageUrl,
+	}
+}
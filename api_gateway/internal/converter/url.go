@@ -14,10 +14,13 @@ func NewTopURLConverter() TopURLConverter {
 
 func (c *TopURLConverter) MapPbToDto(pb *analytics.TopUrlData) dto.TopURLData {
 	return dto.TopURLData{
-		LongURL:     pb.LongUrl,
-		ShortURL:    pb.ShortUrl,
-		FollowCount: pb.FollowCount,
-		CreateCount: pb.CreateCount,
+		LongURL:          pb.LongUrl,
+		ShortURL:         pb.ShortUrl,
+		Domain:           pb.Domain,
+		FollowCount:      pb.FollowCount,
+		CreateCount:      pb.CreateCount,
+		HumanFollowCount: pb.HumanFollowCount,
+		BotFollowCount:   pb.BotFollowCount,
 	}
 }
 
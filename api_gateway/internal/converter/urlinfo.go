@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/pkg/proto/url"
+)
+
+type URLInfoConverter struct {
+}
+
+func NewURLInfoConverter() URLInfoConverter {
+	return URLInfoConverter{}
+}
+
+func (c *URLInfoConverter) MapPbToDto(pb *url.UrlInfo) dto.URLInfo {
+	tags := pb.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return dto.URLInfo{
+		LongURL:    pb.LongUrl,
+		ShortURL:   pb.ShortUrl,
+		Tags:       tags,
+		CampaignID: pb.CampaignId,
+		CreatedAt:  pb.CreatedAt,
+	}
+}
+
+func (c *URLInfoConverter) MapSlicePbToDto(pbs []*url.UrlInfo) []dto.URLInfo {
+	dtos := make([]dto.URLInfo, len(pbs))
+
+	for i := 0; i < len(pbs); i++ {
+		dtos[i] = c.MapPbToDto(pbs[i])
+	}
+
+	return dtos
+}
@@ -0,0 +1,70 @@
+package domains
+
+import (
+	"net"
+	"strings"
+)
+
+// Domain is a host short links are served from. Links on the default domain
+// are stored without a domain, so the key of the default domain is empty
+type Domain struct {
+	Host      string
+	BaseURL   string
+	isDefault bool
+}
+
+func (d Domain) Key() string {
+	if d.isDefault {
+		return ""
+	}
+
+	return d.Host
+}
+
+func (d Domain) ShortURL(code string) string {
+	return d.BaseURL + "/" + code
+}
+
+type Registry struct {
+	defaultDomain Domain
+	domains       map[string]Domain
+}
+
+func NewRegistry(defaultDomain Domain, domains []Domain) *Registry {
+	defaultDomain.Host = strings.ToLower(defaultDomain.Host)
+	defaultDomain.isDefault = true
+
+	registry := &Registry{
+		defaultDomain: defaultDomain,
+		domains:       map[string]Domain{defaultDomain.Host: defaultDomain},
+	}
+	for _, domain := range domains {
+		domain.Host = strings.ToLower(domain.Host)
+		if domain.Host == defaultDomain.Host {
+			continue
+		}
+		registry.domains[domain.Host] = domain
+	}
+
+	return registry
+}
+
+func (r *Registry) Default() Domain {
+	return r.defaultDomain
+}
+
+// Resolve matches a host with or without a port, as it comes in the Host header
+func (r *Registry) Resolve(host string) (Domain, bool) {
+	host = strings.ToLower(host)
+	if domain, ok := r.domains[host]; ok {
+		return domain, true
+	}
+
+	hostname, _, err := net.SplitHostPort(host)
+	if err != nil {
+		return Domain{}, false
+	}
+	domain, ok := r.domains[hostname]
+
+	return domain, ok
+}
@@ -0,0 +1,128 @@
+package privacy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+)
+
+// Anonymized ips keep only the network part, like Google Analytics does
+var (
+	ipv4TruncateMask = net.CIDRMask(24, 32)
+	ipv6TruncateMask = net.CIDRMask(48, 128)
+)
+
+// hashedIPLength is the length of a hashed ip in bytes, it is hex encoded
+const hashedIPLength = 16
+
+// saltLookupTimeout bounds the wait for the salt store on the first click of a day
+const saltLookupTimeout = time.Second
+
+// IPAnonymizer removes what identifies a visitor from a client ip before it leaves the gateway
+type IPAnonymizer interface {
+	AnonymizeIP(rawIP string) string
+}
+
+type truncatingAnonymizer struct{}
+
+func NewTruncatingAnonymizer() IPAnonymizer {
+	return truncatingAnonymizer{}
+}
+
+// AnonymizeIP zeroes the host part of ip, an unparsable ip is dropped
+func (truncatingAnonymizer) AnonymizeIP(rawIP string) string {
+	ip := net.ParseIP(rawIP)
+	if ip == nil {
+		return ""
+	}
+
+	if ipv4 := ip.To4(); ipv4 != nil {
+		return ipv4.Mask(ipv4TruncateMask).String()
+	}
+	return ip.Mask(ipv6TruncateMask).String()
+}
+
+type hashingAnonymizer struct {
+	logger    *slog.Logger
+	saltStore SaltStore
+	now       func() time.Time
+
+	mu   sync.Mutex
+	day  time.Time
+	salt []byte
+}
+
+// NewHashingAnonymizer replaces an ip with its hash salted with a random salt of the current UTC day.
+// Hashes of an ip match within a day, so unique visitors can still be counted, but not across days.
+// Replicas get the same salt from a shared saltStore
+func NewHashingAnonymizer(logger *slog.Logger, saltStore SaltStore) IPAnonymizer {
+	return &hashingAnonymizer{
+		logger:    logger,
+		saltStore: saltStore,
+		now:       time.Now,
+	}
+}
+
+// AnonymizeIP hashes ip, an unparsable ip is dropped. Without the salt the ip is dropped too, rather than sent as is
+func (a *hashingAnonymizer) AnonymizeIP(rawIP string) string {
+	ip := net.ParseIP(rawIP)
+	if ip == nil {
+		return ""
+	}
+
+	salt, err := a.dailySalt()
+	if err != nil {
+		a.logger.Error(fmt.Sprintf("ip is dropped, no salt: %v", err))
+		return ""
+	}
+
+	hash := hmac.New(sha256.New, salt)
+	hash.Write(ip.To16())
+
+	return hex.EncodeToString(hash.Sum(nil)[:hashedIPLength])
+}
+
+// dailySalt keeps the salt of the day to not ask the store on every click, it is wiped when the day ends
+func (a *hashingAnonymizer) dailySalt() ([]byte, error) {
+	now := a.now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.salt != nil && a.day.Equal(day) {
+		return a.salt, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), saltLookupTimeout)
+	defer cancel()
+
+	salt, err := a.saltStore.DailySalt(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+
+	a.forgetSalt()
+	a.day, a.salt = day, salt
+	time.AfterFunc(endOfDay(day).Sub(now), func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		if a.day.Equal(day) {
+			a.forgetSalt()
+		}
+	})
+
+	return salt, nil
+}
+
+func (a *hashingAnonymizer) forgetSalt() {
+	clear(a.salt)
+	a.salt = nil
+}
@@ -0,0 +1,149 @@
+package privacy
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncatingAnonymizer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		ip         string
+		expectedIP string
+	}{
+		{
+			name:       "ipv4 keeps /24",
+			ip:         "203.0.113.57",
+			expectedIP: "203.0.113.0",
+		},
+		{
+			name:       "ipv6 keeps /48",
+			ip:         "2001:db8:85a3:8d3:1319:8a2e:370:7348",
+			expectedIP: "2001:db8:85a3::",
+		},
+		{
+			name:       "ipv4 mapped ipv6 is treated as ipv4",
+			ip:         "::ffff:198.51.100.23",
+			expectedIP: "198.51.100.0",
+		},
+		{
+			name:       "invalid ip is dropped",
+			ip:         "unknown",
+			expectedIP: "",
+		},
+	}
+
+	anonymizer := NewTruncatingAnonymizer()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedIP, anonymizer.AnonymizeIP(tc.ip))
+		})
+	}
+}
+
+// failingSaltStore is a salt store that can not be reached
+type failingSaltStore struct{}
+
+func (failingSaltStore) DailySalt(_ context.Context, _ time.Time) ([]byte, error) {
+	return nil, errors.New("redis is unavailable")
+}
+
+func TestHashingAnonymizer(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 9, 30, 0, 0, time.UTC)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	newAnonymizer := func(saltStore SaltStore, now *time.Time) IPAnonymizer {
+		return &hashingAnonymizer{
+			logger:    logger,
+			saltStore: saltStore,
+			now:       func() time.Time { return *now },
+		}
+	}
+
+	t.Run("Same ip within a day gets the same hash on every replica", func(t *testing.T) {
+		saltStore := NewMemorySaltStore()
+		morning, evening := day, day.Add(14*time.Hour)
+
+		morningHash := newAnonymizer(saltStore, &morning).AnonymizeIP("203.0.113.57")
+		eveningHash := newAnonymizer(saltStore, &evening).AnonymizeIP("203.0.113.57")
+
+		assert.Len(t, morningHash, 2*hashedIPLength)
+		assert.Equal(t, morningHash, eveningHash)
+		assert.NotContains(t, morningHash, "203.0.113")
+	})
+
+	t.Run("Salt rotates at midnight UTC", func(t *testing.T) {
+		now := day
+		anonymizer := newAnonymizer(NewMemorySaltStore(), &now)
+
+		today := anonymizer.AnonymizeIP("203.0.113.57")
+		now = now.Add(24 * time.Hour)
+		tomorrow := anonymizer.AnonymizeIP("203.0.113.57")
+
+		assert.NotEqual(t, today, tomorrow)
+	})
+
+	t.Run("Salt is random, a hash can not be recomputed without the store", func(t *testing.T) {
+		now := day
+
+		assert.NotEqual(t,
+			newAnonymizer(NewMemorySaltStore(), &now).AnonymizeIP("203.0.113.57"),
+			newAnonymizer(NewMemorySaltStore(), &now).AnonymizeIP("203.0.113.57"),
+		)
+	})
+
+	t.Run("Different ips get different hashes", func(t *testing.T) {
+		now := day
+		anonymizer := newAnonymizer(NewMemorySaltStore(), &now)
+
+		assert.NotEqual(t, anonymizer.AnonymizeIP("203.0.113.57"), anonymizer.AnonymizeIP("203.0.113.58"))
+	})
+
+	t.Run("ipv4 mapped ipv6 is treated as ipv4", func(t *testing.T) {
+		now := day
+		anonymizer := newAnonymizer(NewMemorySaltStore(), &now)
+
+		assert.Equal(t, anonymizer.AnonymizeIP("198.51.100.23"), anonymizer.AnonymizeIP("::ffff:198.51.100.23"))
+	})
+
+	t.Run("Invalid ip is dropped", func(t *testing.T) {
+		now := day
+		assert.Equal(t, "", newAnonymizer(NewMemorySaltStore(), &now).AnonymizeIP("unknown"))
+	})
+
+	t.Run("Salt store is unavailable. Ip is dropped", func(t *testing.T) {
+		now := day
+		assert.Equal(t, "", newAnonymizer(failingSaltStore{}, &now).AnonymizeIP("203.0.113.57"))
+	})
+}
+
+func TestMemorySaltStore(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	saltStore := NewMemorySaltStore()
+
+	today, err := saltStore.DailySalt(context.Background(), day)
+	assert.NoError(t, err)
+	assert.Len(t, today, saltLength)
+
+	again, err := saltStore.DailySalt(context.Background(), day)
+	assert.NoError(t, err)
+	assert.Equal(t, today, again)
+
+	// Wiping the copy a caller got keeps the stored salt
+	clear(again)
+	stored, err := saltStore.DailySalt(context.Background(), day)
+	assert.NoError(t, err)
+	assert.Equal(t, today, stored)
+
+	// The salt of the previous day is wiped once a new day starts
+	storedToday := saltStore.(*memorySaltStore).salt
+	tomorrow, err := saltStore.DailySalt(context.Background(), day.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.NotEqual(t, today, tomorrow)
+	assert.Equal(t, make([]byte, saltLength), storedToday)
+}
@@ -0,0 +1,100 @@
+package privacy
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// saltLength is the length of a daily salt in bytes
+const saltLength = 32
+
+// SaltStore keeps a random salt per UTC day. Replicas sharing a store hash an ip the same way,
+// and a salt is deleted once its day is over, so a hash can not be matched to an ip after that
+type SaltStore interface {
+	// DailySalt returns the salt of day, creating it on the first call. day is the start of a UTC day
+	DailySalt(ctx context.Context, day time.Time) ([]byte, error)
+}
+
+func newSalt() ([]byte, error) {
+	salt := make([]byte, saltLength)
+	_, err := rand.Read(salt)
+	return salt, err
+}
+
+// endOfDay is when the salt of day has to be gone
+func endOfDay(day time.Time) time.Time {
+	return day.AddDate(0, 0, 1)
+}
+
+type memorySaltStore struct {
+	mu   sync.Mutex
+	day  time.Time
+	salt []byte
+}
+
+// NewMemorySaltStore keeps the salt of the current day only. Every replica gets its own salt with it,
+// so it suits a single gateway
+func NewMemorySaltStore() SaltStore {
+	return &memorySaltStore{}
+}
+
+// DailySalt replaces the salt of the previous day, the previous salt is wiped
+func (s *memorySaltStore) DailySalt(_ context.Context, day time.Time) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.salt != nil && s.day.Equal(day) {
+		return slices.Clone(s.salt), nil
+	}
+
+	salt, err := newSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	clear(s.salt)
+	s.day, s.salt = day, salt
+
+	// The caller gets a copy, so wiping it does not wipe the stored one
+	return slices.Clone(salt), nil
+}
+
+type redisSaltStore struct {
+	client *redis.Client
+	now    func() time.Time
+}
+
+// NewRedisSaltStore shares the salts between the replicas. A salt expires at the end of its day
+func NewRedisSaltStore(client *redis.Client) SaltStore {
+	return &redisSaltStore{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// DailySalt sets a new salt unless a replica did it first, then reads the one that won
+func (s *redisSaltStore) DailySalt(ctx context.Context, day time.Time) ([]byte, error) {
+	ttl := endOfDay(day).Sub(s.now())
+	if ttl <= 0 {
+		return nil, errors.New("salt of a past day is requested")
+	}
+
+	key := "ip_salt:" + day.Format(time.DateOnly)
+
+	salt, err := newSalt()
+	if err != nil {
+		return nil, err
+	}
+	err = s.client.SetNX(ctx, key, salt, ttl).Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Get(ctx, key).Bytes()
+}
@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"api_gateway/internal/client"
+)
+
+// actorHeader names the user making a change, there is no auth in front of the gateway yet
+const actorHeader = "X-Actor"
+
+// actorContext is used for requests that change a link, so the change gets into the audit log
+func actorContext(r *http.Request) context.Context {
+	return client.WithActor(context.Background(), r.Header.Get(actorHeader), clientIP(r))
+}
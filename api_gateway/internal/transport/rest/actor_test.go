@@ -0,0 +1,49 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestActorContext(t *testing.T) {
+	testCases := []struct {
+		name             string
+		actor            string
+		remoteAddr       string
+		expectedActor    string
+		expectedSourceIP string
+	}{
+		{
+			name:             "Actor header and client ip",
+			actor:            "alice",
+			remoteAddr:       "203.0.113.7:53412",
+			expectedActor:    "alice",
+			expectedSourceIP: "203.0.113.7",
+		},
+		{
+			name:             "Anonymous request",
+			remoteAddr:       "[2001:db8::1]:53412",
+			expectedActor:    "",
+			expectedSourceIP: "2001:db8::1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/save_url", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.actor != "" {
+				req.Header.Set(actorHeader, tc.actor)
+			}
+
+			md, ok := metadata.FromOutgoingContext(actorContext(req))
+			assert.True(t, ok)
+			assert.Equal(t, []string{tc.expectedActor}, md.Get("x-actor"))
+			assert.Equal(t, []string{tc.expectedSourceIP}, md.Get("x-source-ip"))
+		})
+	}
+}
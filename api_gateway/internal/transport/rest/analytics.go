@@ -7,31 +7,56 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"api_gateway/errs"
 	"api_gateway/internal/client"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/transport/rest/dto"
 	"api_gateway/internal/transport/rest/response"
 )
 
 const (
-	limitQueryParam = "limit"
-	pageQueryParam  = "page"
-	defaultPage     = 1
-	defaultLimit    = 10
+	limitQueryParam   = "limit"
+	pageQueryParam    = "page"
+	defaultPage       = 1
+	defaultLimit      = 10
+	campaignPathValue = "campaign_id"
+	byQueryParam      = "by"
+	rankByQueryParam  = "rank_by"
+	windowQueryParam  = "window"
+	customWindow      = "custom"
+
+	granularityQueryParam = "granularity"
+	fromQueryParam        = "from"
+	toQueryParam          = "to"
+	timezoneQueryParam    = "tz"
+	defaultGranularity    = "day"
+
+	metadataLookupTimeout = 2 * time.Second
+	// urlInfoBatchSize is the most links the url service looks up in one call
+	urlInfoBatchSize = 100
 )
 
 type AnalyticsHandler struct {
 	logger          *slog.Logger
 	analyticsClient client.AnalyticsClient
+	urlClient       client.UrlClient
+	domainRegistry  *domains.Registry
 }
 
 func NewAnalyticsHandler(
 	logger *slog.Logger,
 	analyticsClient client.AnalyticsClient,
+	urlClient client.UrlClient,
+	domainRegistry *domains.Registry,
 ) *AnalyticsHandler {
 	return &AnalyticsHandler{
 		logger:          logger,
 		analyticsClient: analyticsClient,
+		urlClient:       urlClient,
+		domainRegistry:  domainRegistry,
 	}
 }
 
@@ -39,12 +64,16 @@ func NewAnalyticsHandler(
 //
 //	@Summary		Получение списка популярных url
 //	@Tags			url
-//	@Description	Принимает page и limit. Возвращает список популярных url. Поддерживает пагинацию
+//	@Description	Принимает page, limit, rank_by и window. Возвращает список популярных url с метаданными страниц назначения. С rank_by=human ссылки ранжируются только по переходам людей, без переходов ботов. С window считаются только события за последний час, сутки, 7 или 30 дней, а с window=custom за промежуток [from, to). Окна длиннее суток и окна старше 3 дней считаются по часам, поэтому первый час окна учитывается целиком. Поддерживает пагинацию
 //	@ID				get-top-urls
 //	@Accept			json
 //	@Produce		json
 //	@Param			page	query		int	false	"Страница"
 //	@Param			limit	query		int	false	"Максимальное количество url на странице"
+//	@Param			rank_by	query		string	false	"По каким переходам ранжировать"	Enums(all, human)
+//	@Param			window	query		string	false	"За какое время считать события"	Enums(all, 1h, 24h, 7d, 30d, custom)
+//	@Param			from	query		string	false	"Начало окна custom в RFC3339"
+//	@Param			to		query		string	false	"Конец окна custom в RFC3339, не включается"
 //	@Success		200		{object}	dto.TopURLDataResponse
 //	@Failure		400		{object}	response.Body
 //	@Failure		500		{object}	response.Body
@@ -58,27 +87,55 @@ func (h *AnalyticsHandler) GetTopURLs(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Allow-Origin", origin)
 	w.Header().Add("Access-Control-Allow-Credentials", "true")
 
-	page, err := h.parseQueryParam(r, pageQueryParam, defaultPage)
+	page, err := parseQueryParam(r, pageQueryParam, defaultPage)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
 	}
-	limit, err := h.parseQueryParam(r, limitQueryParam, defaultLimit)
+	limit, err := parseQueryParam(r, limitQueryParam, defaultLimit)
 	if err != nil {
 		response.BadRequest(w, err.Error())
 		return
 	}
 
-	topUrlsResp, err := h.analyticsClient.GetTopUrls(context.Background(), int64(page), int64(limit))
+	topURLsRequest := dto.TopURLsRequest{
+		Page:   int64(page),
+		Limit:  int64(limit),
+		RankBy: r.URL.Query().Get(rankByQueryParam),
+		Window: r.URL.Query().Get(windowQueryParam),
+	}
+
+	// A custom window has no default, from and to are only read for it
+	if topURLsRequest.Window == customWindow {
+		topURLsRequest.From, err = parseTimeQueryParam(r, fromQueryParam, time.Time{})
+		if err != nil {
+			response.BadRequest(w, err.Error())
+			return
+		}
+		topURLsRequest.To, err = parseTimeQueryParam(r, toQueryParam, time.Time{})
+		if err != nil {
+			response.BadRequest(w, err.Error())
+			return
+		}
+		if topURLsRequest.From.IsZero() || topURLsRequest.To.IsZero() {
+			response.BadRequest(w, "custom window needs from and to")
+			return
+		}
+	}
+
+	topUrlsResp, err := h.analyticsClient.GetTopUrls(context.Background(), topURLsRequest)
 
 	if err != nil {
 		if errors.Is(err, errs.ErrInvalidArgument) {
 			response.BadRequest(w, "bad params")
+			return
 		}
 		response.InternalServerError(w)
 		return
 	}
 
+	h.addMetadata(topUrlsResp.TopURLData)
+
 	respBytes, err := json.Marshal(topUrlsResp)
 	if err != nil {
 		h.logger.Error(err.Error())
@@ -89,7 +146,251 @@ func (h *AnalyticsHandler) GetTopURLs(w http.ResponseWriter, r *http.Request) {
 	response.WriteResponse(w, http.StatusOK, respBytes)
 }
 
-func (h *AnalyticsHandler) parseQueryParam(r *http.Request, key string, defaultValue int) (int, error) {
+// GetCampaignStats docs
+//
+//	@Summary		Получение статистики кампании
+//	@Tags			campaign
+//	@Description	Принимает id кампании в path параметрах. Возвращает суммарное количество созданий и переходов по ссылкам кампании
+//	@ID				get-campaign-stats
+//	@Produce		json
+//	@Param			campaign_id	path		string	true	"id кампании"
+//	@Success		200			{object}	dto.CampaignStats
+//	@Failure		400			{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/campaigns/{campaign_id}/stats [get]
+func (h *AnalyticsHandler) GetCampaignStats(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	campaignID := r.PathValue(campaignPathValue)
+
+	stats, err := h.analyticsClient.GetCampaignStats(context.Background(), campaignID)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad campaign id")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	respBytes, err := json.Marshal(stats)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, respBytes)
+}
+
+// GetClickBreakdown docs
+//
+//	@Summary		Разбивка переходов по устройствам, ОС и браузерам
+//	@Tags			url
+//	@Description	Принимает короткую ссылку в path параметрах и список измерений через запятую в by: device_type, os_family, browser_family, is_bot. Возвращает количество переходов для каждого сочетания значений измерений
+//	@ID				get-click-breakdown
+//	@Produce		json
+//	@Param			short_url	path		string	true	"Короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Param			by			query		string	false	"Измерения через запятую"	default(device_type)
+//	@Success		200			{object}	dto.ClickBreakdown
+//	@Failure		400			{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url}/clicks [get]
+func (h *AnalyticsHandler) GetClickBreakdown(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	urlDomain, ok := resolveDomain(h.domainRegistry, r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortURL := r.PathValue(shortUrlPathValue)
+
+	dimensions := []string{"device_type"}
+	if by := r.URL.Query().Get(byQueryParam); by != "" {
+		dimensions = strings.Split(by, ",")
+	}
+
+	rows, err := h.analyticsClient.GetClickBreakdown(context.Background(), urlDomain.Key(), shortURL, dimensions)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "unknown or repeated dimension")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	respBytes, err := json.Marshal(dto.ClickBreakdown{
+		ShortURL: shortURL,
+		Rows:     rows,
+	})
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, respBytes)
+}
+
+// defaultTimeSeriesSpans are the ranges that end now a time series covers when from is not given
+var defaultTimeSeriesSpans = map[string]time.Duration{
+	"minute": time.Hour,
+	"hour":   24 * time.Hour,
+	"day":    30 * 24 * time.Hour,
+	"week":   12 * 7 * 24 * time.Hour,
+}
+
+// GetURLTimeSeries docs
+//
+//	@Summary		История переходов и созданий ссылки
+//	@Tags			url
+//	@Description	Принимает короткую ссылку в path параметрах, размер интервала (minute, hour, day, week), границы from и to в RFC3339 и часовой пояс tz (IANA, по умолчанию UTC). Возвращает количество переходов и созданий по интервалам от from до to, интервалы без событий заполнены нулями. Дни и недели (с понедельника) начинаются в часовом поясе tz. По умолчанию to текущее время, а from зависит от размера интервала: час для minute, сутки для hour, 30 дней для day и 12 недель для week
+//	@ID				get-url-time-series
+//	@Produce		json
+//	@Param			short_url	path		string	true	"Короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Param			granularity	query		string	false	"Размер интервала"	Enums(minute, hour, day, week)	default(day)
+//	@Param			from		query		string	false	"Начало, RFC3339"
+//	@Param			to			query		string	false	"Конец, RFC3339"
+//	@Param			tz			query		string	false	"Часовой пояс"	default(UTC)
+//	@Success		200			{object}	dto.URLTimeSeries
+//	@Failure		400			{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url}/stats [get]
+func (h *AnalyticsHandler) GetURLTimeSeries(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	query := r.URL.Query()
+
+	urlDomain, ok := resolveDomain(h.domainRegistry, query.Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	granularity := query.Get(granularityQueryParam)
+	if granularity == "" {
+		granularity = defaultGranularity
+	}
+	defaultSpan, ok := defaultTimeSeriesSpans[granularity]
+	if !ok {
+		response.BadRequest(w, "granularity must be minute, hour, day or week")
+		return
+	}
+
+	timezone := query.Get(timezoneQueryParam)
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		response.BadRequest(w, "unknown timezone")
+		return
+	}
+
+	to, err := parseTimeQueryParam(r, toQueryParam, time.Now().Truncate(time.Second))
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	from, err := parseTimeQueryParam(r, fromQueryParam, to.Add(-defaultSpan))
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	shortURL := r.PathValue(shortUrlPathValue)
+	points, err := h.analyticsClient.GetUrlTimeSeries(context.Background(), dto.TimeSeriesRequest{
+		Domain:      urlDomain.Key(),
+		ShortURL:    shortURL,
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+		Timezone:    timezone,
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "range must start before it ends and have at most 10080 buckets")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	// Bucket starts are shown with the offset of the timezone
+	for i := range points {
+		points[i].Start = points[i].Start.In(location)
+	}
+
+	respBytes, err := json.Marshal(dto.URLTimeSeries{
+		ShortURL:    shortURL,
+		Granularity: granularity,
+		Timezone:    timezone,
+		From:        from.In(location),
+		To:          to.In(location),
+		Points:      points,
+	})
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, respBytes)
+}
+
+// addMetadata looks the urls up in batches, one call per page unless the page is huge.
+// The table is still useful without metadata, so lookup errors are only logged
+func (h *AnalyticsHandler) addMetadata(topURLs []dto.TopURLData) {
+	ctx, cancel := context.WithTimeout(context.Background(), metadataLookupTimeout)
+	defer cancel()
+
+	for start := 0; start < len(topURLs); start += urlInfoBatchSize {
+		end := min(start+urlInfoBatchSize, len(topURLs))
+
+		keys := make([]dto.URLKey, 0, end-start)
+		for _, topURL := range topURLs[start:end] {
+			keys = append(keys, dto.URLKey{Domain: topURL.Domain, ShortURL: topURL.ShortURL})
+		}
+
+		urlInfos, err := h.urlClient.GetUrlInfos(ctx, keys)
+		if err != nil {
+			h.logger.Error(err.Error())
+			return
+		}
+
+		for i := start; i < end; i++ {
+			urlInfo, ok := urlInfos[dto.URLKey{Domain: topURLs[i].Domain, ShortURL: topURLs[i].ShortURL}]
+			if ok {
+				topURLs[i].Metadata = urlInfo.Metadata
+			}
+		}
+	}
+}
+
+func parseQueryParam(r *http.Request, key string, defaultValue int) (int, error) {
 	queryParam := r.URL.Query().Get(key)
 
 	if queryParam == "" {
@@ -107,3 +408,13 @@ func (h *AnalyticsHandler) parseQueryParam(r *http.Request, key string, defaultV
 	return param, nil
 
 }
+
+func parseTimeQueryParam(r *http.Request, key string, defaultValue time.Time) (time.Time, error) {
+	queryParam := r.URL.Query().Get(key)
+
+	if queryParam == "" {
+		return defaultValue, nil
+	}
+
+	return time.Parse(time.RFC3339, queryParam)
+}
@@ -1,13 +1,18 @@
 package rest
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
+	"api_gateway/errs"
 	"api_gateway/internal/client"
 	"api_gateway/internal/client/mocks"
 	"api_gateway/internal/transport/rest/dto"
@@ -24,7 +29,7 @@ func TestGetTopURLs(t *testing.T) {
 	testTopUrlDataResp := dto.TopURLDataResponse{
 		TopURLData: []dto.TopURLData{
 			{LongURL: "http://test.long", ShortURL: "short", FollowCount: 10, CreateCount: 1},
-			{LongURL: "http://test.long2", ShortURL: "short2", FollowCount: 20, CreateCount: 2},
+			{LongURL: "http://test.long2", ShortURL: "short", Domain: "go.brand.com", FollowCount: 20, CreateCount: 2},
 			{LongURL: "http://test.long3", ShortURL: "short3", FollowCount: 30, CreateCount: 3},
 		},
 		Pagination: dto.Pagination{
@@ -35,33 +40,82 @@ func TestGetTopURLs(t *testing.T) {
 			TotalPage:     10,
 		},
 	}
+	testMetadata := &dto.URLMetadata{Title: "title", FaviconURL: "http://test.long/favicon.ico"}
 	testErr := errors.New("test error")
 
 	testCases := []struct {
 		name                 string
 		buildAnalyticsClient func() client.AnalyticsClient
+		buildUrlClient       func() client.UrlClient
 		page                 string
 		limit                string
+		rankBy               string
+		window               string
+		from                 string
+		to                   string
 		expectedCode         int
+		expectedMetadata     []*dto.URLMetadata
 	}{
 		{
 			name: "Get top urls without error. 200 OK",
 			buildAnalyticsClient: func() client.AnalyticsClient {
 				mockClient := mocks.NewAnalyticsClient(t)
-				mockClient.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything).
-					Return(testTopUrlDataResp, nil)
+				// addMetadata fills the rows in, so every case gets its own copy
+				topUrlDataResp := testTopUrlDataResp
+				topUrlDataResp.TopURLData = slices.Clone(testTopUrlDataResp.TopURLData)
+				mockClient.On("GetTopUrls", mock.Anything, mock.Anything).
+					Return(topUrlDataResp, nil)
 
 				return mockClient
 			},
-			page:         "",
-			limit:        "",
-			expectedCode: http.StatusOK,
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				// The same code on another domain is another link, short3 is not found
+				mockClient.On("GetUrlInfos", mock.Anything, []dto.URLKey{
+					{ShortURL: "short"},
+					{Domain: "go.brand.com", ShortURL: "short"},
+					{ShortURL: "short3"},
+				}).
+					Return(map[dto.URLKey]dto.URLInfo{
+						{ShortURL: "short"}:                         {ShortURL: "short", Metadata: testMetadata},
+						{Domain: "go.brand.com", ShortURL: "short"}: {ShortURL: "short"},
+					}, nil).
+					Once()
+
+				return mockClient
+			},
+			page:             "",
+			limit:            "",
+			expectedCode:     http.StatusOK,
+			expectedMetadata: []*dto.URLMetadata{testMetadata, nil, nil},
+		},
+		{
+			name: "Metadata lookup failed. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				// addMetadata fills the rows in, so every case gets its own copy
+				topUrlDataResp := testTopUrlDataResp
+				topUrlDataResp.TopURLData = slices.Clone(testTopUrlDataResp.TopURLData)
+				mockClient.On("GetTopUrls", mock.Anything, mock.Anything).
+					Return(topUrlDataResp, nil)
+
+				return mockClient
+			},
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("GetUrlInfos", mock.Anything, mock.Anything).
+					Return(nil, errs.ErrInternal)
+
+				return mockClient
+			},
+			expectedCode:     http.StatusOK,
+			expectedMetadata: []*dto.URLMetadata{nil, nil, nil},
 		},
 		{
 			name: "Get top urls when internal error happened. 500 Internal Server Error",
 			buildAnalyticsClient: func() client.AnalyticsClient {
 				mockClient := mocks.NewAnalyticsClient(t)
-				mockClient.On("GetTopUrls", mock.Anything, mock.Anything, mock.Anything).
+				mockClient.On("GetTopUrls", mock.Anything, mock.Anything).
 					Return(dto.TopURLDataResponse{}, testErr)
 
 				return mockClient
@@ -81,6 +135,94 @@ func TestGetTopURLs(t *testing.T) {
 			limit:        "",
 			expectedCode: http.StatusBadRequest,
 		},
+		{
+			name: "Get top urls ranked by human follows. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetTopUrls", mock.Anything, dto.TopURLsRequest{Page: 1, Limit: 10, RankBy: "human"}).
+					Return(dto.TopURLDataResponse{TopURLData: []dto.TopURLData{}}, nil)
+
+				return mockClient
+			},
+			rankBy:           "human",
+			expectedCode:     http.StatusOK,
+			expectedMetadata: []*dto.URLMetadata{},
+		},
+		{
+			name: "Unknown rank. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetTopUrls", mock.Anything, dto.TopURLsRequest{Page: 1, Limit: 10, RankBy: "robots"}).
+					Return(dto.TopURLDataResponse{}, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			rankBy:       "robots",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Get top urls of the last week. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetTopUrls", mock.Anything, dto.TopURLsRequest{Page: 1, Limit: 10, Window: "7d"}).
+					Return(dto.TopURLDataResponse{TopURLData: []dto.TopURLData{}}, nil)
+
+				return mockClient
+			},
+			window:           "7d",
+			expectedCode:     http.StatusOK,
+			expectedMetadata: []*dto.URLMetadata{},
+		},
+		{
+			name: "Get top urls of a custom window. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetTopUrls", mock.Anything, mock.MatchedBy(func(request dto.TopURLsRequest) bool {
+					return request.Window == "custom" &&
+						request.From.Equal(time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)) &&
+						request.To.Equal(time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC))
+				})).
+					Return(dto.TopURLDataResponse{TopURLData: []dto.TopURLData{}}, nil)
+
+				return mockClient
+			},
+			window:           "custom",
+			from:             "2024-03-10T03:00:00+03:00",
+			to:               "2024-03-17T00:00:00Z",
+			expectedCode:     http.StatusOK,
+			expectedMetadata: []*dto.URLMetadata{},
+		},
+		{
+			name: "Custom window without to. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			window:       "custom",
+			from:         "2024-03-10T00:00:00Z",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Custom window with invalid from. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			window:       "custom",
+			from:         "yesterday",
+			to:           "2024-03-17T00:00:00Z",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown window. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetTopUrls", mock.Anything, dto.TopURLsRequest{Page: 1, Limit: 10, Window: "2d"}).
+					Return(dto.TopURLDataResponse{}, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			window:       "2d",
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name: "Invalid limit. 400 Bad Request",
 			buildAnalyticsClient: func() client.AnalyticsClient {
@@ -96,9 +238,15 @@ func TestGetTopURLs(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			urlClient := client.UrlClient(mocks.NewUrlClient(t))
+			if tc.buildUrlClient != nil {
+				urlClient = tc.buildUrlClient()
+			}
 			handler := NewAnalyticsHandler(
 				logger,
 				tc.buildAnalyticsClient(),
+				urlClient,
+				newTestDomainRegistry(),
 			)
 
 			req := httptest.NewRequest(http.MethodGet, basePath, nil)
@@ -109,6 +257,18 @@ func TestGetTopURLs(t *testing.T) {
 			if tc.limit != "" {
 				q.Add("limit", tc.limit)
 			}
+			if tc.rankBy != "" {
+				q.Add("rank_by", tc.rankBy)
+			}
+			if tc.window != "" {
+				q.Add("window", tc.window)
+			}
+			if tc.from != "" {
+				q.Add("from", tc.from)
+			}
+			if tc.to != "" {
+				q.Add("to", tc.to)
+			}
 			req.URL.RawQuery = q.Encode()
 
 			rec := httptest.NewRecorder()
@@ -116,6 +276,341 @@ func TestGetTopURLs(t *testing.T) {
 			handler.GetTopURLs(rec, req)
 
 			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedMetadata == nil {
+				return
+			}
+
+			var resp dto.TopURLDataResponse
+			err := json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.NoError(t, err)
+			for i, topURL := range resp.TopURLData {
+				assert.Equal(t, tc.expectedMetadata[i], topURL.Metadata)
+			}
+		})
+	}
+}
+
+func TestGetCampaignStats(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testStats := dto.CampaignStats{CampaignID: "spring", FollowCount: 10, CreateCount: 2}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name                 string
+		buildAnalyticsClient func() client.AnalyticsClient
+		campaignID           string
+		expectedCode         int
+	}{
+		{
+			name: "Get campaign stats without error. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetCampaignStats", mock.Anything, "spring").
+					Return(testStats, nil)
+
+				return mockClient
+			},
+			campaignID:   "spring",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Get campaign stats when internal error happened. 500 Internal Server Error",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetCampaignStats", mock.Anything, "spring").
+					Return(dto.CampaignStats{}, testErr)
+
+				return mockClient
+			},
+			campaignID:   "spring",
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewAnalyticsHandler(
+				logger,
+				tc.buildAnalyticsClient(),
+				mocks.NewUrlClient(t),
+				newTestDomainRegistry(),
+			)
+
+			path := fmt.Sprintf("/api/campaigns/%s/stats", tc.campaignID)
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/campaigns/{campaign_id}/stats", handler.GetCampaignStats)
+
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				stats := dto.CampaignStats{}
+				err := json.NewDecoder(rec.Body).Decode(&stats)
+				assert.NoError(t, err)
+				assert.Equal(t, testStats, stats)
+			}
+		})
+	}
+}
+
+func TestGetClickBreakdown(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testRows := []dto.ClickBreakdownRow{
+		{Dimensions: map[string]string{"device_type": "mobile", "os_family": "iOS"}, Clicks: 7},
+		{Dimensions: map[string]string{"device_type": "desktop", "os_family": "Windows"}, Clicks: 3},
+	}
+
+	testCases := []struct {
+		name                 string
+		buildAnalyticsClient func() client.AnalyticsClient
+		query                string
+		expectedCode         int
+		expectedBreakdown    dto.ClickBreakdown
+	}{
+		{
+			name: "Breakdown by several dimensions. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetClickBreakdown", mock.Anything, "", "short", []string{"device_type", "os_family"}).
+					Return(testRows, nil)
+
+				return mockClient
+			},
+			query:             "?by=device_type,os_family",
+			expectedCode:      http.StatusOK,
+			expectedBreakdown: dto.ClickBreakdown{ShortURL: "short", Rows: testRows},
+		},
+		{
+			name: "Breakdown by device type by default. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetClickBreakdown", mock.Anything, "", "short", []string{"device_type"}).
+					Return([]dto.ClickBreakdownRow{}, nil)
+
+				return mockClient
+			},
+			expectedCode:      http.StatusOK,
+			expectedBreakdown: dto.ClickBreakdown{ShortURL: "short", Rows: []dto.ClickBreakdownRow{}},
+		},
+		{
+			name: "Breakdown of a link on a branded domain. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetClickBreakdown", mock.Anything, "go.brand.com", "short", []string{"device_type"}).
+					Return(testRows, nil)
+
+				return mockClient
+			},
+			query:             "?domain=go.brand.com",
+			expectedCode:      http.StatusOK,
+			expectedBreakdown: dto.ClickBreakdown{ShortURL: "short", Rows: testRows},
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			query:        "?domain=evil.com",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown dimension. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetClickBreakdown", mock.Anything, "", "short", []string{"country"}).
+					Return(nil, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			query:        "?by=country",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Internal error. 500 Internal Server Error",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetClickBreakdown", mock.Anything, "", "short", []string{"is_bot"}).
+					Return(nil, errs.ErrInternal)
+
+				return mockClient
+			},
+			query:        "?by=is_bot",
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewAnalyticsHandler(
+				logger,
+				tc.buildAnalyticsClient(),
+				mocks.NewUrlClient(t),
+				newTestDomainRegistry(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/urls/short/clicks"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/urls/{short_url}/clicks", handler.GetClickBreakdown)
+
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				var breakdown dto.ClickBreakdown
+				assert.NoError(t, json.NewDecoder(rec.Body).Decode(&breakdown))
+				assert.Equal(t, tc.expectedBreakdown, breakdown)
+			}
+		})
+	}
+}
+
+func TestGetURLTimeSeries(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testFrom := time.Date(2024, time.March, 9, 21, 0, 0, 0, time.UTC)
+	testTo := time.Date(2024, time.March, 11, 21, 0, 0, 0, time.UTC)
+	testPoints := []dto.TimeSeriesPoint{
+		{Start: testFrom, FollowCount: 12, CreateCount: 1},
+		{Start: testFrom.Add(24 * time.Hour)},
+	}
+
+	testCases := []struct {
+		name                 string
+		buildAnalyticsClient func() client.AnalyticsClient
+		query                string
+		expectedCode         int
+		expectedStarts       []string
+	}{
+		{
+			name: "Buckets are shown in the timezone. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetUrlTimeSeries", mock.Anything, mock.MatchedBy(func(req dto.TimeSeriesRequest) bool {
+					return req.ShortURL == "short" && req.Domain == "go.brand.com" && req.Granularity == "day" &&
+						req.Timezone == "Europe/Moscow" && req.From.Equal(testFrom) && req.To.Equal(testTo)
+				})).Return(testPoints, nil)
+
+				return mockClient
+			},
+			query:          "?domain=go.brand.com&granularity=day&tz=Europe/Moscow&from=2024-03-10T00:00:00%2B03:00&to=2024-03-11T21:00:00Z",
+			expectedCode:   http.StatusOK,
+			expectedStarts: []string{"2024-03-10T00:00:00+03:00", "2024-03-11T00:00:00+03:00"},
+		},
+		{
+			name: "Defaults to the last 30 days in UTC. 200 OK",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetUrlTimeSeries", mock.Anything, mock.MatchedBy(func(req dto.TimeSeriesRequest) bool {
+					return req.Domain == "" && req.Granularity == "day" && req.Timezone == "UTC" &&
+						req.To.Sub(req.From) == 30*24*time.Hour && time.Since(req.To) < time.Minute
+				})).Return([]dto.TimeSeriesPoint{}, nil)
+
+				return mockClient
+			},
+			expectedCode:   http.StatusOK,
+			expectedStarts: []string{},
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			query:        "?domain=evil.com",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown granularity. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			query:        "?granularity=month",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown timezone. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			query:        "?tz=Mars/Olympus_Mons",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid from. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				return mocks.NewAnalyticsClient(t)
+			},
+			query:        "?from=yesterday",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Range rejected by analytics. 400 Bad Request",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetUrlTimeSeries", mock.Anything, mock.Anything).
+					Return(nil, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			query:        "?granularity=minute&from=2024-01-01T00:00:00Z&to=2024-03-01T00:00:00Z",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Internal error. 500 Internal Server Error",
+			buildAnalyticsClient: func() client.AnalyticsClient {
+				mockClient := mocks.NewAnalyticsClient(t)
+				mockClient.On("GetUrlTimeSeries", mock.Anything, mock.Anything).
+					Return(nil, errs.ErrInternal)
+
+				return mockClient
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewAnalyticsHandler(
+				logger,
+				tc.buildAnalyticsClient(),
+				mocks.NewUrlClient(t),
+				newTestDomainRegistry(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/urls/short/stats"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/urls/{short_url}/stats", handler.GetURLTimeSeries)
+
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var resp struct {
+				Points []struct {
+					Start string `json:"start"`
+				} `json:"points"`
+			}
+			assert.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+
+			starts := make([]string, len(resp.Points))
+			for i, point := range resp.Points {
+				starts[i] = point.Start
+			}
+			assert.Equal(t, tc.expectedStarts, starts)
 		})
 	}
 }
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"api_gateway/errs"
+	"api_gateway/internal/transport/rest/response"
+)
+
+// GetURLAuditLog docs
+//
+//	@Summary		Получение истории изменений короткой ссылки
+//	@Tags			url
+//	@Description	Возвращает записи о создании и изменениях ссылки: кто и с какого ip изменил ссылку, значения до и после изменения и время
+//	@ID				get-url-audit-log
+//	@Produce		json
+//	@Param			short_url	path		string	true	"короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Success		200			{object}	dto.AuditLogResponse
+//	@Failure		400,404		{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url}/audit [get]
+func (h *URLHandler) GetURLAuditLog(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortUrl := r.PathValue(shortUrlPathValue)
+	auditLog, err := h.urlClient.GetUrlAuditLog(context.Background(), urlDomain.Key(), shortUrl)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "short url not found")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad short url")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	auditLogBody, err := json.Marshal(auditLog)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, auditLogBody)
+}
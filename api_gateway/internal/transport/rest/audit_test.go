@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetURLAuditLog(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testAuditLog := dto.AuditLogResponse{
+		Records: []dto.AuditRecord{
+			{
+				Action:    "create",
+				Actor:     "alice",
+				SourceIP:  "10.0.0.1",
+				NewValue:  json.RawMessage(`{"long_url":"https://test.long"}`),
+				CreatedAt: 1700000000,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		query          string
+		expectedCode   int
+	}{
+		{
+			name: "Get audit log. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("GetUrlAuditLog", mock.Anything, "go.brand.com", "short").
+					Return(testAuditLog, nil)
+
+				return mockClient
+			},
+			query:        "?domain=go.brand.com",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("GetUrlAuditLog", mock.Anything, "", "short").
+					Return(dto.AuditLogResponse{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/urls/short/audit"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/urls/{short_url}/audit", handler.GetURLAuditLog)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				auditLog := dto.AuditLogResponse{}
+				err := json.NewDecoder(rec.Body).Decode(&auditLog)
+				assert.NoError(t, err)
+
+				assert.Equal(t, testAuditLog, auditLog)
+			}
+		})
+	}
+}
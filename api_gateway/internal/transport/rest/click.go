@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"net"
+	"net/http"
+
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/transport/rest/dto"
+)
+
+// Limits of ClickMetadata in url.proto. Longer headers are cut, so the follow is not rejected
+const (
+	maxReferrerLen       = 2048
+	maxUserAgentLen      = 1024
+	maxAcceptLanguageLen = 255
+	maxHostLen           = 255
+)
+
+// clickContext captures the request details analytics breaks follows down by and scores the click.
+// A visitor that opted out of tracking is only counted by the coarse user agent classes and the bot verdict,
+// the identifying fields are dropped
+func (h *URLHandler) clickContext(r *http.Request) dto.ClickContext {
+	client := h.uaParser.Parse(r.UserAgent())
+	verdict := h.clickScorer.Score(clickfraud.Click{
+		IP:       clientIP(r),
+		ShortURL: r.Host + "/" + r.PathValue(shortUrlPathValue),
+		Header:   r.Header,
+		KnownBot: client.Bot,
+	})
+
+	click := dto.ClickContext{
+		Host:          truncate(r.Host, maxHostLen),
+		DeviceType:    client.DeviceType,
+		OSFamily:      client.OSFamily,
+		BrowserFamily: client.BrowserFamily,
+		Bot:           verdict.Bot,
+		BotScore:      verdict.Score,
+	}
+	if trackingOptedOut(r) {
+		return click
+	}
+
+	click.Referrer = truncate(r.Referer(), maxReferrerLen)
+	click.UserAgent = truncate(r.UserAgent(), maxUserAgentLen)
+	click.IP = h.ipAnonymizer.AnonymizeIP(clientIP(r))
+	click.AcceptLanguage = truncate(r.Header.Get("Accept-Language"), maxAcceptLanguageLen)
+
+	return click
+}
+
+// trackingOptedOut is true when the browser sends Do Not Track or Global Privacy Control
+func trackingOptedOut(r *http.Request) bool {
+	return r.Header.Get("DNT") == "1" || r.Header.Get("Sec-GPC") == "1"
+}
+
+// truncate cuts s to maxLen characters
+func truncate(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+
+	var count int
+	for i := range s {
+		if count == maxLen {
+			return s[:i]
+		}
+		count++
+	}
+	return s
+}
+
+func clientIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testIPhoneUserAgent = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 " +
+	"(KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1"
+
+func TestFollowUrlClickContext(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	testCases := []struct {
+		name          string
+		headers       map[string]string
+		expectedClick dto.ClickContext
+	}{
+		{
+			name: "Request details are passed with an anonymized ip",
+			expectedClick: dto.ClickContext{
+				Referrer:       "https://news.example.org/",
+				UserAgent:      testIPhoneUserAgent,
+				IP:             "203.0.113.0",
+				AcceptLanguage: "en-US,en;q=0.9",
+				Host:           "example.com",
+				DeviceType:     "mobile",
+				OSFamily:       "iOS",
+				BrowserFamily:  "Safari",
+			},
+		},
+		{
+			name:    "Do Not Track drops identifying fields",
+			headers: map[string]string{"DNT": "1"},
+			expectedClick: dto.ClickContext{
+				Host:          "example.com",
+				DeviceType:    "mobile",
+				OSFamily:      "iOS",
+				BrowserFamily: "Safari",
+			},
+		},
+		{
+			name:    "Global Privacy Control drops identifying fields",
+			headers: map[string]string{"Sec-GPC": "1"},
+			expectedClick: dto.ClickContext{
+				Host:          "example.com",
+				DeviceType:    "mobile",
+				OSFamily:      "iOS",
+				BrowserFamily: "Safari",
+			},
+		},
+		{
+			name:    "Bot is classified",
+			headers: map[string]string{"User-Agent": "curl/8.5.0"},
+			expectedClick: dto.ClickContext{
+				Referrer:       "https://news.example.org/",
+				UserAgent:      "curl/8.5.0",
+				IP:             "203.0.113.0",
+				AcceptLanguage: "en-US,en;q=0.9",
+				Host:           "example.com",
+				DeviceType:     "bot",
+				OSFamily:       "Other",
+				BrowserFamily:  "Other",
+				Bot:            true,
+				BotScore:       100,
+			},
+		},
+		{
+			name:    "Missing headers are scored",
+			headers: map[string]string{"Accept-Language": ""},
+			expectedClick: dto.ClickContext{
+				Referrer:      "https://news.example.org/",
+				UserAgent:     testIPhoneUserAgent,
+				IP:            "203.0.113.0",
+				Host:          "example.com",
+				DeviceType:    "mobile",
+				OSFamily:      "iOS",
+				BrowserFamily: "Safari",
+				BotScore:      30,
+			},
+		},
+		{
+			name: "Long headers are cut to the metadata limits",
+			headers: map[string]string{
+				"Referer":         "https://news.example.org/?q=" + strings.Repeat("ы", 3000),
+				"Accept-Language": strings.Repeat("en-US,", 100),
+			},
+			expectedClick: dto.ClickContext{
+				Referrer:       "https://news.example.org/?q=" + strings.Repeat("ы", 2048-len("https://news.example.org/?q=")),
+				UserAgent:      testIPhoneUserAgent,
+				IP:             "203.0.113.0",
+				AcceptLanguage: strings.Repeat("en-US,", 100)[:255],
+				Host:           "example.com",
+				DeviceType:     "mobile",
+				OSFamily:       "iOS",
+				BrowserFamily:  "Safari",
+			},
+		},
+		{
+			name:    "DNT set to 0 is not an opt out",
+			headers: map[string]string{"DNT": "0"},
+			expectedClick: dto.ClickContext{
+				Referrer:       "https://news.example.org/",
+				UserAgent:      testIPhoneUserAgent,
+				IP:             "203.0.113.0",
+				AcceptLanguage: "en-US,en;q=0.9",
+				Host:           "example.com",
+				DeviceType:     "mobile",
+				OSFamily:       "iOS",
+				BrowserFamily:  "Safari",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := mocks.NewUrlClient(t)
+			mockClient.On("FollowUrl", mock.Anything, "", "short", false, tc.expectedClick).
+				Return(dto.FollowData{LongURL: "https://test.longurl"}, nil)
+
+			handler := NewURLHandler(logger, mockClient, newTestDomainRegistry(), false, privacy.NewTruncatingAnonymizer(), useragent.NewDefaultParser(), clickfraud.NewDefaultScorer())
+
+			req := httptest.NewRequest(http.MethodGet, "/short", nil)
+			req.RemoteAddr = "203.0.113.57:51234"
+			req.Header.Set("Referer", "https://news.example.org/")
+			req.Header.Set("User-Agent", testIPhoneUserAgent)
+			req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+			req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+			for key, value := range tc.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /{short_url}", handler.FollowUrl)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusFound, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package rest
+
+import "strings"
+
+// crawlerUserAgents are lowercase User-Agent fragments of link preview bots
+var crawlerUserAgents = []string{
+	"slackbot",
+	"slack-imgproxy",
+	"twitterbot",
+	"facebookexternalhit",
+	"facebookcatalog",
+	"linkedinbot",
+	"discordbot",
+	"telegrambot",
+	"whatsapp",
+	"skypeuripreview",
+	"pinterestbot",
+	"redditbot",
+	"vkshare",
+	"embedly",
+	"mastodon",
+	"google-pagerenderer",
+	"applebot",
+}
+
+func isCrawler(userAgent string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, crawler := range crawlerUserAgents {
+		if strings.Contains(userAgent, crawler) {
+			return true
+		}
+	}
+
+	return false
+}
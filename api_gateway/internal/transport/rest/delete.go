@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"api_gateway/errs"
+	"api_gateway/internal/transport/rest/response"
+)
+
+// DeleteURL docs
+//
+//	@Summary		Удаление короткой ссылки
+//	@Tags			url
+//	@Description	Скрывает ссылку, переходы по ней перестают работать. Ссылку можно восстановить до restorable_until, после этого она удаляется навсегда
+//	@ID				delete-url
+//	@Produce		json
+//	@Param			short_url	path		string	true	"короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Success		200			{object}	dto.DeletedURL
+//	@Failure		400,404		{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url} [delete]
+func (h *URLHandler) DeleteURL(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortUrl := r.PathValue(shortUrlPathValue)
+	deletedURL, err := h.urlClient.DeleteUrl(actorContext(r), urlDomain.Key(), shortUrl)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "short url not found")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad short url")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	deletedURLBody, err := json.Marshal(deletedURL)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, deletedURLBody)
+}
+
+// RestoreURL docs
+//
+//	@Summary		Восстановление удаленной короткой ссылки
+//	@Tags			url
+//	@Description	Возвращает удаленную ссылку, если срок восстановления еще не прошел
+//	@ID				restore-url
+//	@Produce		json
+//	@Param			short_url	path		string	true	"короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Success		200			{object}	dto.URLInfo
+//	@Failure		400,404		{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url}/restore [post]
+func (h *URLHandler) RestoreURL(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortUrl := r.PathValue(shortUrlPathValue)
+	urlInfo, err := h.urlClient.RestoreUrl(actorContext(r), urlDomain.Key(), shortUrl)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "deleted short url not found or can no longer be restored")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad short url")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	urlInfoBody, err := json.Marshal(urlInfo)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, urlInfoBody)
+}
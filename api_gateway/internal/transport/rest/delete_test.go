@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testDeletedURL := dto.DeletedURL{ShortURL: "short", RestorableUntil: 1700000000}
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		query          string
+		expectedCode   int
+	}{
+		{
+			name: "Delete url. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("DeleteUrl", mock.Anything, "go.brand.com", "short").
+					Return(testDeletedURL, nil)
+
+				return mockClient
+			},
+			query:        "?domain=go.brand.com",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("DeleteUrl", mock.Anything, "", "short").
+					Return(dto.DeletedURL{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodDelete, "/api/urls/short"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("DELETE /api/urls/{short_url}", handler.DeleteURL)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				deletedURL := dto.DeletedURL{}
+				err := json.NewDecoder(rec.Body).Decode(&deletedURL)
+				assert.NoError(t, err)
+
+				assert.Equal(t, testDeletedURL, deletedURL)
+			}
+		})
+	}
+}
+
+func TestRestoreURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testURLInfo := dto.URLInfo{ShortURL: "short", LongURL: "https://test.long"}
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		expectedCode   int
+	}{
+		{
+			name: "Restore url. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("RestoreUrl", mock.Anything, "", "short").
+					Return(testURLInfo, nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Grace period is over. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("RestoreUrl", mock.Anything, "", "short").
+					Return(dto.URLInfo{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/urls/short/restore", nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /api/urls/{short_url}/restore", handler.RestoreURL)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				urlInfo := dto.URLInfo{}
+				err := json.NewDecoder(rec.Body).Decode(&urlInfo)
+				assert.NoError(t, err)
+
+				assert.Equal(t, testURLInfo, urlInfo)
+			}
+		})
+	}
+}
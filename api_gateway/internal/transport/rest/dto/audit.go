@@ -0,0 +1,17 @@
+package dto
+
+import "encoding/json"
+
+// AuditRecord values hold the changed fields of a link, OldValue is empty for created links
+type AuditRecord struct {
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor"`
+	SourceIP  string          `json:"source_ip"`
+	OldValue  json.RawMessage `json:"old_value,omitempty" swaggertype:"object"`
+	NewValue  json.RawMessage `json:"new_value,omitempty" swaggertype:"object"`
+	CreatedAt int64           `json:"created_at"`
+}
+
+type AuditLogResponse struct {
+	Records []AuditRecord `json:"records"`
+}
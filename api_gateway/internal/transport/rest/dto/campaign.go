@@ -0,0 +1,7 @@
+package dto
+
+type CampaignStats struct {
+	CampaignID  string `json:"campaign_id"`
+	FollowCount int64  `json:"follow_count"`
+	CreateCount int64  `json:"create_count"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+// ClickBreakdownRow is the number of clicks with the same device_type, os_family, browser_family or is_bot,
+// only the requested dimensions are set
+type ClickBreakdownRow struct {
+	Dimensions map[string]string `json:"dimensions"`
+	Clicks     int64             `json:"clicks"`
+}
+
+type ClickBreakdown struct {
+	ShortURL string              `json:"short_url"`
+	Rows     []ClickBreakdownRow `json:"rows"`
+}
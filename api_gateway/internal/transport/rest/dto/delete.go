@@ -0,0 +1,7 @@
+package dto
+
+// DeletedURL tells until when a deleted link can be restored, RestorableUntil is a unix timestamp
+type DeletedURL struct {
+	ShortURL        string `json:"short_url"`
+	RestorableUntil int64  `json:"restorable_until"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// ErasureRequest names what to erase, either a single link or every link created by Owner.
+// Owner is the X-Actor name the links were created with
+type ErasureRequest struct {
+	Domain   string `json:"domain"`
+	ShortURL string `json:"short_url"`
+	Owner    string `json:"owner"`
+}
+
+type ErasedURL struct {
+	Domain   string `json:"domain"`
+	ShortURL string `json:"short_url"`
+}
+
+// ErasedRows is the number of analytics rows deleted from a table
+type ErasedRows struct {
+	Table string `json:"table"`
+	Rows  int64  `json:"rows"`
+}
+
+// ErasureReport tells what was removed from the url service and from analytics
+type ErasureReport struct {
+	URLs                 []ErasedURL  `json:"urls"`
+	LinksDeleted         int64        `json:"links_deleted"`
+	AuditRecordsRedacted int64        `json:"audit_records_redacted"`
+	OutboxEventsDeleted  int64        `json:"outbox_events_deleted"`
+	AnalyticsRows        []ErasedRows `json:"analytics_rows"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+const (
+	URLStatusActive  = "active"
+	URLStatusFlagged = "flagged"
+)
+
+// ExpandedURL describes a short url without counting a follow.
+// RedirectType is "redirect" or "landing_page", Status is "active" or "flagged"
+type ExpandedURL struct {
+	ShortURL     string       `json:"short_url"`
+	LongURL      string       `json:"long_url,omitempty"`
+	Tags         []string     `json:"tags"`
+	CampaignID   string       `json:"campaign_id,omitempty"`
+	CreatedAt    int64        `json:"created_at"`
+	RedirectType string       `json:"redirect_type"`
+	Status       string       `json:"status"`
+	Metadata     *URLMetadata `json:"metadata,omitempty"`
+	LandingPage  *LandingPage `json:"landing_page,omitempty"`
+}
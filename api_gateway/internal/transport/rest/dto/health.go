@@ -0,0 +1,11 @@
+package dto
+
+// URLHealth times are unix seconds, zero means never
+type URLHealth struct {
+	StatusCode          int32 `json:"status_code"`
+	LatencyMs           int64 `json:"latency_ms"`
+	CheckedAt           int64 `json:"checked_at"`
+	LastSuccessAt       int64 `json:"last_success_at"`
+	ConsecutiveFailures int32 `json:"consecutive_failures"`
+	Flagged             bool  `json:"flagged"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+type LandingItem struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type LandingPage struct {
+	Title string        `json:"title"`
+	Items []LandingItem `json:"items"`
+}
+
+type LandingPageRequest struct {
+	Title  string        `json:"title"`
+	Items  []LandingItem `json:"items"`
+	Alias  string        `json:"alias,omitempty"`
+	Domain string        `json:"domain,omitempty"`
+}
+
+type LandingPageData struct {
+	ShortURL string `json:"short_url"`
+}
+
+// FollowData has either a long url to redirect to or a landing page to render.
+// Flagged is set when the long url failed the recent health checks
+type FollowData struct {
+	LongURL     string
+	LandingPage *LandingPage
+	Preview     *URLPreview
+	BackupURL   string
+	Flagged     bool
+}
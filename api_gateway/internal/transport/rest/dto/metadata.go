@@ -0,0 +1,9 @@
+package dto
+
+type URLMetadata struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	FaviconURL  string `json:"favicon_url"`
+	FinalURL    string `json:"final_url"`
+	FetchedAt   int64  `json:"fetched_at"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type URLPreview struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	ImageURL    string `json:"image_url"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+// TimeSeriesRequest asks for the buckets of a link that start within [From, To).
+// Granularity is minute, hour, day or week, hours, days and weeks start in Timezone
+type TimeSeriesRequest struct {
+	Domain      string
+	ShortURL    string
+	Granularity string
+	From        time.Time
+	To          time.Time
+	Timezone    string
+}
+
+type TimeSeriesPoint struct {
+	Start       time.Time `json:"start"`
+	FollowCount int64     `json:"follow_count"`
+	CreateCount int64     `json:"create_count"`
+}
+
+// URLTimeSeries has every bucket of the range in order, the buckets without events are zero
+type URLTimeSeries struct {
+	ShortURL    string            `json:"short_url"`
+	Granularity string            `json:"granularity"`
+	Timezone    string            `json:"timezone"`
+	From        time.Time         `json:"from"`
+	To          time.Time         `json:"to"`
+	Points      []TimeSeriesPoint `json:"points"`
+}
@@ -1,10 +1,29 @@
 package dto
 
+import "time"
+
+// TopURLData splits FollowCount into the follows of humans and the follows the gateway took for bots
 type TopURLData struct {
-	LongURL     string `json:"long_url"`
-	ShortURL    string `json:"short_url"`
-	FollowCount int64  `json:"follow_count"`
-	CreateCount int64  `json:"create_count"`
+	LongURL  string `json:"long_url"`
+	ShortURL string `json:"short_url"`
+	// Domain is the branded domain ShortURL is on, empty for the default one
+	Domain           string       `json:"domain"`
+	FollowCount      int64        `json:"follow_count"`
+	CreateCount      int64        `json:"create_count"`
+	HumanFollowCount int64        `json:"human_follow_count"`
+	BotFollowCount   int64        `json:"bot_follow_count"`
+	Metadata         *URLMetadata `json:"metadata,omitempty"`
+}
+
+// TopURLsRequest asks for a page of top urls. RankBy is all or human, Window is all, 1h, 24h, 7d, 30d or custom,
+// From and To bound a custom window
+type TopURLsRequest struct {
+	Page   int64
+	Limit  int64
+	RankBy string
+	Window string
+	From   time.Time
+	To     time.Time
 }
 
 type TopURLDataResponse struct {
@@ -13,10 +32,68 @@ type TopURLDataResponse struct {
 }
 
 type LongURLData struct {
-	LongURL string `json:"long_url"`
+	LongURL    string   `json:"long_url"`
+	Alias      string   `json:"alias,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	CampaignID string   `json:"campaign_id,omitempty"`
+	Domain     string   `json:"domain,omitempty"`
+	BackupURL  string   `json:"backup_url,omitempty"`
+}
+
+// ClickContext describes the request that followed a short url, IP is anonymized.
+// DeviceType, OSFamily and BrowserFamily are classified from the user agent,
+// Bot is set when BotScore reached the click fraud threshold
+type ClickContext struct {
+	Referrer       string
+	UserAgent      string
+	IP             string
+	AcceptLanguage string
+	Host           string
+	DeviceType     string
+	OSFamily       string
+	BrowserFamily  string
+	Bot            bool
+	BotScore       int
 }
 
 type URlData struct {
 	LongURL  string `json:"long_url"`
 	ShortURL string `json:"short_url"`
 }
+
+// URLKey names a link, a short url is unique within its domain only
+type URLKey struct {
+	Domain   string
+	ShortURL string
+}
+
+type URLInfo struct {
+	LongURL    string       `json:"long_url"`
+	ShortURL   string       `json:"short_url"`
+	Tags       []string     `json:"tags"`
+	CampaignID string       `json:"campaign_id,omitempty"`
+	CreatedAt  int64        `json:"created_at"`
+	Metadata   *URLMetadata `json:"metadata,omitempty"`
+}
+
+// URLListResponse.NextCursor is empty on the last page
+type URLListResponse struct {
+	URLs       []URLInfo `json:"urls"`
+	NextCursor string    `json:"next_cursor,omitempty"`
+}
+
+// URLListParams filters are optional. CreatedFrom and CreatedTo are unix seconds of inclusive days,
+// Page is ignored when Cursor is set
+type URLListParams struct {
+	Domain            string
+	Tag               string
+	CampaignID        string
+	DestinationDomain string
+	Search            string
+	CreatedFrom       int64
+	CreatedTo         int64
+	Order             string
+	Cursor            string
+	Page              int64
+	Limit             int64
+}
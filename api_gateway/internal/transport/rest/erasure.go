@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"api_gateway/errs"
+	"api_gateway/internal/client"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/transport/rest/response"
+)
+
+// maxErasedURLsPerRequest is the most links the analytics service erases in one request
+const maxErasedURLsPerRequest = 1000
+
+type ErasureHandler struct {
+	logger          *slog.Logger
+	urlClient       client.UrlClient
+	analyticsClient client.AnalyticsClient
+	domainRegistry  *domains.Registry
+	// operatorTokens are the bearer tokens of the operators allowed to erase by operator name
+	operatorTokens map[string]string
+}
+
+func NewErasureHandler(
+	logger *slog.Logger,
+	urlClient client.UrlClient,
+	analyticsClient client.AnalyticsClient,
+	domainRegistry *domains.Registry,
+	operatorTokens map[string]string,
+) *ErasureHandler {
+	return &ErasureHandler{
+		logger:          logger,
+		urlClient:       urlClient,
+		analyticsClient: analyticsClient,
+		domainRegistry:  domainRegistry,
+		operatorTokens:  operatorTokens,
+	}
+}
+
+// EraseData docs
+//
+//	@Summary		Удаление данных ссылки или владельца
+//	@Tags			privacy
+//	@Description	Доступно только операторам, принимает токен оператора в заголовке Authorization: Bearer. Принимает короткую ссылку или владельца (X-Actor, с которым создавались ссылки). Удаляет ссылки без возможности восстановления, обезличивает их записи в журнале изменений и удаляет их аналитику. Возвращает отчет о том, что было удалено
+//	@ID				erase-data
+//	@Accept			json
+//	@Produce		json
+//	@Param			Authorization	header		string				true	"Bearer токен оператора"
+//	@Param			input			body		dto.ErasureRequest	true	"Что удалить"
+//	@Success		200				{object}	dto.ErasureReport
+//	@Failure		400,404			{object}	response.Body
+//	@Failure		401				{object}	response.Body
+//	@Failure		500				{object}	response.Body
+//	@Router			/api/erasure [post]
+func (h *ErasureHandler) EraseData(w http.ResponseWriter, r *http.Request) {
+	// Erasure is not called from browsers, so there are no CORS headers and other sites can not send it with cookies.
+	// X-Actor is not checked by anyone, the operator is who the token belongs to
+	operator, ok := h.authenticateOperator(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="erasure"`)
+		response.Unauthorized(w, "operator token is required")
+		return
+	}
+
+	var erasureRequest dto.ErasureRequest
+	err := json.NewDecoder(r.Body).Decode(&erasureRequest)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	if (erasureRequest.ShortURL == "") == (erasureRequest.Owner == "") {
+		response.BadRequest(w, "either short_url or owner is required")
+		return
+	}
+
+	urlDomain, ok := resolveDomain(h.domainRegistry, erasureRequest.Domain)
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+	erasureRequest.Domain = urlDomain.Key()
+
+	// The subject is not logged, the log would keep what is erased
+	h.logger.Info(fmt.Sprintf("erasure requested by operator %s", operator))
+
+	ctx := client.WithActor(context.Background(), operator, clientIP(r))
+	report, err := h.urlClient.EraseUrlData(ctx, erasureRequest)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "short url not found")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad erasure request")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	report.AnalyticsRows, err = h.eraseAnalytics(report.URLs)
+	if err != nil {
+		// The links are gone from the url service by now, erasing them again by short url finishes the job.
+		// Only the count is logged, the short urls would outlive the erasure in the log
+		h.logger.Error(fmt.Sprintf(
+			"analytics of %d links erased by operator %s is not erased: %s", len(report.URLs), operator, err,
+		))
+		response.InternalServerError(w)
+		return
+	}
+
+	reportBody, err := json.Marshal(report)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, reportBody)
+}
+
+// eraseAnalytics erases the links in batches and sums up the rows deleted from each table
+func (h *ErasureHandler) eraseAnalytics(erasedURLs []dto.ErasedURL) ([]dto.ErasedRows, error) {
+	erasedRows := make([]dto.ErasedRows, 0)
+	tableIndexes := make(map[string]int)
+
+	for start := 0; start < len(erasedURLs); start += maxErasedURLsPerRequest {
+		end := min(start+maxErasedURLsPerRequest, len(erasedURLs))
+
+		batchRows, err := h.analyticsClient.EraseUrlAnalytics(context.Background(), erasedURLs[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tableRows := range batchRows {
+			i, ok := tableIndexes[tableRows.Table]
+			if !ok {
+				tableIndexes[tableRows.Table] = len(erasedRows)
+				erasedRows = append(erasedRows, tableRows)
+				continue
+			}
+			erasedRows[i].Rows += tableRows.Rows
+		}
+	}
+
+	return erasedRows, nil
+}
+
+// authenticateOperator finds the operator of the bearer token, every token is compared in constant time
+func (h *ErasureHandler) authenticateOperator(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return "", false
+	}
+
+	operator := ""
+	for name, operatorToken := range h.operatorTokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(operatorToken)) == 1 {
+			operator = name
+		}
+	}
+
+	return operator, operator != ""
+}
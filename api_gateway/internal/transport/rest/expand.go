@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"api_gateway/errs"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/transport/rest/response"
+)
+
+// expandPageSuffix turns a short url into its preview page, e.g. /abc+
+const expandPageSuffix = "+"
+
+type expandPageView struct {
+	ShortURL  string
+	CreatedAt string
+	Flagged   bool
+	URL       dto.ExpandedURL
+}
+
+// ExpandURL docs
+//
+//	@Summary		Получение информации о короткой ссылке
+//	@Tags			url
+//	@Description	Возвращает исходную ссылку, дату создания, тип перенаправления, статус и метаданные страницы. Переход по ссылке при этом не засчитывается
+//	@ID				expand-url
+//	@Produce		json
+//	@Param			short_url	path		string	true	"короткая ссылка"
+//	@Param			domain		query		string	false	"Домен"
+//	@Success		200			{object}	dto.ExpandedURL
+//	@Failure		400,404		{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url} [get]
+func (h *URLHandler) ExpandURL(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortUrl := r.PathValue(shortUrlPathValue)
+	expanded, ok := h.expandURL(w, urlDomain, shortUrl)
+	if !ok {
+		return
+	}
+
+	expandedBody, err := json.Marshal(expanded)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, expandedBody)
+}
+
+// renderExpandPage shows where a short url leads before following it
+func (h *URLHandler) renderExpandPage(w http.ResponseWriter, urlDomain domains.Domain, shortUrl string) {
+	expanded, ok := h.expandURL(w, urlDomain, shortUrl)
+	if !ok {
+		return
+	}
+
+	h.renderPage(w, expandTemplateName, expandPageView{
+		ShortURL:  expanded.ShortURL,
+		CreatedAt: time.Unix(expanded.CreatedAt, 0).UTC().Format(time.DateOnly),
+		Flagged:   expanded.Status == dto.URLStatusFlagged,
+		URL:       expanded,
+	})
+}
+
+// expandURL writes the error response itself and reports whether the caller can go on
+func (h *URLHandler) expandURL(
+	w http.ResponseWriter,
+	urlDomain domains.Domain,
+	shortUrl string,
+) (dto.ExpandedURL, bool) {
+	expanded, err := h.urlClient.ExpandUrl(context.Background(), urlDomain.Key(), shortUrl)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "short url not found")
+			return dto.ExpandedURL{}, false
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad short url")
+			return dto.ExpandedURL{}, false
+		}
+		response.InternalServerError(w)
+		return dto.ExpandedURL{}, false
+	}
+
+	expanded.ShortURL = urlDomain.ShortURL(shortUrl)
+	return expanded, true
+}
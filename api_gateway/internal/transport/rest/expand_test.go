@@ -0,0 +1,241 @@
+package rest
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpandURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testExpanded := dto.ExpandedURL{
+		ShortURL:     "short",
+		LongURL:      "http://test.long",
+		Tags:         []string{"promo"},
+		CreatedAt:    1700000000,
+		RedirectType: "redirect",
+		Status:       dto.URLStatusActive,
+		Metadata:     &dto.URLMetadata{Title: "Test page"},
+	}
+
+	testCases := []struct {
+		name             string
+		buildUrlClient   func() client.UrlClient
+		query            string
+		expectedCode     int
+		expectedShortURL string
+	}{
+		{
+			name: "Expand url. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(testExpanded, nil)
+
+				return mockClient
+			},
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "Expand url on branded domain. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "go.brand.com", "short").
+					Return(testExpanded, nil)
+
+				return mockClient
+			},
+			query:            "?domain=go.brand.com",
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "https://go.brand.com/short",
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Internal error. 500 Internal Server Error",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{}, errs.ErrInternal)
+
+				return mockClient
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/urls/short"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/urls/{short_url}", handler.ExpandURL)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				expanded := dto.ExpandedURL{}
+				err := json.NewDecoder(rec.Body).Decode(&expanded)
+				assert.NoError(t, err)
+
+				expected := testExpanded
+				expected.ShortURL = tc.expectedShortURL
+				assert.Equal(t, expected, expanded)
+			}
+		})
+	}
+}
+
+func TestExpandPage(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	testCases := []struct {
+		name             string
+		buildUrlClient   func() client.UrlClient
+		expectedCode     int
+		expectedContains []string
+	}{
+		{
+			name: "Preview page does not follow the url. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{
+						LongURL:      "http://test.long",
+						CreatedAt:    1700000000,
+						RedirectType: "redirect",
+						Status:       dto.URLStatusActive,
+						Metadata:     &dto.URLMetadata{Title: "<b>Test page</b>"},
+					}, nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+			expectedContains: []string{
+				`<meta name="robots" content="noindex">`,
+				`<h1>http://test:8000/short</h1>`,
+				`href="http://test.long"`,
+				`&lt;b&gt;Test page&lt;/b&gt;`,
+				`Created 2023-11-14`,
+			},
+		},
+		{
+			name: "Flagged url shows a warning. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{
+						LongURL:      "http://test.long",
+						RedirectType: "redirect",
+						Status:       dto.URLStatusFlagged,
+					}, nil)
+
+				return mockClient
+			},
+			expectedCode:     http.StatusOK,
+			expectedContains: []string{`class="warning"`},
+		},
+		{
+			name: "Landing page lists items. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{
+						RedirectType: "landing_page",
+						Status:       dto.URLStatusActive,
+						LandingPage: &dto.LandingPage{
+							Title: "My links",
+							Items: []dto.LandingItem{{Title: "Blog", URL: "http://test.blog"}},
+						},
+					}, nil)
+
+				return mockClient
+			},
+			expectedCode:     http.StatusOK,
+			expectedContains: []string{"My links", "Blog: http://test.blog"},
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ExpandUrl", mock.Anything, "", "short").
+					Return(dto.ExpandedURL{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/short+", nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /{short_url}", handler.FollowUrl)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			for _, expected := range tc.expectedContains {
+				assert.Contains(t, rec.Body.String(), expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetURLHealth(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testHealth := dto.URLHealth{StatusCode: 404, LatencyMs: 120, CheckedAt: 1700000000, ConsecutiveFailures: 3, Flagged: true}
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		query          string
+		expectedCode   int
+	}{
+		{
+			name: "Get health. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("GetUrlHealth", mock.Anything, "go.brand.com", "short").
+					Return(testHealth, nil)
+
+				return mockClient
+			},
+			query:        "?domain=go.brand.com",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("GetUrlHealth", mock.Anything, "", "short").
+					Return(dto.URLHealth{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/urls/short/health"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/urls/{short_url}/health", handler.GetURLHealth)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				health := dto.URLHealth{}
+				err := json.NewDecoder(rec.Body).Decode(&health)
+				assert.NoError(t, err)
+
+				assert.Equal(t, testHealth, health)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestSaveURLIdempotencyKey(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	withKey := func(key string) interface{} {
+		return mock.MatchedBy(func(ctx context.Context) bool {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			return strings.Join(md.Get("idempotency-key"), ",") == key
+		})
+	}
+
+	testCases := []struct {
+		name           string
+		idempotencyKey string
+		buildUrlClient func() client.UrlClient
+		expectedCode   int
+	}{
+		{
+			name:           "Key is forwarded to the url service. 200 OK",
+			idempotencyKey: "retry-1",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", withKey("retry-1"), mock.Anything).
+					Return("short", nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "No key. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", withKey(""), mock.Anything).
+					Return("short", nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:           "Key reused with another request. 422 Unprocessable Entity",
+			idempotencyKey: "retry-1",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", mock.Anything, mock.Anything).
+					Return("", errs.ErrKeyReused)
+
+				return mockClient
+			},
+			expectedCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Key is too long. 400 Bad Request",
+			idempotencyKey: strings.Repeat("k", maxIdempotencyKeyLen+1),
+			buildUrlClient: func() client.UrlClient {
+				return mocks.NewUrlClient(t)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/save_url", strings.NewReader(`{"long_url":"http://test.long"}`))
+			if tc.idempotencyKey != "" {
+				req.Header.Set(idempotencyKeyHeader, tc.idempotencyKey)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.SaveURL(rec, req)
+			assert.Equal(t, tc.expectedCode, rec.Code)
+		})
+	}
+}
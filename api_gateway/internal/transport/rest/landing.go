@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"api_gateway/errs"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/transport/rest/response"
+)
+
+const (
+	landingItemPathValue = "item"
+	landingItemPath      = "/l"
+)
+
+type landingPageView struct {
+	Title     string
+	Items     []landingItemView
+	OpenGraph openGraphView
+}
+
+type landingItemView struct {
+	Title string
+	Href  string
+}
+
+// CreateLandingPage docs
+//
+//	@Summary		Создание страницы со ссылками
+//	@Tags			url
+//	@Description	Принимает заголовок, список ссылок с заголовками, необязательные alias и домен. Возвращает короткую ссылку на страницу
+//	@ID				create-landing-page
+//	@Accept			json
+//	@Produce		json
+//	@Param			input	body		dto.LandingPageRequest	true	"Страница со ссылками"
+//	@Success		200		{object}	dto.LandingPageData
+//	@Failure		400		{object}	response.Body
+//	@Failure		409		{object}	response.Body
+//	@Failure		500		{object}	response.Body
+//	@Router			/api/landing_pages [post]
+func (h *URLHandler) CreateLandingPage(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	var landingPageRequest dto.LandingPageRequest
+	err := json.NewDecoder(r.Body).Decode(&landingPageRequest)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	urlDomain, ok := h.resolveDomain(landingPageRequest.Domain)
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+	landingPageRequest.Domain = urlDomain.Key()
+
+	shortURLRaw, err := h.urlClient.CreateLandingPage(actorContext(r), landingPageRequest)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, err.Error())
+			return
+		}
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			response.Conflict(w, "alias is already taken")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	landingPageBody, err := json.Marshal(dto.LandingPageData{
+		ShortURL: urlDomain.ShortURL(shortURLRaw),
+	})
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, landingPageBody)
+}
+
+// FollowLandingItem docs
+//
+//	@Summary		Редирект со ссылки на странице со ссылками
+//	@Tags			url
+//	@Description	Принимает короткую ссылку страницы и номер ссылки на ней, учитывает переход и производит редирект
+//	@ID				follow-landing-item
+//	@Param			short_url	path	string	true	"короткая ссылка страницы"
+//	@Param			item		path	int		true	"номер ссылки на странице"
+//	@Success		302
+//	@Failure		400,404	{object}	response.Body
+//	@Failure		500		{object}	response.Body
+//	@Router			/l/{short_url}/{item} [get]
+func (h *URLHandler) FollowLandingItem(w http.ResponseWriter, r *http.Request) {
+	shortUrl := r.PathValue(shortUrlPathValue)
+	position, err := strconv.ParseInt(r.PathValue(landingItemPathValue), 10, 64)
+	if err != nil {
+		response.BadRequest(w, "bad item")
+		return
+	}
+
+	urlDomain, ok := h.domainRegistry.Resolve(r.Host)
+	if !ok {
+		urlDomain = h.domainRegistry.Default()
+	}
+
+	longUrl, err := h.urlClient.FollowLandingItem(
+		context.Background(), urlDomain.Key(), shortUrl, position, h.clickContext(r),
+	)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "landing item not found")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad landing item")
+			return
+		}
+
+		response.InternalServerError(w)
+		return
+	}
+
+	http.Redirect(w, r, longUrl, http.StatusFound)
+}
+
+// renderLandingPage links items to the tracked redirect instead of the item urls,
+// so every click is counted
+func (h *URLHandler) renderLandingPage(
+	w http.ResponseWriter,
+	shortURL string,
+	landingPage dto.LandingPage,
+	openGraph openGraphView,
+) {
+	if openGraph.Title == "" {
+		openGraph.Title = landingPage.Title
+	}
+
+	view := landingPageView{
+		Title:     landingPage.Title,
+		Items:     make([]landingItemView, len(landingPage.Items)),
+		OpenGraph: openGraph,
+	}
+	for i, item := range landingPage.Items {
+		view.Items[i] = landingItemView{
+			Title: item.Title,
+			Href:  fmt.Sprintf("%s/%s/%d", landingItemPath, shortURL, i),
+		}
+	}
+
+	h.renderPage(w, landingTemplateName, view)
+}
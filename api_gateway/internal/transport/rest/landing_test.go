@@ -0,0 +1,246 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollowUrlLandingPage(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	mockClient := mocks.NewUrlClient(t)
+	mockClient.On("FollowUrl", mock.Anything, "", "bio", false, mock.Anything).
+		Return(dto.FollowData{
+			LandingPage: &dto.LandingPage{
+				Title: "<b>My links</b>",
+				Items: []dto.LandingItem{
+					{Title: "Shop", URL: "https://test.shop"},
+					{Title: "Blog", URL: "https://test.blog"},
+				},
+			},
+		}, nil)
+
+	handler := NewURLHandler(
+		logger,
+		mockClient,
+		newTestDomainRegistry(),
+		false,
+		privacy.NewTruncatingAnonymizer(),
+		useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+	)
+
+	req := httptest.NewRequest(http.MethodGet, "/bio", nil)
+	rec := httptest.NewRecorder()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{short_url}", handler.FollowUrl)
+	mux.ServeHTTP(rec, req)
+
+	body := rec.Body.String()
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, body, "&lt;b&gt;My links&lt;/b&gt;")
+	assert.Contains(t, body, `href="/l/bio/0"`)
+	assert.Contains(t, body, `href="/l/bio/1"`)
+	assert.NotContains(t, body, "https://test.shop")
+}
+
+func TestCreateLandingPage(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	basePath := "/api/landing_pages"
+
+	testErr := errors.New("test error")
+	testItems := []dto.LandingItem{{Title: "Shop", URL: "https://test.shop"}}
+
+	testCases := []struct {
+		name             string
+		buildUrlClient   func() client.UrlClient
+		request          dto.LandingPageRequest
+		expectedCode     int
+		expectedShortURL string
+	}{
+		{
+			name: "Create landing page. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("CreateLandingPage", mock.Anything, dto.LandingPageRequest{Title: "bio", Items: testItems}).
+					Return("short", nil)
+
+				return mockClient
+			},
+			request:          dto.LandingPageRequest{Title: "bio", Items: testItems},
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "Create landing page on branded domain. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("CreateLandingPage", mock.Anything, dto.LandingPageRequest{Title: "bio", Items: testItems, Domain: "go.brand.com"}).
+					Return("short", nil)
+
+				return mockClient
+			},
+			request:          dto.LandingPageRequest{Title: "bio", Items: testItems, Domain: "go.brand.com"},
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "https://go.brand.com/short",
+		},
+		{
+			name: "Invalid landing page. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("CreateLandingPage", mock.Anything, mock.Anything).
+					Return("", errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			request:      dto.LandingPageRequest{Title: "bio"},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Alias is already taken. 409 Conflict",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("CreateLandingPage", mock.Anything, mock.Anything).
+					Return("", errs.ErrAlreadyExists)
+
+				return mockClient
+			},
+			request:      dto.LandingPageRequest{Title: "bio", Items: testItems, Alias: "taken"},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Unexpected error. 500 Internal Server Error",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("CreateLandingPage", mock.Anything, mock.Anything).
+					Return("", testErr)
+
+				return mockClient
+			},
+			request:      dto.LandingPageRequest{Title: "bio", Items: testItems},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			var buf bytes.Buffer
+			err := json.NewEncoder(&buf).Encode(tc.request)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, basePath, &buf)
+			rec := httptest.NewRecorder()
+
+			handler.CreateLandingPage(rec, req)
+			assert.Equal(t, tc.expectedCode, rec.Code)
+
+			if rec.Code == http.StatusOK {
+				landingPageData := dto.LandingPageData{}
+				err = json.NewDecoder(rec.Body).Decode(&landingPageData)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tc.expectedShortURL, landingPageData.ShortURL)
+			}
+		})
+	}
+}
+
+func TestFollowLandingItem(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		path           string
+		expectedCode   int
+	}{
+		{
+			name: "Redirect by landing item. 302 Status found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowLandingItem", mock.Anything, "", "bio", int64(1), mock.Anything).
+					Return("https://test.shop", nil)
+
+				return mockClient
+			},
+			path:         "/l/bio/1",
+			expectedCode: http.StatusFound,
+		},
+		{
+			name: "Item is not a number. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			path:         "/l/bio/first",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Landing item not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowLandingItem", mock.Anything, "", "bio", int64(9), mock.Anything).
+					Return("", errs.ErrNotFound)
+
+				return mockClient
+			},
+			path:         "/l/bio/9",
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /l/{short_url}/{item}", handler.FollowLandingItem)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"api_gateway/errs"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/transport/rest/response"
+)
+
+type previewPageView struct {
+	LongURL   string
+	OpenGraph openGraphView
+}
+
+// UpdateURLPreview docs
+//
+//	@Summary		Изменение превью короткой ссылки
+//	@Tags			url
+//	@Description	Принимает заголовок, описание и картинку, которые боты соцсетей показывают в превью короткой ссылки
+//	@ID				update-url-preview
+//	@Accept			json
+//	@Produce		json
+//	@Param			short_url	path		string			true	"короткая ссылка"
+//	@Param			domain		query		string			false	"Домен"
+//	@Param			input		body		dto.URLPreview	true	"Превью"
+//	@Success		200			{object}	dto.URLPreview
+//	@Failure		400,404		{object}	response.Body
+//	@Failure		500			{object}	response.Body
+//	@Router			/api/urls/{short_url}/preview [put]
+func (h *URLHandler) UpdateURLPreview(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	var preview dto.URLPreview
+	err := json.NewDecoder(r.Body).Decode(&preview)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	shortUrl := r.PathValue(shortUrlPathValue)
+	preview, err = h.urlClient.UpdateUrlPreview(actorContext(r), urlDomain.Key(), shortUrl, preview)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			response.NotFound(w, "short url not found")
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, err.Error())
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	previewBody, err := json.Marshal(preview)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, previewBody)
+}
+
+func (h *URLHandler) renderPreviewPage(w http.ResponseWriter, longURL string, openGraph openGraphView) {
+	if openGraph.Title == "" {
+		openGraph.Title = longURL
+	}
+
+	h.renderPage(w, previewTemplateName, previewPageView{
+		LongURL:   longURL,
+		OpenGraph: openGraph,
+	})
+}
+
+func newOpenGraphView(shortURL string, preview *dto.URLPreview) openGraphView {
+	openGraph := openGraphView{URL: shortURL}
+	if preview != nil {
+		openGraph.Title = preview.Title
+		openGraph.Description = preview.Description
+		openGraph.ImageURL = preview.ImageURL
+	}
+
+	return openGraph
+}
@@ -0,0 +1,208 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
+	"api_gateway/internal/client"
+	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/privacy"
+	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFollowUrlCrawler(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	testCases := []struct {
+		name             string
+		userAgent        string
+		buildUrlClient   func() client.UrlClient
+		expectedCode     int
+		expectedContains []string
+	}{
+		{
+			name:      "Slackbot gets preview page. 200 OK",
+			userAgent: "Slackbot-LinkExpanding 1.0 (+https://api.slack.com/robots)",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "", "short", true, mock.Anything).
+					Return(dto.FollowData{
+						LongURL: "http://test.long",
+						Preview: &dto.URLPreview{
+							Title:       "Spring sale",
+							Description: "Everything is 50% off",
+							ImageURL:    "https://test.image/og.png",
+						},
+					}, nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+			expectedContains: []string{
+				`<meta property="og:title" content="Spring sale">`,
+				`<meta property="og:description" content="Everything is 50% off">`,
+				`<meta property="og:image" content="https://test.image/og.png">`,
+				`<meta property="og:url" content="http://test:8000/short">`,
+			},
+		},
+		{
+			name:      "Crawler gets long url as title without preview. 200 OK",
+			userAgent: "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "", "short", true, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long"}, nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusOK,
+			expectedContains: []string{
+				`<meta property="og:title" content="http://test.long">`,
+				`<meta name="twitter:card" content="summary">`,
+			},
+		},
+		{
+			name:      "Browser gets redirect. 302 Status found",
+			userAgent: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/124.0 Safari/537.36",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "", "short", false, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long"}, nil)
+
+				return mockClient
+			},
+			expectedCode: http.StatusFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/short", nil)
+			req.Header.Set("User-Agent", tc.userAgent)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /{short_url}", handler.FollowUrl)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			assert.Equal(t, "User-Agent", rec.Header().Get("Vary"))
+			for _, expected := range tc.expectedContains {
+				assert.Contains(t, rec.Body.String(), expected)
+			}
+		})
+	}
+}
+
+func TestUpdateURLPreview(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testPreview := dto.URLPreview{Title: "title", Description: "description", ImageURL: "https://test.image"}
+
+	testCases := []struct {
+		name           string
+		buildUrlClient func() client.UrlClient
+		query          string
+		expectedCode   int
+	}{
+		{
+			name: "Update preview. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("UpdateUrlPreview", mock.Anything, "go.brand.com", "short", testPreview).
+					Return(testPreview, nil)
+
+				return mockClient
+			},
+			query:        "?domain=go.brand.com",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Short url not found. 404 Not found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("UpdateUrlPreview", mock.Anything, "", "short", testPreview).
+					Return(dto.URLPreview{}, errs.ErrNotFound)
+
+				return mockClient
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Invalid preview. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("UpdateUrlPreview", mock.Anything, "", "short", testPreview).
+					Return(dto.URLPreview{}, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			var buf bytes.Buffer
+			err := json.NewEncoder(&buf).Encode(testPreview)
+			assert.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/urls/short/preview"+tc.query, &buf)
+			rec := httptest.NewRecorder()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("PUT /api/urls/{short_url}/preview", handler.UpdateURLPreview)
+			mux.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if rec.Code == http.StatusOK {
+				preview := dto.URLPreview{}
+				err = json.NewDecoder(rec.Body).Decode(&preview)
+				assert.NoError(t, err)
+
+				assert.Equal(t, testPreview, preview)
+			}
+		})
+	}
+}
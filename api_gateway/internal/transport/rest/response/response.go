@@ -41,10 +41,22 @@ func BadRequest(w http.ResponseWriter, text string) {
 	WriteMessage(w, http.StatusBadRequest, text)
 }
 
+func Unauthorized(w http.ResponseWriter, text string) {
+	WriteMessage(w, http.StatusUnauthorized, text)
+}
+
 func NotFound(w http.ResponseWriter, text string) {
 	WriteMessage(w, http.StatusNotFound, text)
 }
 
+func Conflict(w http.ResponseWriter, text string) {
+	WriteMessage(w, http.StatusConflict, text)
+}
+
+func UnprocessableEntity(w http.ResponseWriter, text string) {
+	WriteMessage(w, http.StatusUnprocessableEntity, text)
+}
+
 func OKMessage(w http.ResponseWriter, text string) {
 	WriteMessage(w, http.StatusOK, text)
 }
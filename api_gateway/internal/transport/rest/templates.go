@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"net/http"
+
+	"api_gateway/internal/transport/rest/response"
+)
+
+const (
+	landingTemplateName = "landing.html"
+	previewTemplateName = "preview.html"
+	expandTemplateName  = "expand.html"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var pageTemplates = template.Must(template.ParseFS(templatesFS, "templates/*.html"))
+
+// openGraphView fills the og meta tags shared by all pages
+type openGraphView struct {
+	Title       string
+	Description string
+	ImageURL    string
+	URL         string
+}
+
+// renderPage renders into a buffer first, so a template error still gets a proper 500
+func (h *URLHandler) renderPage(w http.ResponseWriter, name string, data any) {
+	var buf bytes.Buffer
+	err := pageTemplates.ExecuteTemplate(&buf, name, data)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(buf.Bytes())
+	if err != nil {
+		h.logger.Error(err.Error())
+	}
+}
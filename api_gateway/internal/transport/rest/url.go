@@ -7,33 +7,64 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 
 	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
 	"api_gateway/internal/client"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/privacy"
 	"api_gateway/internal/transport/rest/dto"
 	"api_gateway/internal/transport/rest/response"
+	"api_gateway/internal/useragent"
 )
 
 const (
-	shortUrlPathValue = "short_url"
-	serverProtocol    = "http"
+	shortUrlPathValue  = "short_url"
+	tagQueryParam      = "tag"
+	campaignQueryParam = "campaign_id"
+	domainQueryParam   = "domain"
+
+	cursorQueryParam            = "cursor"
+	orderQueryParam             = "order"
+	destinationDomainQueryParam = "destination_domain"
+	searchQueryParam            = "q"
+	createdFromQueryParam       = "created_from"
+	createdToQueryParam         = "created_to"
+
+	idempotencyKeyHeader = "Idempotency-Key"
+	maxIdempotencyKeyLen = 255
 )
 
 type URLHandler struct {
-	logger       *slog.Logger
-	urlClient    client.UrlClient
-	serverDomain string
+	logger           *slog.Logger
+	urlClient        client.UrlClient
+	domainRegistry   *domains.Registry
+	fallbackToBackup bool
+	ipAnonymizer     privacy.IPAnonymizer
+	uaParser         *useragent.Parser
+	clickScorer      *clickfraud.Scorer
 }
 
+// NewURLHandler with fallbackToBackup redirects flagged links to their backup url when they have one
 func NewURLHandler(
 	logger *slog.Logger,
 	urlClient client.UrlClient,
-	serverDomain string,
+	domainRegistry *domains.Registry,
+	fallbackToBackup bool,
+	ipAnonymizer privacy.IPAnonymizer,
+	uaParser *useragent.Parser,
+	clickScorer *clickfraud.Scorer,
 ) *URLHandler {
 	return &URLHandler{
-		logger:       logger,
-		urlClient:    urlClient,
-		serverDomain: serverDomain,
+		logger:           logger,
+		urlClient:        urlClient,
+		domainRegistry:   domainRegistry,
+		fallbackToBackup: fallbackToBackup,
+		ipAnonymizer:     ipAnonymizer,
+		uaParser:         uaParser,
+		clickScorer:      clickScorer,
 	}
 }
 
@@ -41,9 +72,11 @@ func NewURLHandler(
 //
 //	@Summary		Редирект с короткой ссылки на исходную ссылку
 //	@Tags			url
-//	@Description	Принимает короткую ссылку в path параметрах и производит редирект на исходную ссылку
+//	@Description	Принимает короткую ссылку в path параметрах и производит редирект на исходную ссылку или отдает страницу со ссылками. Ссылка ищется среди ссылок домена из заголовка Host. Боты соцсетей получают страницу с Open Graph разметкой вместо редиректа. Если исходная ссылка не проходит проверки и включен запасной переход, редирект идет на запасную ссылку
 //	@ID				follow-url
 //	@Param			id	query	string	true	"короткая ссылка"
+//	@Produce		html
+//	@Success		200	{string}	string	"Страница со ссылками или Open Graph разметкой"
 //	@Success		302
 //	@Failure		400,404	{object}	response.Body
 //	@Failure		500		{object}	response.Body
@@ -54,7 +87,22 @@ func (h *URLHandler) FollowUrl(w http.ResponseWriter, r *http.Request) {
 
 	shortUrl := r.PathValue(shortUrlPathValue)
 
-	longUrl, err := h.urlClient.FollowUrl(context.Background(), shortUrl)
+	// Unknown hosts, e.g. internal ones behind a proxy, fall back to the default domain
+	urlDomain, ok := h.domainRegistry.Resolve(r.Host)
+	if !ok {
+		urlDomain = h.domainRegistry.Default()
+	}
+
+	if code, ok := strings.CutSuffix(shortUrl, expandPageSuffix); ok {
+		h.renderExpandPage(w, urlDomain, code)
+		return
+	}
+
+	crawler := isCrawler(r.UserAgent())
+	// Crawlers and browsers get different responses for the same url
+	w.Header().Add("Vary", "User-Agent")
+
+	followData, err := h.urlClient.FollowUrl(context.Background(), urlDomain.Key(), shortUrl, crawler, h.clickContext(r))
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
 			response.NotFound(w, "short url not found")
@@ -69,21 +117,38 @@ func (h *URLHandler) FollowUrl(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, longUrl, http.StatusFound)
+	openGraph := newOpenGraphView(urlDomain.ShortURL(shortUrl), followData.Preview)
+	if followData.LandingPage != nil {
+		h.renderLandingPage(w, shortUrl, *followData.LandingPage, openGraph)
+		return
+	}
+	if crawler {
+		h.renderPreviewPage(w, followData.LongURL, openGraph)
+		return
+	}
+
+	if h.fallbackToBackup && followData.Flagged && followData.BackupURL != "" {
+		http.Redirect(w, r, followData.BackupURL, http.StatusFound)
+		return
+	}
+	http.Redirect(w, r, followData.LongURL, http.StatusFound)
 }
 
 // SaveURL docs
 //
 //	@Summary		Создание и сохранение короткой ссылки по исходной ссылки
 //	@Tags			url
-//	@Description	Принимает исходную ссылку, создает короткую ссылку и возвращает короткую ссылку
+//	@Description	Принимает исходную ссылку, необязательные alias, теги, id кампании, домен и запасную ссылку, создает короткую ссылку и возвращает короткую ссылку
 //	@ID				save-url
 //	@Accept			json
 //	@Produce		json
-//	@Param			input	body		dto.LongURLData	true	"Длинная ссылка"
-//	@Success		200		{object}	dto.URlData
-//	@Failure		400		{object}	response.Body
-//	@Failure		500		{object}	response.Body
+//	@Param			input			body		dto.LongURLData	true	"Длинная ссылка"
+//	@Param			Idempotency-Key	header		string			false	"Ключ идемпотентности, повторный запрос с тем же ключом в течение 24 часов вернет первый ответ"
+//	@Success		200				{object}	dto.URlData
+//	@Failure		400				{object}	response.Body
+//	@Failure		409				{object}	response.Body
+//	@Failure		422				{object}	response.Body
+//	@Failure		500				{object}	response.Body
 //	@Router			/api/save_url [post]
 func (h *URLHandler) SaveURL(w http.ResponseWriter, r *http.Request) {
 	origin := r.Header.Get("Origin")
@@ -101,20 +166,43 @@ func (h *URLHandler) SaveURL(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	shortURLRaw, err := h.urlClient.ShortenUrl(context.Background(), longURLData.LongURL)
+	urlDomain, ok := h.resolveDomain(longURLData.Domain)
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+	longURLData.Domain = urlDomain.Key()
+
+	ctx := actorContext(r)
+	if idempotencyKey := r.Header.Get(idempotencyKeyHeader); idempotencyKey != "" {
+		if len(idempotencyKey) > maxIdempotencyKeyLen {
+			response.BadRequest(w, "idempotency key is too long")
+			return
+		}
+		ctx = client.WithIdempotencyKey(ctx, idempotencyKey)
+	}
+
+	shortURLRaw, err := h.urlClient.ShortenUrl(ctx, longURLData)
 	if err != nil {
 		if errors.Is(err, errs.ErrInvalidArgument) {
 			response.BadRequest(w, err.Error())
 			return
 		}
+		if errors.Is(err, errs.ErrAlreadyExists) {
+			response.Conflict(w, "alias is already taken")
+			return
+		}
+		if errors.Is(err, errs.ErrKeyReused) {
+			response.UnprocessableEntity(w, "idempotency key was already used with another request")
+			return
+		}
 		response.InternalServerError(w)
 		return
 	}
 
-	shortURL := fmt.Sprintf("%s://%s/%s", serverProtocol, h.serverDomain, shortURLRaw)
 	urlData := dto.URlData{
 		LongURL:  longURLData.LongURL,
-		ShortURL: shortURL,
+		ShortURL: urlDomain.ShortURL(shortURLRaw),
 	}
 	urlBody, err := json.Marshal(urlData)
 	if err != nil {
@@ -126,6 +214,131 @@ func (h *URLHandler) SaveURL(w http.ResponseWriter, r *http.Request) {
 	response.WriteResponse(w, http.StatusOK, urlBody)
 }
 
+// ListURLs docs
+//
+//	@Summary		Получение списка ссылок
+//	@Tags			url
+//	@Description	Принимает необязательные фильтры: domain, tag, campaign_id, домен исходной ссылки, подстроку исходной ссылки и даты создания. Ссылки сортируются по дате создания, страницы листаются по cursor из предыдущего ответа или по page. Возвращает список ссылок
+//	@ID				list-urls
+//	@Produce		json
+//	@Param			domain				query		string	false	"Домен"
+//	@Param			tag					query		string	false	"Тег"
+//	@Param			campaign_id			query		string	false	"id кампании"
+//	@Param			destination_domain	query		string	false	"Домен исходной ссылки, включая поддомены"
+//	@Param			q					query		string	false	"Подстрока исходной ссылки"
+//	@Param			created_from		query		string	false	"Первый день создания, YYYY-MM-DD"
+//	@Param			created_to			query		string	false	"Последний день создания, YYYY-MM-DD"
+//	@Param			order				query		string	false	"Сортировка по дате создания: desc или asc"	Enums(desc, asc)
+//	@Param			cursor				query		string	false	"next_cursor из предыдущего ответа"
+//	@Param			page				query		int		false	"Страница, не учитывается вместе с cursor"
+//	@Param			limit				query		int		false	"Максимальное количество ссылок на странице"
+//	@Success		200					{object}	dto.URLListResponse
+//	@Failure		400					{object}	response.Body
+//	@Failure		500					{object}	response.Body
+//	@Router			/api/urls [get]
+func (h *URLHandler) ListURLs(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+
+	w.Header().Add("Access-Control-Allow-Origin", origin)
+	w.Header().Add("Access-Control-Allow-Credentials", "true")
+
+	page, err := parseQueryParam(r, pageQueryParam, defaultPage)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	limit, err := parseQueryParam(r, limitQueryParam, defaultLimit)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	urlDomain, ok := h.resolveDomain(r.URL.Query().Get(domainQueryParam))
+	if !ok {
+		response.BadRequest(w, "unknown domain")
+		return
+	}
+
+	createdFrom, err := parseDateQueryParam(r, createdFromQueryParam)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+	createdTo, err := parseDateQueryParam(r, createdToQueryParam)
+	if err != nil {
+		response.BadRequest(w, err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	listResp, err := h.urlClient.ListUrls(context.Background(), dto.URLListParams{
+		Domain:            urlDomain.Key(),
+		Tag:               query.Get(tagQueryParam),
+		CampaignID:        query.Get(campaignQueryParam),
+		DestinationDomain: query.Get(destinationDomainQueryParam),
+		Search:            query.Get(searchQueryParam),
+		CreatedFrom:       createdFrom,
+		CreatedTo:         createdTo,
+		Order:             query.Get(orderQueryParam),
+		Cursor:            query.Get(cursorQueryParam),
+		Page:              int64(page),
+		Limit:             int64(limit),
+	})
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidArgument) {
+			response.BadRequest(w, "bad params")
+			return
+		}
+		response.InternalServerError(w)
+		return
+	}
+
+	for i := range listResp.URLs {
+		listResp.URLs[i].ShortURL = urlDomain.ShortURL(listResp.URLs[i].ShortURL)
+	}
+
+	respBytes, err := json.Marshal(listResp)
+	if err != nil {
+		h.logger.Error(err.Error())
+		response.InternalServerError(w)
+		return
+	}
+
+	response.WriteResponse(w, http.StatusOK, respBytes)
+}
+
+// parseDateQueryParam returns unix seconds of a YYYY-MM-DD day, zero when the param is missing
+func parseDateQueryParam(r *http.Request, key string) (int64, error) {
+	queryParam := r.URL.Query().Get(key)
+	if queryParam == "" {
+		return 0, nil
+	}
+
+	day, err := time.Parse(time.DateOnly, queryParam)
+	if err != nil {
+		return 0, fmt.Errorf("incorrect %s: %s", key, queryParam)
+	}
+
+	return day.Unix(), nil
+}
+
+// resolveDomain treats an empty host as the default domain
+func (h *URLHandler) resolveDomain(host string) (domains.Domain, bool) {
+	return resolveDomain(h.domainRegistry, host)
+}
+
+// resolveDomain picks the default domain for an empty host
+func resolveDomain(domainRegistry *domains.Registry, host string) (domains.Domain, bool) {
+	if host == "" {
+		return domainRegistry.Default(), true
+	}
+
+	return domainRegistry.Resolve(host)
+}
+
 // SaveURLOptions docs
 //
 //	@Summary		Получение описания параметров соединения с сервером
@@ -136,6 +349,6 @@ func (h *URLHandler) SaveURL(w http.ResponseWriter, r *http.Request) {
 //	@Router			/api/save_url [options]
 func (h *URLHandler) SaveURLOptions(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Access-Control-Request-Method", "POST")
-	w.Header().Add("Access-Control-Request-Headers", "x-requested-with")
+	w.Header().Add("Access-Control-Request-Headers", "x-requested-with, idempotency-key")
 	w.Header().Add("Origin", "*")
 }
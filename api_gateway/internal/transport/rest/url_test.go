@@ -12,40 +12,94 @@ import (
 	"testing"
 
 	"api_gateway/errs"
+	"api_gateway/internal/clickfraud"
 	"api_gateway/internal/client"
 	"api_gateway/internal/client/mocks"
+	"api_gateway/internal/domains"
+	"api_gateway/internal/privacy"
 	"api_gateway/internal/transport/rest/dto"
+	"api_gateway/internal/useragent"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+func newTestDomainRegistry() *domains.Registry {
+	return domains.NewRegistry(
+		domains.Domain{Host: "test:8000", BaseURL: "http://test:8000"},
+		[]domains.Domain{{Host: "go.brand.com", BaseURL: "https://go.brand.com"}},
+	)
+}
+
 func TestFollowUrl(t *testing.T) {
 	logger := slog.New(
 		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
 	)
-	serverDomain := "test"
 	basePath := ""
 
 	testErr := errors.New("test error")
 
 	testCases := []struct {
-		name           string
-		buildUrlClient func() client.UrlClient
-		shortURL       string
-		expectedCode   int
+		name             string
+		buildUrlClient   func() client.UrlClient
+		host             string
+		shortURL         string
+		fallbackToBackup bool
+		expectedCode     int
+		expectedLocation string
 	}{
 		{
 			name: "redirect by short url. 302 Status found",
 			buildUrlClient: func() client.UrlClient {
 				mockClient := mocks.NewUrlClient(t)
-				mockClient.On("FollowUrl", mock.Anything, mock.Anything).
-					Return("http://test.long", nil)
+				mockClient.On("FollowUrl", mock.Anything, "", mock.Anything, false, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long"}, nil)
+
+				return mockClient
+			},
+			shortURL:     "short",
+			expectedCode: http.StatusFound,
+		},
+		{
+			name: "redirect by short url on branded domain. 302 Status found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "go.brand.com", "short", false, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long"}, nil)
 
 				return mockClient
 			},
+			host:         "Go.Brand.com:443",
 			shortURL:     "short",
 			expectedCode: http.StatusFound,
 		},
+		{
+			name: "flagged url falls back to backup url. 302 Status found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "", "short", false, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long", BackupURL: "http://test.backup", Flagged: true}, nil)
+
+				return mockClient
+			},
+			shortURL:         "short",
+			fallbackToBackup: true,
+			expectedCode:     http.StatusFound,
+			expectedLocation: "http://test.backup",
+		},
+		{
+			name: "flagged url without fallback keeps long url. 302 Status found",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("FollowUrl", mock.Anything, "", "short", false, mock.Anything).
+					Return(dto.FollowData{LongURL: "http://test.long", BackupURL: "http://test.backup", Flagged: true}, nil)
+
+				return mockClient
+			},
+			shortURL:         "short",
+			fallbackToBackup: false,
+			expectedCode:     http.StatusFound,
+			expectedLocation: "http://test.long",
+		},
 		{
 			name: "short url is empty. 404 Not found",
 			buildUrlClient: func() client.UrlClient {
@@ -59,8 +113,8 @@ func TestFollowUrl(t *testing.T) {
 			name: "short url not found. 404 Not found",
 			buildUrlClient: func() client.UrlClient {
 				mockClient := mocks.NewUrlClient(t)
-				mockClient.On("FollowUrl", mock.Anything, mock.Anything).
-					Return("", errs.ErrNotFound)
+				mockClient.On("FollowUrl", mock.Anything, "", mock.Anything, false, mock.Anything).
+					Return(dto.FollowData{}, errs.ErrNotFound)
 
 				return mockClient
 			},
@@ -71,8 +125,8 @@ func TestFollowUrl(t *testing.T) {
 			name: "unexpected error. 500 Internal Server Error",
 			buildUrlClient: func() client.UrlClient {
 				mockClient := mocks.NewUrlClient(t)
-				mockClient.On("FollowUrl", mock.Anything, mock.Anything).
-					Return("", testErr)
+				mockClient.On("FollowUrl", mock.Anything, "", mock.Anything, false, mock.Anything).
+					Return(dto.FollowData{}, testErr)
 
 				return mockClient
 			},
@@ -86,11 +140,17 @@ func TestFollowUrl(t *testing.T) {
 			handler := NewURLHandler(
 				logger,
 				tc.buildUrlClient(),
-				serverDomain,
+				newTestDomainRegistry(),
+				tc.fallbackToBackup,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
 			)
 
 			path := fmt.Sprintf("%s/%s", basePath, tc.shortURL)
 			req := httptest.NewRequest(http.MethodGet, path, nil)
+			if tc.host != "" {
+				req.Host = tc.host
+			}
 			rec := httptest.NewRecorder()
 
 			mux := http.NewServeMux()
@@ -99,6 +159,9 @@ func TestFollowUrl(t *testing.T) {
 			mux.ServeHTTP(rec, req)
 
 			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedLocation != "" {
+				assert.Equal(t, tc.expectedLocation, rec.Header().Get("Location"))
+			}
 		})
 	}
 }
@@ -111,7 +174,6 @@ func TestSaveURL(t *testing.T) {
 	basePath := "/api/save_url"
 
 	testErr := errors.New("test error")
-	serverDomain := "test:8000"
 
 	testCases := []struct {
 		name             string
@@ -151,7 +213,7 @@ func TestSaveURL(t *testing.T) {
 			},
 			expectedCode:     http.StatusOK,
 			expectedLongURL:  "http://test.long",
-			expectedShortURL: fmt.Sprintf("%s://%s/%s", serverProtocol, serverDomain, "short"),
+			expectedShortURL: "http://test:8000/short",
 		},
 		{
 			name: "Unexpected error while saving url. 500 Internal Server Error",
@@ -169,6 +231,112 @@ func TestSaveURL(t *testing.T) {
 			expectedLongURL:  "",
 			expectedShortURL: "",
 		},
+		{
+			name: "Create short url with alias. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", mock.Anything, dto.LongURLData{LongURL: "http://test.long", Alias: "alias"}).
+					Return("alias", nil)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL: "http://test.long",
+				Alias:   "alias",
+			},
+			expectedCode:     http.StatusOK,
+			expectedLongURL:  "http://test.long",
+			expectedShortURL: "http://test:8000/alias",
+		},
+		{
+			name: "Alias is already taken. 409 Conflict",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", mock.Anything, dto.LongURLData{LongURL: "http://test.long", Alias: "alias"}).
+					Return("", errs.ErrAlreadyExists)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL: "http://test.long",
+				Alias:   "alias",
+			},
+			expectedCode:     http.StatusConflict,
+			expectedLongURL:  "",
+			expectedShortURL: "",
+		},
+		{
+			name: "Create short url with tags and campaign. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				longURLData := dto.LongURLData{
+					LongURL:    "http://test.long",
+					Tags:       []string{"sale"},
+					CampaignID: "spring",
+				}
+				mockClient.On("ShortenUrl", mock.Anything, longURLData).
+					Return("short", nil)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL:    "http://test.long",
+				Tags:       []string{"sale"},
+				CampaignID: "spring",
+			},
+			expectedCode:     http.StatusOK,
+			expectedLongURL:  "http://test.long",
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "Create short url on branded domain. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", mock.Anything, dto.LongURLData{LongURL: "http://test.long", Domain: "go.brand.com"}).
+					Return("short", nil)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL: "http://test.long",
+				Domain:  "go.brand.com",
+			},
+			expectedCode:     http.StatusOK,
+			expectedLongURL:  "http://test.long",
+			expectedShortURL: "https://go.brand.com/short",
+		},
+		{
+			name: "Create short url on default domain by host. 200 Status OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ShortenUrl", mock.Anything, dto.LongURLData{LongURL: "http://test.long"}).
+					Return("short", nil)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL: "http://test.long",
+				Domain:  "test:8000",
+			},
+			expectedCode:     http.StatusOK,
+			expectedLongURL:  "http://test.long",
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			longUrlRequest: dto.LongURLData{
+				LongURL: "http://test.long",
+				Domain:  "unknown.com",
+			},
+			expectedCode:     http.StatusBadRequest,
+			expectedLongURL:  "",
+			expectedShortURL: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -176,7 +344,10 @@ func TestSaveURL(t *testing.T) {
 			handler := NewURLHandler(
 				logger,
 				tc.buildUrlClient(),
-				serverDomain,
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
 			)
 
 			var buf bytes.Buffer
@@ -201,21 +372,188 @@ func TestSaveURL(t *testing.T) {
 	}
 }
 
+func TestListURLs(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	basePath := "/api/urls"
+
+	// The handler rewrites short urls in place, so every case gets its own response
+	testListResp := func() dto.URLListResponse {
+		return dto.URLListResponse{
+			URLs: []dto.URLInfo{
+				{LongURL: "http://test.long", ShortURL: "short", Tags: []string{"sale"}, CampaignID: "spring"},
+			},
+		}
+	}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name             string
+		buildUrlClient   func() client.UrlClient
+		query            string
+		expectedCode     int
+		expectedShortURL string
+	}{
+		{
+			name: "List urls by tag. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ListUrls", mock.Anything, dto.URLListParams{
+					Tag:        "sale",
+					CampaignID: "spring",
+					Page:       defaultPage,
+					Limit:      defaultLimit,
+				}).
+					Return(testListResp(), nil)
+
+				return mockClient
+			},
+			query:            "?tag=sale&campaign_id=spring",
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "List urls on branded domain. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ListUrls", mock.Anything, dto.URLListParams{
+					Domain: "go.brand.com",
+					Page:   defaultPage,
+					Limit:  defaultLimit,
+				}).
+					Return(testListResp(), nil)
+
+				return mockClient
+			},
+			query:            "?domain=go.brand.com",
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "https://go.brand.com/short",
+		},
+		{
+			name: "Unknown domain. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?domain=unknown.com",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Search by destination, dates and cursor. 200 OK",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ListUrls", mock.Anything, dto.URLListParams{
+					DestinationDomain: "example.com",
+					Search:            "promo",
+					CreatedFrom:       1709251200,
+					CreatedTo:         1711843200,
+					Order:             "asc",
+					Cursor:            "next",
+					Page:              defaultPage,
+					Limit:             defaultLimit,
+				}).Return(testListResp(), nil)
+
+				return mockClient
+			},
+			query: "?destination_domain=example.com&q=promo&created_from=2024-03-01&created_to=2024-03-31" +
+				"&order=asc&cursor=next",
+			expectedCode:     http.StatusOK,
+			expectedShortURL: "http://test:8000/short",
+		},
+		{
+			name: "Invalid created date. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?created_from=01.03.2024",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid page. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+
+				return mockClient
+			},
+			query:        "?page=test",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid limit from url service. 400 Bad Request",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ListUrls", mock.Anything, dto.URLListParams{Page: defaultPage, Limit: 1000}).
+					Return(dto.URLListResponse{}, errs.ErrInvalidArgument)
+
+				return mockClient
+			},
+			query:        "?limit=1000",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unexpected error while listing urls. 500 Internal Server Error",
+			buildUrlClient: func() client.UrlClient {
+				mockClient := mocks.NewUrlClient(t)
+				mockClient.On("ListUrls", mock.Anything, mock.Anything).
+					Return(dto.URLListResponse{}, testErr)
+
+				return mockClient
+			},
+			query:        "",
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := NewURLHandler(
+				logger,
+				tc.buildUrlClient(),
+				newTestDomainRegistry(),
+				false,
+				privacy.NewTruncatingAnonymizer(),
+				useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, basePath+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.ListURLs(rec, req)
+			assert.Equal(t, tc.expectedCode, rec.Code)
+
+			if rec.Code == http.StatusOK {
+				listResp := dto.URLListResponse{}
+				err := json.NewDecoder(rec.Body).Decode(&listResp)
+				assert.NoError(t, err)
+
+				assert.Equal(t, tc.expectedShortURL, listResp.URLs[0].ShortURL)
+			}
+		})
+	}
+}
+
 func FuzzSaveURL(f *testing.F) {
 	logger := slog.New(
 		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
 	)
 	basePath := "/api/save_url"
 
-	testShortURL := "http://test/short"
-	serverDomain := "test"
+	testShortURL := "short"
+	domainRegistry := newTestDomainRegistry()
 
 	mockClient := mocks.NewUrlClient(f)
 
 	handler := NewURLHandler(
 		logger,
 		mockClient,
-		serverDomain,
+		domainRegistry,
+		false,
+		privacy.NewTruncatingAnonymizer(),
+		useragent.NewDefaultParser(), clickfraud.NewDefaultScorer(),
 	)
 
 	args := []dto.LongURLData{
@@ -230,7 +568,7 @@ func FuzzSaveURL(f *testing.F) {
 	}
 
 	f.Fuzz(func(t *testing.T, data []byte) {
-		mockClient.On("ShortenUrl", mock.Anything, mock.AnythingOfType("string")).
+		mockClient.On("ShortenUrl", mock.Anything, mock.AnythingOfType("dto.LongURLData")).
 			Return(testShortURL, nil)
 
 		req := httptest.NewRequest(http.MethodPost, basePath, bytes.NewBuffer(data))
@@ -245,6 +583,10 @@ func FuzzSaveURL(f *testing.F) {
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
 			return
 		}
+		if _, ok := domainRegistry.Resolve(longUrlData.Domain); longUrlData.Domain != "" && !ok {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			return
+		}
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
@@ -0,0 +1,153 @@
+package useragent
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+const (
+	DeviceDesktop = "desktop"
+	DeviceBot     = "bot"
+	// Unknown is set for every dimension of an empty User-Agent
+	Unknown = "unknown"
+	// Other is set for a family no rule matched
+	Other = "Other"
+)
+
+// defaultRules is the rule database the gateway is built with, UA_RULES_PATH replaces it without a rebuild
+//
+//go:embed rules.json
+var defaultRules []byte
+
+// Client is what a User-Agent tells about the visitor
+type Client struct {
+	DeviceType    string
+	OSFamily      string
+	BrowserFamily string
+	Bot           bool
+}
+
+// Parser classifies User-Agents with the rules of a database.
+// Rules of a dimension are tried in order and the first match wins
+type Parser struct {
+	bots     []rule
+	browsers []rule
+	oses     []rule
+	devices  []rule
+}
+
+type rule struct {
+	pattern *regexp.Regexp
+	unless  *regexp.Regexp
+	value   string
+}
+
+func (r rule) match(userAgent string) bool {
+	return r.pattern.MatchString(userAgent) && (r.unless == nil || !r.unless.MatchString(userAgent))
+}
+
+type rulesFile struct {
+	Bots     []ruleEntry `json:"bots"`
+	Browsers []ruleEntry `json:"browsers"`
+	OS       []ruleEntry `json:"os"`
+	Devices  []ruleEntry `json:"devices"`
+}
+
+// ruleEntry matches a User-Agent that matches pattern and does not match unless
+type ruleEntry struct {
+	Pattern string `json:"pattern"`
+	Unless  string `json:"unless"`
+	Value   string `json:"value"`
+}
+
+// NewParser compiles a json rule database, see rules.json for the format
+func NewParser(rulesJSON []byte) (*Parser, error) {
+	var file rulesFile
+	err := json.Unmarshal(rulesJSON, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &Parser{}
+	for _, dimension := range []struct {
+		name    string
+		entries []ruleEntry
+		rules   *[]rule
+	}{
+		{name: "bots", entries: file.Bots, rules: &parser.bots},
+		{name: "browsers", entries: file.Browsers, rules: &parser.browsers},
+		{name: "os", entries: file.OS, rules: &parser.oses},
+		{name: "devices", entries: file.Devices, rules: &parser.devices},
+	} {
+		*dimension.rules, err = compileRules(dimension.entries)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", dimension.name, err)
+		}
+	}
+
+	return parser, nil
+}
+
+// NewDefaultParser uses the embedded rule database
+func NewDefaultParser() *Parser {
+	parser, err := NewParser(defaultRules)
+	if err != nil {
+		panic(err)
+	}
+
+	return parser
+}
+
+func compileRules(entries []ruleEntry) ([]rule, error) {
+	rules := make([]rule, len(entries))
+	for i, entry := range entries {
+		var err error
+		rules[i].pattern, err = regexp.Compile(entry.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+
+		if entry.Unless != "" {
+			rules[i].unless, err = regexp.Compile(entry.Unless)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %w", i, err)
+			}
+		}
+		rules[i].value = entry.Value
+	}
+
+	return rules, nil
+}
+
+func (p *Parser) Parse(userAgent string) Client {
+	if userAgent == "" {
+		return Client{DeviceType: Unknown, OSFamily: Unknown, BrowserFamily: Unknown}
+	}
+
+	client := Client{
+		DeviceType:    matchValue(p.devices, userAgent, DeviceDesktop),
+		OSFamily:      matchValue(p.oses, userAgent, Other),
+		BrowserFamily: matchValue(p.browsers, userAgent, Other),
+	}
+	for _, botRule := range p.bots {
+		if botRule.match(userAgent) {
+			client.Bot = true
+			client.DeviceType = DeviceBot
+			break
+		}
+	}
+
+	return client
+}
+
+func matchValue(rules []rule, userAgent string, fallback string) string {
+	for _, r := range rules {
+		if r.match(userAgent) {
+			return r.value
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,118 @@
+package useragent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name           string
+		userAgent      string
+		expectedClient Client
+	}{
+		{
+			name:           "Chrome on Windows",
+			userAgent:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36",
+			expectedClient: Client{DeviceType: "desktop", OSFamily: "Windows", BrowserFamily: "Chrome"},
+		},
+		{
+			name:           "Edge is not taken for Chrome",
+			userAgent:      "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.2478.51",
+			expectedClient: Client{DeviceType: "desktop", OSFamily: "Windows", BrowserFamily: "Edge"},
+		},
+		{
+			name:           "Safari on iPhone",
+			userAgent:      "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1",
+			expectedClient: Client{DeviceType: "mobile", OSFamily: "iOS", BrowserFamily: "Safari"},
+		},
+		{
+			name:           "Chrome on iPad",
+			userAgent:      "Mozilla/5.0 (iPad; CPU OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) CriOS/124.0.6367.88 Mobile/15E148 Safari/604.1",
+			expectedClient: Client{DeviceType: "tablet", OSFamily: "iOS", BrowserFamily: "Chrome"},
+		},
+		{
+			name:           "Android phone",
+			userAgent:      "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.6367.82 Mobile Safari/537.36",
+			expectedClient: Client{DeviceType: "mobile", OSFamily: "Android", BrowserFamily: "Chrome"},
+		},
+		{
+			name:           "Android without Mobile is a tablet",
+			userAgent:      "Mozilla/5.0 (Linux; Android 13; SM-X710) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/24.0 Chrome/117.0.0.0 Safari/537.36",
+			expectedClient: Client{DeviceType: "tablet", OSFamily: "Android", BrowserFamily: "Samsung Internet"},
+		},
+		{
+			name:           "Firefox on Linux",
+			userAgent:      "Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:125.0) Gecko/20100101 Firefox/125.0",
+			expectedClient: Client{DeviceType: "desktop", OSFamily: "Linux", BrowserFamily: "Firefox"},
+		},
+		{
+			name:           "Safari on macOS",
+			userAgent:      "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4.1 Safari/605.1.15",
+			expectedClient: Client{DeviceType: "desktop", OSFamily: "macOS", BrowserFamily: "Safari"},
+		},
+		{
+			name:           "Smart TV",
+			userAgent:      "Mozilla/5.0 (SMART-TV; Linux; Tizen 6.0) AppleWebKit/537.36 (KHTML, like Gecko) SamsungBrowser/4.0 Chrome/76.0.3809.146 TV Safari/537.36",
+			expectedClient: Client{DeviceType: "tv", OSFamily: "Linux", BrowserFamily: "Samsung Internet"},
+		},
+		{
+			name:           "Search engine crawler",
+			userAgent:      "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			expectedClient: Client{DeviceType: "bot", OSFamily: "Other", BrowserFamily: "Other", Bot: true},
+		},
+		{
+			name:           "Link preview crawler",
+			userAgent:      "facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)",
+			expectedClient: Client{DeviceType: "bot", OSFamily: "Other", BrowserFamily: "Other", Bot: true},
+		},
+		{
+			name:           "Uptime checker",
+			userAgent:      "Mozilla/5.0+(compatible; UptimeRobot/2.0; http://www.uptimerobot.com/)",
+			expectedClient: Client{DeviceType: "bot", OSFamily: "Other", BrowserFamily: "Other", Bot: true},
+		},
+		{
+			name:           "Http client",
+			userAgent:      "curl/8.5.0",
+			expectedClient: Client{DeviceType: "bot", OSFamily: "Other", BrowserFamily: "Other", Bot: true},
+		},
+		{
+			name:           "Cubot phone is not a bot",
+			userAgent:      "Mozilla/5.0 (Linux; Android 10; CUBOT X30) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/96.0.4664.45 Mobile Safari/537.36",
+			expectedClient: Client{DeviceType: "mobile", OSFamily: "Android", BrowserFamily: "Chrome"},
+		},
+		{
+			name:           "Empty User-Agent",
+			userAgent:      "",
+			expectedClient: Client{DeviceType: "unknown", OSFamily: "unknown", BrowserFamily: "unknown"},
+		},
+	}
+
+	parser := NewDefaultParser()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedClient, parser.Parse(tc.userAgent))
+		})
+	}
+}
+
+func TestNewParser(t *testing.T) {
+	t.Run("Custom rules replace the embedded ones", func(t *testing.T) {
+		parser, err := NewParser([]byte(`{"browsers": [{"pattern": "MyBrowser/", "value": "My Browser"}]}`))
+		assert.NoError(t, err)
+
+		assert.Equal(t, Client{DeviceType: "desktop", OSFamily: "Other", BrowserFamily: "My Browser"}, parser.Parse("MyBrowser/1.0"))
+		assert.Equal(t, Client{DeviceType: "desktop", OSFamily: "Other", BrowserFamily: "Other"}, parser.Parse("curl/8.5.0"))
+	})
+
+	t.Run("Invalid pattern is rejected", func(t *testing.T) {
+		_, err := NewParser([]byte(`{"os": [{"pattern": "(", "value": "Broken"}]}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("Invalid json is rejected", func(t *testing.T) {
+		_, err := NewParser([]byte(`{"os": `))
+		assert.Error(t, err)
+	})
+}
@@ -7,11 +7,10 @@
 package analytics
 
 import (
-	reflect "reflect"
-	sync "sync"
-
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -21,13 +20,238 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TopUrlsRank is the follow count top urls are ranked by
+type TopUrlsRank int32
+
+const (
+	TopUrlsRank_TOP_URLS_RANK_ALL_FOLLOWS TopUrlsRank = 0
+	// Only follows the gateway did not take for bots
+	TopUrlsRank_TOP_URLS_RANK_HUMAN_FOLLOWS TopUrlsRank = 1
+)
+
+// Enum value maps for TopUrlsRank.
+var (
+	TopUrlsRank_name = map[int32]string{
+		0: "TOP_URLS_RANK_ALL_FOLLOWS",
+		1: "TOP_URLS_RANK_HUMAN_FOLLOWS",
+	}
+	TopUrlsRank_value = map[string]int32{
+		"TOP_URLS_RANK_ALL_FOLLOWS":   0,
+		"TOP_URLS_RANK_HUMAN_FOLLOWS": 1,
+	}
+)
+
+func (x TopUrlsRank) Enum() *TopUrlsRank {
+	p := new(TopUrlsRank)
+	*p = x
+	return p
+}
+
+func (x TopUrlsRank) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopUrlsRank) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_topurls_proto_enumTypes[0].Descriptor()
+}
+
+func (TopUrlsRank) Type() protoreflect.EnumType {
+	return &file_pkg_proto_topurls_proto_enumTypes[0]
+}
+
+func (x TopUrlsRank) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopUrlsRank.Descriptor instead.
+func (TopUrlsRank) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{0}
+}
+
+// TopUrlsWindow is the time the events of top urls are counted within, the last ones end now
+type TopUrlsWindow int32
+
+const (
+	TopUrlsWindow_TOP_URLS_WINDOW_ALL_TIME   TopUrlsWindow = 0
+	TopUrlsWindow_TOP_URLS_WINDOW_LAST_HOUR  TopUrlsWindow = 1
+	TopUrlsWindow_TOP_URLS_WINDOW_LAST_DAY   TopUrlsWindow = 2
+	TopUrlsWindow_TOP_URLS_WINDOW_LAST_WEEK  TopUrlsWindow = 3
+	TopUrlsWindow_TOP_URLS_WINDOW_LAST_MONTH TopUrlsWindow = 4
+	// Within [from, to)
+	TopUrlsWindow_TOP_URLS_WINDOW_CUSTOM TopUrlsWindow = 5
+)
+
+// Enum value maps for TopUrlsWindow.
+var (
+	TopUrlsWindow_name = map[int32]string{
+		0: "TOP_URLS_WINDOW_ALL_TIME",
+		1: "TOP_URLS_WINDOW_LAST_HOUR",
+		2: "TOP_URLS_WINDOW_LAST_DAY",
+		3: "TOP_URLS_WINDOW_LAST_WEEK",
+		4: "TOP_URLS_WINDOW_LAST_MONTH",
+		5: "TOP_URLS_WINDOW_CUSTOM",
+	}
+	TopUrlsWindow_value = map[string]int32{
+		"TOP_URLS_WINDOW_ALL_TIME":   0,
+		"TOP_URLS_WINDOW_LAST_HOUR":  1,
+		"TOP_URLS_WINDOW_LAST_DAY":   2,
+		"TOP_URLS_WINDOW_LAST_WEEK":  3,
+		"TOP_URLS_WINDOW_LAST_MONTH": 4,
+		"TOP_URLS_WINDOW_CUSTOM":     5,
+	}
+)
+
+func (x TopUrlsWindow) Enum() *TopUrlsWindow {
+	p := new(TopUrlsWindow)
+	*p = x
+	return p
+}
+
+func (x TopUrlsWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopUrlsWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_topurls_proto_enumTypes[1].Descriptor()
+}
+
+func (TopUrlsWindow) Type() protoreflect.EnumType {
+	return &file_pkg_proto_topurls_proto_enumTypes[1]
+}
+
+func (x TopUrlsWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopUrlsWindow.Descriptor instead.
+func (TopUrlsWindow) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{1}
+}
+
+// ClickDimension is a user agent class clicks are grouped by
+type ClickDimension int32
+
+const (
+	ClickDimension_CLICK_DIMENSION_UNSPECIFIED    ClickDimension = 0
+	ClickDimension_CLICK_DIMENSION_DEVICE_TYPE    ClickDimension = 1
+	ClickDimension_CLICK_DIMENSION_OS_FAMILY      ClickDimension = 2
+	ClickDimension_CLICK_DIMENSION_BROWSER_FAMILY ClickDimension = 3
+	ClickDimension_CLICK_DIMENSION_BOT            ClickDimension = 4
+)
+
+// Enum value maps for ClickDimension.
+var (
+	ClickDimension_name = map[int32]string{
+		0: "CLICK_DIMENSION_UNSPECIFIED",
+		1: "CLICK_DIMENSION_DEVICE_TYPE",
+		2: "CLICK_DIMENSION_OS_FAMILY",
+		3: "CLICK_DIMENSION_BROWSER_FAMILY",
+		4: "CLICK_DIMENSION_BOT",
+	}
+	ClickDimension_value = map[string]int32{
+		"CLICK_DIMENSION_UNSPECIFIED":    0,
+		"CLICK_DIMENSION_DEVICE_TYPE":    1,
+		"CLICK_DIMENSION_OS_FAMILY":      2,
+		"CLICK_DIMENSION_BROWSER_FAMILY": 3,
+		"CLICK_DIMENSION_BOT":            4,
+	}
+)
+
+func (x ClickDimension) Enum() *ClickDimension {
+	p := new(ClickDimension)
+	*p = x
+	return p
+}
+
+func (x ClickDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClickDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_topurls_proto_enumTypes[2].Descriptor()
+}
+
+func (ClickDimension) Type() protoreflect.EnumType {
+	return &file_pkg_proto_topurls_proto_enumTypes[2]
+}
+
+func (x ClickDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClickDimension.Descriptor instead.
+func (ClickDimension) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{2}
+}
+
+type TimeSeriesGranularity int32
+
+const (
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED TimeSeriesGranularity = 0
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_MINUTE      TimeSeriesGranularity = 1
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_HOUR        TimeSeriesGranularity = 2
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_DAY         TimeSeriesGranularity = 3
+	// Weeks start on Monday
+	TimeSeriesGranularity_TIME_SERIES_GRANULARITY_WEEK TimeSeriesGranularity = 4
+)
+
+// Enum value maps for TimeSeriesGranularity.
+var (
+	TimeSeriesGranularity_name = map[int32]string{
+		0: "TIME_SERIES_GRANULARITY_UNSPECIFIED",
+		1: "TIME_SERIES_GRANULARITY_MINUTE",
+		2: "TIME_SERIES_GRANULARITY_HOUR",
+		3: "TIME_SERIES_GRANULARITY_DAY",
+		4: "TIME_SERIES_GRANULARITY_WEEK",
+	}
+	TimeSeriesGranularity_value = map[string]int32{
+		"TIME_SERIES_GRANULARITY_UNSPECIFIED": 0,
+		"TIME_SERIES_GRANULARITY_MINUTE":      1,
+		"TIME_SERIES_GRANULARITY_HOUR":        2,
+		"TIME_SERIES_GRANULARITY_DAY":         3,
+		"TIME_SERIES_GRANULARITY_WEEK":        4,
+	}
+)
+
+func (x TimeSeriesGranularity) Enum() *TimeSeriesGranularity {
+	p := new(TimeSeriesGranularity)
+	*p = x
+	return p
+}
+
+func (x TimeSeriesGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeSeriesGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_topurls_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeSeriesGranularity) Type() protoreflect.EnumType {
+	return &file_pkg_proto_topurls_proto_enumTypes[3]
+}
+
+func (x TimeSeriesGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeSeriesGranularity.Descriptor instead.
+func (TimeSeriesGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{3}
+}
+
+// TopUrlsRequest from and to are unix seconds, they are only read for TOP_URLS_WINDOW_CUSTOM
 type TopUrlsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page   int64         `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit  int64         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	RankBy TopUrlsRank   `protobuf:"varint,3,opt,name=rankBy,proto3,enum=analytics.TopUrlsRank" json:"rankBy,omitempty"`
+	Window TopUrlsWindow `protobuf:"varint,4,opt,name=window,proto3,enum=analytics.TopUrlsWindow" json:"window,omitempty"`
+	From   int64         `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`
+	To     int64         `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`
 }
 
 func (x *TopUrlsRequest) Reset() {
@@ -76,6 +300,34 @@ func (x *TopUrlsRequest) GetLimit() int64 {
 	return 0
 }
 
+func (x *TopUrlsRequest) GetRankBy() TopUrlsRank {
+	if x != nil {
+		return x.RankBy
+	}
+	return TopUrlsRank_TOP_URLS_RANK_ALL_FOLLOWS
+}
+
+func (x *TopUrlsRequest) GetWindow() TopUrlsWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TopUrlsWindow_TOP_URLS_WINDOW_ALL_TIME
+}
+
+func (x *TopUrlsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *TopUrlsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
 type Pagination struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -160,10 +412,14 @@ type TopUrlData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LongUrl     string `protobuf:"bytes,1,opt,name=longUrl,proto3" json:"longUrl,omitempty"`
-	ShortUrl    string `protobuf:"bytes,2,opt,name=shortUrl,proto3" json:"shortUrl,omitempty"`
-	FollowCount int64  `protobuf:"varint,3,opt,name=followCount,proto3" json:"followCount,omitempty"`
-	CreateCount int64  `protobuf:"varint,4,opt,name=createCount,proto3" json:"createCount,omitempty"`
+	LongUrl          string `protobuf:"bytes,1,opt,name=longUrl,proto3" json:"longUrl,omitempty"`
+	ShortUrl         string `protobuf:"bytes,2,opt,name=shortUrl,proto3" json:"shortUrl,omitempty"`
+	FollowCount      int64  `protobuf:"varint,3,opt,name=followCount,proto3" json:"followCount,omitempty"`
+	CreateCount      int64  `protobuf:"varint,4,opt,name=createCount,proto3" json:"createCount,omitempty"`
+	HumanFollowCount int64  `protobuf:"varint,5,opt,name=humanFollowCount,proto3" json:"humanFollowCount,omitempty"`
+	BotFollowCount   int64  `protobuf:"varint,6,opt,name=botFollowCount,proto3" json:"botFollowCount,omitempty"`
+	// domain is the branded domain shortUrl is on, empty for the default one
+	Domain string `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain,omitempty"`
 }
 
 func (x *TopUrlData) Reset() {
@@ -226,6 +482,27 @@ func (x *TopUrlData) GetCreateCount() int64 {
 	return 0
 }
 
+func (x *TopUrlData) GetHumanFollowCount() int64 {
+	if x != nil {
+		return x.HumanFollowCount
+	}
+	return 0
+}
+
+func (x *TopUrlData) GetBotFollowCount() int64 {
+	if x != nil {
+		return x.BotFollowCount
+	}
+	return 0
+}
+
+func (x *TopUrlData) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
 type TopUrlsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -281,113 +558,995 @@ func (x *TopUrlsResponse) GetPagination() *Pagination {
 	return nil
 }
 
-var File_pkg_proto_topurls_proto protoreflect.FileDescriptor
+type CampaignStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_pkg_proto_topurls_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x70, 0x75,
-	0x72, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e,
-	0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12,
-	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x65,
-	0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x50, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x50, 0x61, 0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x1a,
-	0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f,
-	0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x74, 0x6f,
-	0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32,
-	0x52, 0x0a, 0x09, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x3b, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	CampaignId string `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
 }
 
-var (
-	file_pkg_proto_topurls_proto_rawDescOnce sync.Once
-	file_pkg_proto_topurls_proto_rawDescData = file_pkg_proto_topurls_proto_rawDesc
-)
+func (x *CampaignStatsRequest) Reset() {
+	*x = CampaignStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_pkg_proto_topurls_proto_rawDescGZIP() []byte {
-	file_pkg_proto_topurls_proto_rawDescOnce.Do(func() {
-		file_pkg_proto_topurls_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_topurls_proto_rawDescData)
-	})
-	return file_pkg_proto_topurls_proto_rawDescData
+func (x *CampaignStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_pkg_proto_topurls_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_pkg_proto_topurls_proto_goTypes = []interface{}{
-	(*TopUrlsRequest)(nil),  // 0: analytics.TopUrlsRequest
-	(*Pagination)(nil),      // 1: analytics.Pagination
-	(*TopUrlData)(nil),      // 2: analytics.TopUrlData
-	(*TopUrlsResponse)(nil), // 3: analytics.TopUrlsResponse
+func (*CampaignStatsRequest) ProtoMessage() {}
+
+func (x *CampaignStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_pkg_proto_topurls_proto_depIdxs = []int32{
-	2, // 0: analytics.TopUrlsResponse.topUrlData:type_name -> analytics.TopUrlData
-	1, // 1: analytics.TopUrlsResponse.pagination:type_name -> analytics.Pagination
-	0, // 2: analytics.Analytics.GetTopUrls:input_type -> analytics.TopUrlsRequest
-	3, // 3: analytics.Analytics.GetTopUrls:output_type -> analytics.TopUrlsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use CampaignStatsRequest.ProtoReflect.Descriptor instead.
+func (*CampaignStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_pkg_proto_topurls_proto_init() }
-func file_pkg_proto_topurls_proto_init() {
-	if File_pkg_proto_topurls_proto != nil {
-		return
+func (x *CampaignStatsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_proto_topurls_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TopUrlsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+type CampaignStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CampaignId  string `protobuf:"bytes,1,opt,name=campaignId,proto3" json:"campaignId,omitempty"`
+	FollowCount int64  `protobuf:"varint,2,opt,name=followCount,proto3" json:"followCount,omitempty"`
+	CreateCount int64  `protobuf:"varint,3,opt,name=createCount,proto3" json:"createCount,omitempty"`
+}
+
+func (x *CampaignStatsResponse) Reset() {
+	*x = CampaignStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CampaignStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CampaignStatsResponse) ProtoMessage() {}
+
+func (x *CampaignStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pkg_proto_topurls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CampaignStatsResponse.ProtoReflect.Descriptor instead.
+func (*CampaignStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CampaignStatsResponse) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *CampaignStatsResponse) GetFollowCount() int64 {
+	if x != nil {
+		return x.FollowCount
+	}
+	return 0
+}
+
+func (x *CampaignStatsResponse) GetCreateCount() int64 {
+	if x != nil {
+		return x.CreateCount
+	}
+	return 0
+}
+
+// ErasedUrl is a link to erase, domain is empty for the default one
+type ErasedUrl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain   string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	ShortUrl string `protobuf:"bytes,2,opt,name=shortUrl,proto3" json:"shortUrl,omitempty"`
+}
+
+func (x *ErasedUrl) Reset() {
+	*x = ErasedUrl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErasedUrl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasedUrl) ProtoMessage() {}
+
+func (x *ErasedUrl) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pkg_proto_topurls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasedUrl.ProtoReflect.Descriptor instead.
+func (*ErasedUrl) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ErasedUrl) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ErasedUrl) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+// EraseUrlAnalyticsRequest lists the links to erase, the gateway resolves an owner to their links.
+// Bare short urls matched the same code on every domain, so they are not taken anymore
+type EraseUrlAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Urls []*ErasedUrl `protobuf:"bytes,2,rep,name=urls,proto3" json:"urls,omitempty"`
+}
+
+func (x *EraseUrlAnalyticsRequest) Reset() {
+	*x = EraseUrlAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseUrlAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUrlAnalyticsRequest) ProtoMessage() {}
+
+func (x *EraseUrlAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUrlAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*EraseUrlAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EraseUrlAnalyticsRequest) GetUrls() []*ErasedUrl {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+// ErasedRows is the number of rows deleted from a table
+type ErasedRows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	Rows  int64  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *ErasedRows) Reset() {
+	*x = ErasedRows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErasedRows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErasedRows) ProtoMessage() {}
+
+func (x *ErasedRows) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErasedRows.ProtoReflect.Descriptor instead.
+func (*ErasedRows) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ErasedRows) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *ErasedRows) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type EraseUrlAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErasedRows []*ErasedRows `protobuf:"bytes,1,rep,name=erasedRows,proto3" json:"erasedRows,omitempty"`
+}
+
+func (x *EraseUrlAnalyticsResponse) Reset() {
+	*x = EraseUrlAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EraseUrlAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUrlAnalyticsResponse) ProtoMessage() {}
+
+func (x *EraseUrlAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUrlAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*EraseUrlAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EraseUrlAnalyticsResponse) GetErasedRows() []*ErasedRows {
+	if x != nil {
+		return x.ErasedRows
+	}
+	return nil
+}
+
+// ClickBreakdownRequest groups the clicks of a link, or of every link when shortUrl is empty.
+// domain is the one shortUrl is on, empty for the default one
+type ClickBreakdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl   string           `protobuf:"bytes,1,opt,name=shortUrl,proto3" json:"shortUrl,omitempty"`
+	Dimensions []ClickDimension `protobuf:"varint,2,rep,packed,name=dimensions,proto3,enum=analytics.ClickDimension" json:"dimensions,omitempty"`
+	Domain     string           `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *ClickBreakdownRequest) Reset() {
+	*x = ClickBreakdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickBreakdownRequest) ProtoMessage() {}
+
+func (x *ClickBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*ClickBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ClickBreakdownRequest) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *ClickBreakdownRequest) GetDimensions() []ClickDimension {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *ClickBreakdownRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// ClickBreakdownRow is keyed by device_type, os_family, browser_family and is_bot, whichever were requested
+type ClickBreakdownRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dimensions map[string]string `protobuf:"bytes,1,rep,name=dimensions,proto3" json:"dimensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Clicks     int64             `protobuf:"varint,2,opt,name=clicks,proto3" json:"clicks,omitempty"`
+}
+
+func (x *ClickBreakdownRow) Reset() {
+	*x = ClickBreakdownRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickBreakdownRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickBreakdownRow) ProtoMessage() {}
+
+func (x *ClickBreakdownRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickBreakdownRow.ProtoReflect.Descriptor instead.
+func (*ClickBreakdownRow) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClickBreakdownRow) GetDimensions() map[string]string {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *ClickBreakdownRow) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+type ClickBreakdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*ClickBreakdownRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *ClickBreakdownResponse) Reset() {
+	*x = ClickBreakdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClickBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClickBreakdownResponse) ProtoMessage() {}
+
+func (x *ClickBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClickBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*ClickBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClickBreakdownResponse) GetRows() []*ClickBreakdownRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// UrlTimeSeriesRequest asks for the buckets that start within [from, to), from and to are unix seconds.
+// timezone is an IANA name hours, days and weeks start in, empty is UTC.
+// domain is the one shortUrl is on, empty for the default one
+type UrlTimeSeriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShortUrl    string                `protobuf:"bytes,1,opt,name=shortUrl,proto3" json:"shortUrl,omitempty"`
+	Granularity TimeSeriesGranularity `protobuf:"varint,2,opt,name=granularity,proto3,enum=analytics.TimeSeriesGranularity" json:"granularity,omitempty"`
+	From        int64                 `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To          int64                 `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone    string                `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Domain      string                `protobuf:"bytes,6,opt,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *UrlTimeSeriesRequest) Reset() {
+	*x = UrlTimeSeriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrlTimeSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrlTimeSeriesRequest) ProtoMessage() {}
+
+func (x *UrlTimeSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrlTimeSeriesRequest.ProtoReflect.Descriptor instead.
+func (*UrlTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UrlTimeSeriesRequest) GetShortUrl() string {
+	if x != nil {
+		return x.ShortUrl
+	}
+	return ""
+}
+
+func (x *UrlTimeSeriesRequest) GetGranularity() TimeSeriesGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return TimeSeriesGranularity_TIME_SERIES_GRANULARITY_UNSPECIFIED
+}
+
+func (x *UrlTimeSeriesRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *UrlTimeSeriesRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *UrlTimeSeriesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UrlTimeSeriesRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// TimeSeriesPoint is a bucket, start is unix seconds
+type TimeSeriesPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start       int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	FollowCount int64 `protobuf:"varint,2,opt,name=followCount,proto3" json:"followCount,omitempty"`
+	CreateCount int64 `protobuf:"varint,3,opt,name=createCount,proto3" json:"createCount,omitempty"`
+}
+
+func (x *TimeSeriesPoint) Reset() {
+	*x = TimeSeriesPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSeriesPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSeriesPoint) ProtoMessage() {}
+
+func (x *TimeSeriesPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSeriesPoint.ProtoReflect.Descriptor instead.
+func (*TimeSeriesPoint) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TimeSeriesPoint) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TimeSeriesPoint) GetFollowCount() int64 {
+	if x != nil {
+		return x.FollowCount
+	}
+	return 0
+}
+
+func (x *TimeSeriesPoint) GetCreateCount() int64 {
+	if x != nil {
+		return x.CreateCount
+	}
+	return 0
+}
+
+// UrlTimeSeriesResponse has every bucket of the range in order, the buckets without events are zero
+type UrlTimeSeriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*TimeSeriesPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *UrlTimeSeriesResponse) Reset() {
+	*x = UrlTimeSeriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_topurls_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UrlTimeSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UrlTimeSeriesResponse) ProtoMessage() {}
+
+func (x *UrlTimeSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_topurls_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UrlTimeSeriesResponse.ProtoReflect.Descriptor instead.
+func (*UrlTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_topurls_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UrlTimeSeriesResponse) GetPoints() []*TimeSeriesPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+var File_pkg_proto_topurls_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_topurls_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x6f, 0x70, 0x75,
+	0x72, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x6b, 0x42,
+	0x79, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x55, 0x72, 0x6c, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x22, 0xf2, 0x01, 0x0a,
+	0x0a, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6c,
+	0x6f, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f,
+	0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x68, 0x75, 0x6d, 0x61, 0x6e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x6f, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x6f, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x22, 0x7f, 0x0a, 0x0f, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0a, 0x74, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x14, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x15, 0x43, 0x61,
+	0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x45, 0x72, 0x61, 0x73, 0x65,
+	0x64, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x55, 0x0a, 0x18, 0x45, 0x72, 0x61, 0x73,
+	0x65, 0x55, 0x72, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x45,
+	0x72, 0x61, 0x73, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x4a, 0x04,
+	0x08, 0x01, 0x10, 0x02, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c, 0x73, 0x22,
+	0x36, 0x0a, 0x0a, 0x45, 0x72, 0x61, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x52, 0x0a, 0x19, 0x45, 0x72, 0x61, 0x73, 0x65,
+	0x55, 0x72, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x65, 0x72, 0x61, 0x73, 0x65, 0x64, 0x52, 0x6f,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x52,
+	0x0a, 0x65, 0x72, 0x61, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x15,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72,
+	0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x22, 0xb8, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x77, 0x12, 0x4c, 0x0a, 0x0a, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x77, 0x2e, 0x44, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x4a, 0x0a, 0x16, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x14,
+	0x55, 0x72, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x55, 0x72, 0x6c,
+	0x12, 0x42, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x22, 0x6b, 0x0a, 0x0f,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x15, 0x55, 0x72, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2a, 0x4d, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c,
+	0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c,
+	0x53, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f,
+	0x57, 0x53, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c, 0x53,
+	0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x48, 0x55, 0x4d, 0x41, 0x4e, 0x5f, 0x46, 0x4f, 0x4c, 0x4c,
+	0x4f, 0x57, 0x53, 0x10, 0x01, 0x2a, 0xc5, 0x01, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c,
+	0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x50, 0x5f, 0x55,
+	0x52, 0x4c, 0x53, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c,
+	0x53, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x48, 0x4f,
+	0x55, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c, 0x53,
+	0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x41, 0x59,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c, 0x53, 0x5f, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10,
+	0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c, 0x53, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10,
+	0x04, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x52, 0x4c, 0x53, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x05, 0x2a, 0xae, 0x01,
+	0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x4d, 0x45,
+	0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x53, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x10,
+	0x02, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x52, 0x4f, 0x57, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x4d,
+	0x49, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x5f, 0x44,
+	0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x4f, 0x54, 0x10, 0x04, 0x2a, 0xc9,
+	0x01, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x47, 0x72, 0x61,
+	0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53,
+	0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e,
+	0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49, 0x4d, 0x45,
+	0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52,
+	0x49, 0x54, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x32, 0xc2, 0x03, 0x0a, 0x09, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x55, 0x72, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x11, 0x45, 0x72, 0x61, 0x73,
+	0x65, 0x55, 0x72, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x23, 0x2e,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x45, 0x72, 0x61, 0x73, 0x65, 0x55,
+	0x72, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x45,
+	0x72, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12,
+	0x20, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x63,
+	0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x72, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x72, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x72, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x3b, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_topurls_proto_rawDescOnce sync.Once
+	file_pkg_proto_topurls_proto_rawDescData = file_pkg_proto_topurls_proto_rawDesc
+)
+
+func file_pkg_proto_topurls_proto_rawDescGZIP() []byte {
+	file_pkg_proto_topurls_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_topurls_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_topurls_proto_rawDescData)
+	})
+	return file_pkg_proto_topurls_proto_rawDescData
+}
+
+var file_pkg_proto_topurls_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pkg_proto_topurls_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_pkg_proto_topurls_proto_goTypes = []interface{}{
+	(TopUrlsRank)(0),                  // 0: analytics.TopUrlsRank
+	(TopUrlsWindow)(0),                // 1: analytics.TopUrlsWindow
+	(ClickDimension)(0),               // 2: analytics.ClickDimension
+	(TimeSeriesGranularity)(0),        // 3: analytics.TimeSeriesGranularity
+	(*TopUrlsRequest)(nil),            // 4: analytics.TopUrlsRequest
+	(*Pagination)(nil),                // 5: analytics.Pagination
+	(*TopUrlData)(nil),                // 6: analytics.TopUrlData
+	(*TopUrlsResponse)(nil),           // 7: analytics.TopUrlsResponse
+	(*CampaignStatsRequest)(nil),      // 8: analytics.CampaignStatsRequest
+	(*CampaignStatsResponse)(nil),     // 9: analytics.CampaignStatsResponse
+	(*ErasedUrl)(nil),                 // 10: analytics.ErasedUrl
+	(*EraseUrlAnalyticsRequest)(nil),  // 11: analytics.EraseUrlAnalyticsRequest
+	(*ErasedRows)(nil),                // 12: analytics.ErasedRows
+	(*EraseUrlAnalyticsResponse)(nil), // 13: analytics.EraseUrlAnalyticsResponse
+	(*ClickBreakdownRequest)(nil),     // 14: analytics.ClickBreakdownRequest
+	(*ClickBreakdownRow)(nil),         // 15: analytics.ClickBreakdownRow
+	(*ClickBreakdownResponse)(nil),    // 16: analytics.ClickBreakdownResponse
+	(*UrlTimeSeriesRequest)(nil),      // 17: analytics.UrlTimeSeriesRequest
+	(*TimeSeriesPoint)(nil),           // 18: analytics.TimeSeriesPoint
+	(*UrlTimeSeriesResponse)(nil),     // 19: analytics.UrlTimeSeriesResponse
+	nil,                               // 20: analytics.ClickBreakdownRow.DimensionsEntry
+}
+var file_pkg_proto_topurls_proto_depIdxs = []int32{
+	0,  // 0: analytics.TopUrlsRequest.rankBy:type_name -> analytics.TopUrlsRank
+	1,  // 1: analytics.TopUrlsRequest.window:type_name -> analytics.TopUrlsWindow
+	6,  // 2: analytics.TopUrlsResponse.topUrlData:type_name -> analytics.TopUrlData
+	5,  // 3: analytics.TopUrlsResponse.pagination:type_name -> analytics.Pagination
+	10, // 4: analytics.EraseUrlAnalyticsRequest.urls:type_name -> analytics.ErasedUrl
+	12, // 5: analytics.EraseUrlAnalyticsResponse.erasedRows:type_name -> analytics.ErasedRows
+	2,  // 6: analytics.ClickBreakdownRequest.dimensions:type_name -> analytics.ClickDimension
+	20, // 7: analytics.ClickBreakdownRow.dimensions:type_name -> analytics.ClickBreakdownRow.DimensionsEntry
+	15, // 8: analytics.ClickBreakdownResponse.rows:type_name -> analytics.ClickBreakdownRow
+	3,  // 9: analytics.UrlTimeSeriesRequest.granularity:type_name -> analytics.TimeSeriesGranularity
+	18, // 10: analytics.UrlTimeSeriesResponse.points:type_name -> analytics.TimeSeriesPoint
+	4,  // 11: analytics.Analytics.GetTopUrls:input_type -> analytics.TopUrlsRequest
+	8,  // 12: analytics.Analytics.GetCampaignStats:input_type -> analytics.CampaignStatsRequest
+	11, // 13: analytics.Analytics.EraseUrlAnalytics:input_type -> analytics.EraseUrlAnalyticsRequest
+	14, // 14: analytics.Analytics.GetClickBreakdown:input_type -> analytics.ClickBreakdownRequest
+	17, // 15: analytics.Analytics.GetUrlTimeSeries:input_type -> analytics.UrlTimeSeriesRequest
+	7,  // 16: analytics.Analytics.GetTopUrls:output_type -> analytics.TopUrlsResponse
+	9,  // 17: analytics.Analytics.GetCampaignStats:output_type -> analytics.CampaignStatsResponse
+	13, // 18: analytics.Analytics.EraseUrlAnalytics:output_type -> analytics.EraseUrlAnalyticsResponse
+	16, // 19: analytics.Analytics.GetClickBreakdown:output_type -> analytics.ClickBreakdownResponse
+	19, // 20: analytics.Analytics.GetUrlTimeSeries:output_type -> analytics.UrlTimeSeriesResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_topurls_proto_init() }
+func file_pkg_proto_topurls_proto_init() {
+	if File_pkg_proto_topurls_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_proto_topurls_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopUrlsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TopUrlData); i {
 			case 0:
 				return &v.state
@@ -411,19 +1570,164 @@ func file_pkg_proto_topurls_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_topurls_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CampaignStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CampaignStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErasedUrl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseUrlAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErasedRows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EraseUrlAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickBreakdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickBreakdownRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClickBreakdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrlTimeSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSeriesPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_topurls_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UrlTimeSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_topurls_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_proto_topurls_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_topurls_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_topurls_proto_enumTypes,
 		MessageInfos:      file_pkg_proto_topurls_proto_msgTypes,
 	}.Build()
 	File_pkg_proto_topurls_proto = out.File
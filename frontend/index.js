@@ -47,10 +47,11 @@ async function loadTopUrl() {
     for (let i = 0; i < topUrls.length; i++) {
         let row = table.insertRow(i + 1);
 
-        let sourceUrlCell = row.insertCell(0);
-        let shortUrlCell = row.insertCell(1);
-        let followCountCell = row.insertCell(2)
-        let createCountCell = row.insertCell(3)
+        let pageCell = row.insertCell(0);
+        let sourceUrlCell = row.insertCell(1);
+        let shortUrlCell = row.insertCell(2);
+        let followCountCell = row.insertCell(3)
+        let createCountCell = row.insertCell(4)
 
         let longUrl = topUrls[i].long_url
         let shortUrl = `http://${serverDomain}/${topUrls[i].short_url}`
@@ -59,6 +60,7 @@ async function loadTopUrl() {
         longUrlElem.setAttribute('href', longUrl)
         longUrlElem.innerHTML = longUrl
 
+        appendPageMetadata(pageCell, topUrls[i].metadata)
         sourceUrlCell.appendChild(longUrlElem)
         shortUrlCell.innerHTML = shortUrl
         followCountCell.innerHTML = topUrls[i].follow_count
@@ -66,6 +68,26 @@ async function loadTopUrl() {
     }
 }
 
+// Page titles come from other sites, so they are set as text and never as html
+function appendPageMetadata(cell, metadata) {
+    if (!metadata) {
+        return
+    }
+
+    if (metadata.favicon_url) {
+        let faviconElem = document.createElement('img')
+        faviconElem.setAttribute('src', metadata.favicon_url)
+        faviconElem.setAttribute('class', 'favicon')
+        faviconElem.setAttribute('alt', '')
+        faviconElem.onerror = () => faviconElem.remove()
+        cell.appendChild(faviconElem)
+    }
+
+    let titleElem = document.createElement('span')
+    titleElem.textContent = metadata.title || metadata.final_url
+    titleElem.title = metadata.description
+    cell.appendChild(titleElem)
+}
 
 function copyToClipboard() {
     let copyText = shortUrlElem.innerHTML
@@ -11,7 +11,10 @@ import (
 	"syscall"
 
 	"CoolUrlShortener/internal/config"
+	"CoolUrlShortener/internal/repository"
 	"CoolUrlShortener/internal/repository/events"
+	"CoolUrlShortener/internal/repository/linkchecker"
+	"CoolUrlShortener/internal/repository/pagefetcher"
 	"CoolUrlShortener/internal/repository/postgresql"
 	"CoolUrlShortener/internal/repository/rediscache"
 	"CoolUrlShortener/internal/service"
@@ -19,6 +22,7 @@ import (
 	"CoolUrlShortener/internal/transport/rest"
 	url "CoolUrlShortener/pkg/proto"
 	"CoolUrlShortener/pkg/shortener"
+	"github.com/IBM/sarama"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
@@ -54,14 +58,21 @@ func Run() {
 	dbPool := createDBPool(cfg.DatabaseConfig)
 	defer dbPool.Close()
 
-	runGrpcServer(logger, cfg, dbPool, doneCh)
-	runHttpServer(logger)
+	eventsProducer := setupAsyncEventsProducer(logger, cfg, doneCh)
+	runGrpcServer(logger, cfg, dbPool, eventsProducer, doneCh)
+	runHttpServer(logger, eventsProducer)
 
 	// Graceful shutdown
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
 	<-stop
+
+	// Publish the queued events before exit
+	err = eventsProducer.Close()
+	if err != nil {
+		logger.Error(err.Error())
+	}
 }
 
 func setupLogger(env string) (*slog.Logger, error) {
@@ -112,31 +123,304 @@ func setupRedisClient(redisCfg config.RedisConfig) (*redis.Client, error) {
 	return redisClient, nil
 }
 
-func runGrpcServer(
+// eventSinkFactory builds the events producer of a sink accepted in EVENTS_SINKS
+type eventSinkFactory func(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) (repository.EventsProducer, error)
+
+var eventSinks = map[string]eventSinkFactory{
+	config.SinkKafka: func(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) (repository.EventsProducer, error) {
+		saramaCfg, err := newSaramaConfig(cfg.KafkaConfig)
+		if err != nil {
+			return nil, err
+		}
+		return events.NewKafkaEventProducer(
+			logger, cfg.KafkaConfig.Addrs, cfg.KafkaConfig.Topic, saramaCfg, newEventEncoder(cfg.EventsConfig), doneCh,
+		)
+	},
+	config.SinkNATS: func(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) (repository.EventsProducer, error) {
+		return events.NewNATSEventProducer(logger, events.NATSProducerConfig{
+			URL:            cfg.NATSConfig.URL,
+			Subject:        cfg.NATSConfig.Subject,
+			Stream:         cfg.NATSConfig.Stream,
+			PublishTimeout: cfg.NATSConfig.PublishTimeout,
+		}, newEventEncoder(cfg.EventsConfig), doneCh)
+	},
+	config.SinkFile: func(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) (repository.EventsProducer, error) {
+		return events.NewFileEventProducer(logger, cfg.EventsConfig.FilePath, doneCh)
+	},
+	config.SinkStdout: func(_ *slog.Logger, _ config.Config, _ <-chan struct{}) (repository.EventsProducer, error) {
+		return events.NewStdoutEventProducer(), nil
+	},
+}
+
+func newEventEncoder(eventsCfg config.EventsConfig) events.EventEncoder {
+	if eventsCfg.Encoding == config.EncodingProtobuf {
+		return events.EncodeEventProtobuf
+	}
+	return events.EncodeEventJSON
+}
+
+func newSaramaConfig(kafkaCfg config.KafkaConfig) (*sarama.Config, error) {
+	saramaCfg := sarama.NewConfig()
+	if kafkaCfg.ClientID != "" {
+		saramaCfg.ClientID = kafkaCfg.ClientID
+	}
+	if kafkaCfg.Version != "" {
+		version, err := sarama.ParseKafkaVersion(kafkaCfg.Version)
+		if err != nil {
+			return nil, err
+		}
+		saramaCfg.Version = version
+	}
+
+	return saramaCfg, saramaCfg.Validate()
+}
+
+// setupOutboxSinks returns a producer for every configured sink.
+// They return once the sink accepted the event, so a failure is noticed
+func setupOutboxSinks(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) []service.OutboxSink {
+	sinks := make([]service.OutboxSink, 0, len(cfg.EventsConfig.Sinks))
+	for _, sink := range cfg.EventsConfig.Sinks {
+		producer, err := eventSinks[sink](logger, cfg, doneCh)
+		if err != nil {
+			panic(err)
+		}
+		sinks = append(sinks, service.OutboxSink{Name: sink, Producer: producer})
+	}
+
+	return sinks
+}
+
+// setupAsyncEventsProducer returns the producer of follow and preview events. Kafka gets them
+// through the batched async producer, the other sinks are written to directly
+func setupAsyncEventsProducer(logger *slog.Logger, cfg config.Config, doneCh <-chan struct{}) repository.AsyncEventsProducer {
+	producers := make([]repository.EventsProducer, 0, len(cfg.EventsConfig.Sinks))
+	for _, sink := range cfg.EventsConfig.Sinks {
+		if sink == config.SinkKafka {
+			producers = append(producers, setupKafkaAsyncEventsProducer(logger, cfg, doneCh))
+			continue
+		}
+
+		producer, err := eventSinks[sink](logger, cfg, doneCh)
+		if err != nil {
+			panic(err)
+		}
+		producers = append(producers, producer)
+	}
+
+	return events.NewFanOutEventProducer(producers...)
+}
+
+func setupKafkaAsyncEventsProducer(
 	logger *slog.Logger,
 	cfg config.Config,
-	dbPool *pgxpool.Pool,
 	doneCh <-chan struct{},
-) {
+) repository.AsyncEventsProducer {
+	kafkaCfg := cfg.KafkaConfig
+	encode := newEventEncoder(cfg.EventsConfig)
+
+	var fallback repository.SpoolEventsProducer
+	if kafkaCfg.SpoolPath != "" {
+		fallback = setupEventsSpool(logger, kafkaCfg, encode, doneCh)
+	}
+
+	saramaCfg, err := newSaramaConfig(kafkaCfg)
+	if err != nil {
+		panic(err)
+	}
+
+	eventsProducer, err := events.NewKafkaAsyncEventProducer(logger, kafkaCfg.Addrs, kafkaCfg.Topic, saramaCfg, encode, events.AsyncProducerConfig{
+		QueueSize:      kafkaCfg.EventsQueueSize,
+		BlockWhenFull:  kafkaCfg.QueueFullPolicy == config.QueueFullBlock,
+		FlushFrequency: kafkaCfg.FlushFrequency,
+		FlushMessages:  kafkaCfg.FlushMessages,
+		Fallback:       fallback,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return eventsProducer
+}
+
+// setupEventsSpool returns the spool for the events kafka failed to accept.
+// It publishes them again through its own sync producer, so a failed replay is noticed
+func setupEventsSpool(
+	logger *slog.Logger,
+	kafkaCfg config.KafkaConfig,
+	encode events.EventEncoder,
+	doneCh <-chan struct{},
+) repository.SpoolEventsProducer {
+	saramaCfg, err := newSaramaConfig(kafkaCfg)
+	if err != nil {
+		panic(err)
+	}
+
+	replayProducer, err := events.NewKafkaEventProducer(logger, kafkaCfg.Addrs, kafkaCfg.Topic, saramaCfg, encode, doneCh)
+	if err != nil {
+		panic(err)
+	}
 
-	eventsServiceProducer, err := events.NewKafkaEventProducer(logger, cfg.KafkaConfig.Addrs, nil, doneCh)
+	eventsSpool, err := events.NewSpoolEventProducer(logger, replayProducer, events.SpoolConfig{
+		Path:           kafkaCfg.SpoolPath,
+		MaxBytes:       int64(kafkaCfg.SpoolMaxBytes),
+		ReplayInterval: kafkaCfg.SpoolReplayInterval,
+	})
 	if err != nil {
 		panic(err)
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go eventsSpool.Run(ctx)
+
+	return eventsSpool
+}
+
+func setupUrlShortener(shortenerCfg config.ShortenerConfig) shortener.URLShortener {
+	if shortenerCfg.Mode == config.ModeHash {
+		return shortener.NewHashUrlShortener()
+	}
+
+	switch shortenerCfg.Alphabet {
+	case config.AlphabetBase36:
+		return shortener.NewBase36UrlShortener()
+	case config.AlphabetCrockford32:
+		return shortener.NewCrockford32UrlShortener()
+	default:
+		return shortener.NewBase62UrlShortener()
+	}
+}
+
+func setupMetadataFetcher(
+	logger *slog.Logger,
+	metadataCfg config.MetadataConfig,
+	urlRepo repository.UrlRepo,
+	doneCh <-chan struct{},
+) service.MetadataFetcher {
+	pageFetcher := pagefetcher.NewHTTPPageFetcher(
+		pagefetcher.NewPublicHTTPClient(metadataCfg.FetchTimeout),
+		metadataCfg.MaxBodySize,
+	)
+	metadataFetcher := service.NewMetadataFetcher(
+		logger, urlRepo, pageFetcher, metadataCfg.Workers, metadataCfg.QueueSize, metadataCfg.FetchTimeout,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go metadataFetcher.Run(ctx)
+
+	return metadataFetcher
+}
+
+func runLinkMonitor(
+	logger *slog.Logger,
+	linkCheckCfg config.LinkCheckConfig,
+	urlRepo repository.UrlRepo,
+	doneCh <-chan struct{},
+) {
+	destinationChecker := linkchecker.NewHTTPLinkChecker(pagefetcher.NewPublicHTTPClient(linkCheckCfg.Timeout))
+	linkMonitor := service.NewLinkMonitor(
+		logger,
+		urlRepo,
+		destinationChecker,
+		linkCheckCfg.Interval,
+		linkCheckCfg.Workers,
+		linkCheckCfg.Timeout,
+		linkCheckCfg.FailureThreshold,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go linkMonitor.Run(ctx)
+}
+
+func runURLCleaner(
+	logger *slog.Logger,
+	deleteCfg config.DeleteConfig,
+	urlRepo repository.UrlRepo,
+	idempotencyRepo repository.IdempotencyRepo,
+	outboxRepo repository.OutboxRepo,
+	doneCh <-chan struct{},
+) {
+	urlCleaner := service.NewURLCleaner(
+		logger, urlRepo, idempotencyRepo, outboxRepo, deleteCfg.CleanupInterval, deleteCfg.GracePeriod,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go urlCleaner.Run(ctx)
+}
+
+func runOutboxRelay(
+	logger *slog.Logger,
+	outboxCfg config.OutboxConfig,
+	transactor repository.Transactor,
+	outboxRepo repository.OutboxRepo,
+	outboxSinks []service.OutboxSink,
+	doneCh <-chan struct{},
+) {
+	outboxRelay := service.NewOutboxRelay(
+		logger, transactor, outboxRepo, outboxSinks, outboxCfg.RelayInterval, outboxCfg.BatchSize,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-doneCh
+		cancel()
+	}()
+	go outboxRelay.Run(ctx)
+}
+
+func runGrpcServer(
+	logger *slog.Logger,
+	cfg config.Config,
+	dbPool *pgxpool.Pool,
+	eventsProducer repository.EventsProducer,
+	doneCh <-chan struct{},
+) {
+	// The relay needs to know an event reached a sink before marking it sent, so it uses sync producers
+	outboxSinks := setupOutboxSinks(logger, cfg, doneCh)
+
 	redisClient, err := setupRedisClient(cfg.RedisConfig)
 	if err != nil {
 		panic(err.Error())
 	}
 
-	base62URLShortener := shortener.NewBase62UrlShortener()
-
-	urlCache := rediscache.NewURLCacheRedis(redisClient)
-	urlRepo := postgresql.NewUrlRepoPostgres(dbPool)
-	urlService := service.NewURLService(logger, urlRepo, urlCache, eventsServiceProducer, base62URLShortener)
+	baseUrlShortener := setupUrlShortener(cfg.ShortenerConfig)
+	codeFilter := shortener.NewWordListCodeFilter(
+		cfg.ShortenerConfig.BlockedWords, cfg.ShortenerConfig.ReservedCodes, baseUrlShortener,
+	)
+	urlShortener := shortener.NewFilteredUrlShortener(baseUrlShortener, codeFilter)
+
+	urlCache := rediscache.NewURLCacheRedis(redisClient, urlShortener)
+	urlRepo := postgresql.NewUrlRepoPostgres(dbPool, urlShortener)
+	idempotencyRepo := postgresql.NewIdempotencyRepoPostgres(dbPool)
+	outboxRepo := postgresql.NewOutboxRepoPostgres(dbPool)
+	transactor := postgresql.NewTransactor(dbPool)
+	metadataFetcher := setupMetadataFetcher(logger, cfg.MetadataConfig, urlRepo, doneCh)
+	runLinkMonitor(logger, cfg.LinkCheckConfig, urlRepo, doneCh)
+	runURLCleaner(logger, cfg.DeleteConfig, urlRepo, idempotencyRepo, outboxRepo, doneCh)
+	runOutboxRelay(logger, cfg.OutboxConfig, transactor, outboxRepo, outboxSinks, doneCh)
+	urlService := service.NewURLService(
+		logger, urlRepo, urlCache, eventsProducer, urlShortener, codeFilter, metadataFetcher,
+		transactor, postgresql.NewAuditRepoPostgres(dbPool),
+		idempotencyRepo, outboxRepo, cfg.DeleteConfig.GracePeriod,
+	)
 
 	go func() {
-		s := grpc.NewServer()
+		s := grpc.NewServer(grpc.UnaryInterceptor(url_grpc.ActorInterceptor))
 		urlServer := url_grpc.NewUrlServer(
 			logger,
 			urlService,
@@ -157,12 +441,14 @@ func runGrpcServer(
 	}()
 }
 
-func runHttpServer(logger *slog.Logger) {
+func runHttpServer(logger *slog.Logger, eventsProducer repository.AsyncEventsProducer) {
 	healthCheckHandler := rest.NewHealthCheckHandler(logger)
+	eventsStatsHandler := rest.NewEventsStatsHandler(logger, eventsProducer)
 
 	go func() {
 		mux := http.NewServeMux()
 		mux.HandleFunc("GET /api/healthcheck", healthCheckHandler.HealthCheck)
+		mux.HandleFunc("GET /api/stats/events", eventsStatsHandler.EventsStats)
 
 		addr := fmt.Sprintf(":%s", httpServerPort)
 		server := http.Server{
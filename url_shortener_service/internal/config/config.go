@@ -3,7 +3,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -18,14 +21,119 @@ const (
 	redisPortKey     = "REDIS_PORT"
 	redisPasswordKey = "REDIS_PASSWORD"
 
-	kafkaAddrsKey = "KAFKA_ADDRS"
+	kafkaAddrsKey    = "KAFKA_ADDRS"
+	kafkaTopicKey    = "KAFKA_TOPIC"
+	kafkaClientIDKey = "KAFKA_CLIENT_ID"
+	kafkaVersionKey  = "KAFKA_VERSION"
+
+	natsURLKey            = "NATS_URL"
+	natsSubjectKey        = "NATS_SUBJECT"
+	natsStreamKey         = "NATS_STREAM"
+	natsPublishTimeoutKey = "NATS_PUBLISH_TIMEOUT"
+
+	eventsSinksKey    = "EVENTS_SINKS"
+	eventsFilePathKey = "EVENTS_FILE_PATH"
+	eventsEncodingKey = "EVENTS_ENCODING"
+
+	eventsQueueSizeKey      = "EVENTS_QUEUE_SIZE"
+	eventsQueueFullKey      = "EVENTS_QUEUE_FULL_POLICY"
+	eventsFlushFrequencyKey = "EVENTS_FLUSH_FREQUENCY"
+	eventsFlushMessagesKey  = "EVENTS_FLUSH_MESSAGES"
+
+	eventsSpoolPathKey           = "EVENTS_SPOOL_PATH"
+	eventsSpoolMaxBytesKey       = "EVENTS_SPOOL_MAX_BYTES"
+	eventsSpoolReplayIntervalKey = "EVENTS_SPOOL_REPLAY_INTERVAL"
+
+	blockedWordsKey  = "BLOCKED_WORDS"
+	reservedCodesKey = "RESERVED_CODES"
+
+	shortCodeAlphabetKey = "SHORT_CODE_ALPHABET"
+	shortCodeModeKey     = "SHORT_CODE_MODE"
+
+	metadataWorkersKey      = "METADATA_WORKERS"
+	metadataQueueSizeKey    = "METADATA_QUEUE_SIZE"
+	metadataFetchTimeoutKey = "METADATA_FETCH_TIMEOUT"
+	metadataMaxBodySizeKey  = "METADATA_MAX_BODY_SIZE"
+
+	linkCheckIntervalKey         = "LINK_CHECK_INTERVAL"
+	linkCheckWorkersKey          = "LINK_CHECK_WORKERS"
+	linkCheckTimeoutKey          = "LINK_CHECK_TIMEOUT"
+	linkCheckFailureThresholdKey = "LINK_CHECK_FAILURE_THRESHOLD"
+
+	deleteGracePeriodKey  = "DELETE_GRACE_PERIOD"
+	urlCleanupIntervalKey = "URL_CLEANUP_INTERVAL"
+
+	outboxRelayIntervalKey = "OUTBOX_RELAY_INTERVAL"
+	outboxBatchSizeKey     = "OUTBOX_BATCH_SIZE"
+)
+
+const (
+	defaultKafkaTopic = "events"
+
+	defaultNATSSubject        = "events"
+	defaultNATSStream         = "EVENTS"
+	defaultNATSPublishTimeout = 5 * time.Second
+
+	defaultEventsQueueSize      = 10000
+	defaultEventsFlushFrequency = 100 * time.Millisecond
+	defaultEventsFlushMessages  = 100
+
+	defaultEventsSpoolMaxBytes       = 64 << 20
+	defaultEventsSpoolReplayInterval = 5 * time.Second
+
+	defaultMetadataWorkers      = 4
+	defaultMetadataQueueSize    = 1000
+	defaultMetadataFetchTimeout = 5 * time.Second
+	defaultMetadataMaxBodySize  = 1 << 20
+
+	defaultLinkCheckInterval         = time.Hour
+	defaultLinkCheckWorkers          = 4
+	defaultLinkCheckTimeout          = 10 * time.Second
+	defaultLinkCheckFailureThreshold = 3
+
+	defaultDeleteGracePeriod  = 30 * 24 * time.Hour
+	defaultURLCleanupInterval = time.Hour
+
+	defaultOutboxRelayInterval = time.Second
+	defaultOutboxBatchSize     = 100
 )
 
+const (
+	AlphabetBase62      = "base62"
+	AlphabetBase36      = "base36"
+	AlphabetCrockford32 = "crockford32"
+
+	ModeRandom = "random"
+	ModeHash   = "hash"
+
+	QueueFullDrop  = "drop"
+	QueueFullBlock = "block"
+
+	SinkKafka  = "kafka"
+	SinkNATS   = "nats"
+	SinkFile   = "file"
+	SinkStdout = "stdout"
+
+	EncodingJSON     = "json"
+	EncodingProtobuf = "protobuf"
+)
+
+// defaultReservedCodes are paths the api gateway serves itself,
+// so they can never be used as short urls
+var defaultReservedCodes = []string{"api", "docs", "healthcheck", "favicon.ico"}
+
 type Config struct {
-	Env            string
-	DatabaseConfig DatabaseConfig
-	RedisConfig    RedisConfig
-	KafkaConfig    KafkaConfig
+	Env             string
+	DatabaseConfig  DatabaseConfig
+	RedisConfig     RedisConfig
+	EventsConfig    EventsConfig
+	KafkaConfig     KafkaConfig
+	NATSConfig      NATSConfig
+	ShortenerConfig ShortenerConfig
+	MetadataConfig  MetadataConfig
+	LinkCheckConfig LinkCheckConfig
+	DeleteConfig    DeleteConfig
+	OutboxConfig    OutboxConfig
 }
 
 type DatabaseConfig struct {
@@ -42,8 +150,86 @@ type RedisConfig struct {
 	Password string
 }
 
+// EventsConfig selects where events are written. Every event goes to all Sinks,
+// FilePath is the newline-delimited json file of the file sink
+type EventsConfig struct {
+	Sinks    []string
+	FilePath string
+	// Encoding is json or protobuf and applies to kafka and nats. ClickHouse reads
+	// the events topic as JSONEachRow, so it stays json until the analytics side moves
+	Encoding string
+}
+
 type KafkaConfig struct {
 	Addrs []string
+	Topic string
+	// ClientID and Version are passed to the kafka client, Version is the sarama default when empty
+	ClientID string
+	Version  string
+	// EventsQueueSize bounds the in-memory queue of follow and preview events.
+	// QueueFullPolicy is drop or block and decides what happens to an event when it is full
+	EventsQueueSize int
+	QueueFullPolicy string
+	// Queued events are sent in a batch every FlushFrequency or once FlushMessages are buffered
+	FlushFrequency time.Duration
+	FlushMessages  int
+	// SpoolPath is the file keeping the events kafka failed to accept until they are replayed.
+	// Spooling is off when it is empty
+	SpoolPath           string
+	SpoolMaxBytes       int
+	SpoolReplayInterval time.Duration
+}
+
+// NATSConfig is used by the nats sink. Events are published to Subject,
+// Stream is created for it when it does not exist yet
+type NATSConfig struct {
+	URL            string
+	Subject        string
+	Stream         string
+	PublishTimeout time.Duration
+}
+
+type ShortenerConfig struct {
+	BlockedWords  []string
+	ReservedCodes []string
+	// Alphabet selects how short codes are generated. base36 and crockford32
+	// are resolved ignoring case. Switching away from base62 on a deployment
+	// with existing mixed case codes makes those codes unreachable.
+	Alphabet string
+	// Mode selects whether codes are derived from a random id or from the long url hash
+	Mode string
+}
+
+// MetadataConfig limits the background fetching of destination page metadata
+type MetadataConfig struct {
+	Workers      int
+	QueueSize    int
+	FetchTimeout time.Duration
+	// MaxBodySize is how many bytes of a page are read looking for its head
+	MaxBodySize int64
+}
+
+// LinkCheckConfig controls the periodic destination checks. A link is flagged
+// after FailureThreshold failed checks in a row
+type LinkCheckConfig struct {
+	Interval         time.Duration
+	Workers          int
+	Timeout          time.Duration
+	FailureThreshold int
+}
+
+// DeleteConfig controls soft deletes. A deleted link can be restored during GracePeriod,
+// the cleanup job runs once per CleanupInterval and purges the expired ones
+type DeleteConfig struct {
+	GracePeriod     time.Duration
+	CleanupInterval time.Duration
+}
+
+// OutboxConfig controls the relay publishing create events from the outbox to the event sinks.
+// RelayInterval is the delay between polls, it doubles while a sink is unavailable
+type OutboxConfig struct {
+	RelayInterval time.Duration
+	BatchSize     int
 }
 
 func ParseConfig() (Config, error) {
@@ -93,11 +279,80 @@ func ParseConfig() (Config, error) {
 		return Config{}, fmt.Errorf("you did not provide env: %s", redisPasswordKey)
 	}
 
-	kafkaAddrsRaw := os.Getenv(kafkaAddrsKey)
-	if kafkaAddrsRaw == "" {
-		return Config{}, fmt.Errorf("you did not provide env: %s", kafkaAddrsKey)
+	eventsConfig, err := parseEventsConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	var kafkaConfig KafkaConfig
+	if slices.Contains(eventsConfig.Sinks, SinkKafka) {
+		kafkaConfig, err = parseKafkaConfig()
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	var natsConfig NATSConfig
+	if slices.Contains(eventsConfig.Sinks, SinkNATS) {
+		natsConfig, err = parseNATSConfig()
+		if err != nil {
+			return Config{}, err
+		}
+	}
+
+	var blockedWords []string
+	blockedWordsRaw := os.Getenv(blockedWordsKey)
+	if blockedWordsRaw != "" {
+		blockedWords = strings.Split(blockedWordsRaw, ",")
+	}
+
+	reservedCodes := defaultReservedCodes
+	reservedCodesRaw := os.Getenv(reservedCodesKey)
+	if reservedCodesRaw != "" {
+		reservedCodes = append(reservedCodes, strings.Split(reservedCodesRaw, ",")...)
+	}
+
+	alphabet := os.Getenv(shortCodeAlphabetKey)
+	switch alphabet {
+	case "":
+		alphabet = AlphabetBase62
+	case AlphabetBase62, AlphabetBase36, AlphabetCrockford32:
+	default:
+		return Config{}, fmt.Errorf("incorrect %s: %s", shortCodeAlphabetKey, alphabet)
+	}
+
+	mode := os.Getenv(shortCodeModeKey)
+	switch mode {
+	case "":
+		mode = ModeRandom
+	case ModeRandom:
+	case ModeHash:
+		if alphabet != AlphabetBase62 {
+			return Config{}, fmt.Errorf("%s %s supports only %s alphabet", shortCodeModeKey, ModeHash, AlphabetBase62)
+		}
+	default:
+		return Config{}, fmt.Errorf("incorrect %s: %s", shortCodeModeKey, mode)
+	}
+
+	metadataConfig, err := parseMetadataConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	deleteConfig, err := parseDeleteConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	outboxConfig, err := parseOutboxConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	linkCheckConfig, err := parseLinkCheckConfig()
+	if err != nil {
+		return Config{}, err
 	}
-	kafkaAddrs := strings.Split(kafkaAddrsRaw, ",")
 
 	return Config{
 		Env: env,
@@ -113,8 +368,266 @@ func ParseConfig() (Config, error) {
 			Port:     redisPort,
 			Password: redisPassword,
 		},
-		KafkaConfig: KafkaConfig{
-			Addrs: kafkaAddrs,
+		EventsConfig: eventsConfig,
+		KafkaConfig:  kafkaConfig,
+		NATSConfig:   natsConfig,
+		ShortenerConfig: ShortenerConfig{
+			BlockedWords:  blockedWords,
+			ReservedCodes: reservedCodes,
+			Alphabet:      alphabet,
+			Mode:          mode,
 		},
+		MetadataConfig:  metadataConfig,
+		LinkCheckConfig: linkCheckConfig,
+		DeleteConfig:    deleteConfig,
+		OutboxConfig:    outboxConfig,
 	}, nil
 }
+
+func parseEventsConfig() (EventsConfig, error) {
+	sinks := []string{SinkKafka}
+	sinksRaw := os.Getenv(eventsSinksKey)
+	if sinksRaw != "" {
+		sinks = strings.Split(sinksRaw, ",")
+	}
+
+	for i, sink := range sinks {
+		switch sink {
+		case SinkKafka, SinkNATS, SinkFile, SinkStdout:
+		default:
+			return EventsConfig{}, fmt.Errorf("incorrect %s: %s", eventsSinksKey, sinksRaw)
+		}
+		if slices.Contains(sinks[:i], sink) {
+			return EventsConfig{}, fmt.Errorf("incorrect %s: %s", eventsSinksKey, sinksRaw)
+		}
+	}
+
+	filePath := os.Getenv(eventsFilePathKey)
+	if filePath == "" && slices.Contains(sinks, SinkFile) {
+		return EventsConfig{}, fmt.Errorf("you did not provide env: %s", eventsFilePathKey)
+	}
+
+	encoding := os.Getenv(eventsEncodingKey)
+	switch encoding {
+	case "":
+		encoding = EncodingJSON
+	case EncodingJSON, EncodingProtobuf:
+	default:
+		return EventsConfig{}, fmt.Errorf("incorrect %s: %s", eventsEncodingKey, encoding)
+	}
+
+	return EventsConfig{
+		Sinks:    sinks,
+		FilePath: filePath,
+		Encoding: encoding,
+	}, nil
+}
+
+func parseKafkaConfig() (KafkaConfig, error) {
+	kafkaAddrsRaw := os.Getenv(kafkaAddrsKey)
+	if kafkaAddrsRaw == "" {
+		return KafkaConfig{}, fmt.Errorf("you did not provide env: %s", kafkaAddrsKey)
+	}
+
+	queueSize, err := parsePositiveInt(eventsQueueSizeKey, defaultEventsQueueSize)
+	if err != nil {
+		return KafkaConfig{}, err
+	}
+
+	queueFullPolicy := os.Getenv(eventsQueueFullKey)
+	switch queueFullPolicy {
+	case "":
+		queueFullPolicy = QueueFullDrop
+	case QueueFullDrop, QueueFullBlock:
+	default:
+		return KafkaConfig{}, fmt.Errorf("incorrect %s: %s", eventsQueueFullKey, queueFullPolicy)
+	}
+
+	flushFrequency, err := parsePositiveDuration(eventsFlushFrequencyKey, defaultEventsFlushFrequency)
+	if err != nil {
+		return KafkaConfig{}, err
+	}
+
+	flushMessages, err := parsePositiveInt(eventsFlushMessagesKey, defaultEventsFlushMessages)
+	if err != nil {
+		return KafkaConfig{}, err
+	}
+
+	spoolMaxBytes, err := parsePositiveInt(eventsSpoolMaxBytesKey, defaultEventsSpoolMaxBytes)
+	if err != nil {
+		return KafkaConfig{}, err
+	}
+
+	spoolReplayInterval, err := parsePositiveDuration(eventsSpoolReplayIntervalKey, defaultEventsSpoolReplayInterval)
+	if err != nil {
+		return KafkaConfig{}, err
+	}
+
+	topic := os.Getenv(kafkaTopicKey)
+	if topic == "" {
+		topic = defaultKafkaTopic
+	}
+
+	return KafkaConfig{
+		Addrs:               strings.Split(kafkaAddrsRaw, ","),
+		Topic:               topic,
+		ClientID:            os.Getenv(kafkaClientIDKey),
+		Version:             os.Getenv(kafkaVersionKey),
+		EventsQueueSize:     queueSize,
+		QueueFullPolicy:     queueFullPolicy,
+		FlushFrequency:      flushFrequency,
+		FlushMessages:       flushMessages,
+		SpoolPath:           os.Getenv(eventsSpoolPathKey),
+		SpoolMaxBytes:       spoolMaxBytes,
+		SpoolReplayInterval: spoolReplayInterval,
+	}, nil
+}
+
+func parseNATSConfig() (NATSConfig, error) {
+	natsURL := os.Getenv(natsURLKey)
+	if natsURL == "" {
+		return NATSConfig{}, fmt.Errorf("you did not provide env: %s", natsURLKey)
+	}
+
+	subject := os.Getenv(natsSubjectKey)
+	if subject == "" {
+		subject = defaultNATSSubject
+	}
+
+	stream := os.Getenv(natsStreamKey)
+	if stream == "" {
+		stream = defaultNATSStream
+	}
+
+	publishTimeout, err := parsePositiveDuration(natsPublishTimeoutKey, defaultNATSPublishTimeout)
+	if err != nil {
+		return NATSConfig{}, err
+	}
+
+	return NATSConfig{
+		URL:            natsURL,
+		Subject:        subject,
+		Stream:         stream,
+		PublishTimeout: publishTimeout,
+	}, nil
+}
+
+func parseMetadataConfig() (MetadataConfig, error) {
+	workers, err := parsePositiveInt(metadataWorkersKey, defaultMetadataWorkers)
+	if err != nil {
+		return MetadataConfig{}, err
+	}
+
+	queueSize, err := parsePositiveInt(metadataQueueSizeKey, defaultMetadataQueueSize)
+	if err != nil {
+		return MetadataConfig{}, err
+	}
+
+	fetchTimeout, err := parsePositiveDuration(metadataFetchTimeoutKey, defaultMetadataFetchTimeout)
+	if err != nil {
+		return MetadataConfig{}, err
+	}
+
+	maxBodySize, err := parsePositiveInt(metadataMaxBodySizeKey, defaultMetadataMaxBodySize)
+	if err != nil {
+		return MetadataConfig{}, err
+	}
+
+	return MetadataConfig{
+		Workers:      workers,
+		QueueSize:    queueSize,
+		FetchTimeout: fetchTimeout,
+		MaxBodySize:  int64(maxBodySize),
+	}, nil
+}
+
+func parseLinkCheckConfig() (LinkCheckConfig, error) {
+	interval, err := parsePositiveDuration(linkCheckIntervalKey, defaultLinkCheckInterval)
+	if err != nil {
+		return LinkCheckConfig{}, err
+	}
+
+	workers, err := parsePositiveInt(linkCheckWorkersKey, defaultLinkCheckWorkers)
+	if err != nil {
+		return LinkCheckConfig{}, err
+	}
+
+	timeout, err := parsePositiveDuration(linkCheckTimeoutKey, defaultLinkCheckTimeout)
+	if err != nil {
+		return LinkCheckConfig{}, err
+	}
+
+	failureThreshold, err := parsePositiveInt(linkCheckFailureThresholdKey, defaultLinkCheckFailureThreshold)
+	if err != nil {
+		return LinkCheckConfig{}, err
+	}
+
+	return LinkCheckConfig{
+		Interval:         interval,
+		Workers:          workers,
+		Timeout:          timeout,
+		FailureThreshold: failureThreshold,
+	}, nil
+}
+
+func parseDeleteConfig() (DeleteConfig, error) {
+	gracePeriod, err := parsePositiveDuration(deleteGracePeriodKey, defaultDeleteGracePeriod)
+	if err != nil {
+		return DeleteConfig{}, err
+	}
+
+	cleanupInterval, err := parsePositiveDuration(urlCleanupIntervalKey, defaultURLCleanupInterval)
+	if err != nil {
+		return DeleteConfig{}, err
+	}
+
+	return DeleteConfig{
+		GracePeriod:     gracePeriod,
+		CleanupInterval: cleanupInterval,
+	}, nil
+}
+
+func parseOutboxConfig() (OutboxConfig, error) {
+	relayInterval, err := parsePositiveDuration(outboxRelayIntervalKey, defaultOutboxRelayInterval)
+	if err != nil {
+		return OutboxConfig{}, err
+	}
+
+	batchSize, err := parsePositiveInt(outboxBatchSizeKey, defaultOutboxBatchSize)
+	if err != nil {
+		return OutboxConfig{}, err
+	}
+
+	return OutboxConfig{
+		RelayInterval: relayInterval,
+		BatchSize:     batchSize,
+	}, nil
+}
+
+func parsePositiveInt(key string, defaultValue int) (int, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 1 {
+		return 0, fmt.Errorf("incorrect %s: %s", key, raw)
+	}
+
+	return value, nil
+}
+
+func parsePositiveDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return defaultValue, nil
+	}
+
+	value, err := time.ParseDuration(raw)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("incorrect %s: %s", key, raw)
+	}
+
+	return value, nil
+}
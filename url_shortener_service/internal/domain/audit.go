@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionUpdate  AuditAction = "update"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRestore AuditAction = "restore"
+)
+
+// Actor is whoever made a change. Name is empty for anonymous requests
+type Actor struct {
+	Name string
+	IP   string
+}
+
+// AuditRecord keeps the changed fields of a link before and after the change,
+// OldValue is nil for created and restored links, NewValue is nil for deleted ones
+type AuditRecord struct {
+	ID        int64
+	URLID     int64
+	Domain    string
+	ShortURL  string
+	Action    AuditAction
+	Actor     Actor
+	OldValue  map[string]any
+	NewValue  map[string]any
+	CreatedAt time.Time
+}
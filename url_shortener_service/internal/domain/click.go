@@ -0,0 +1,17 @@
+package domain
+
+// ClickContext describes the request behind a follow or preview event.
+// The gateway anonymizes IP, classifies the user agent and scores the click before it gets here.
+// Bot is the verdict of the click fraud rules, BotScore is the score it was based on
+type ClickContext struct {
+	Referrer       string
+	UserAgent      string
+	IP             string
+	AcceptLanguage string
+	Host           string
+	DeviceType     string
+	OSFamily       string
+	BrowserFamily  string
+	Bot            bool
+	BotScore       int
+}
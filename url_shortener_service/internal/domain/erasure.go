@@ -0,0 +1,24 @@
+package domain
+
+// ErasureRequest names the data to erase, either a single link or every link created by Owner.
+// Owner is an actor name from the audit log
+type ErasureRequest struct {
+	Domain   string
+	ShortURL string
+	Owner    string
+}
+
+type ErasedURL struct {
+	ID       int64
+	Domain   string
+	ShortURL string
+}
+
+// ErasureReport tells what an erasure removed. URLs lists every erased link,
+// including the ones purged before, LinksDeleted counts only the links still stored
+type ErasureReport struct {
+	URLs                 []ErasedURL
+	LinksDeleted         int
+	AuditRecordsRedacted int
+	OutboxEventsDeleted  int
+}
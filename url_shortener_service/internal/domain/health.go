@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// URLHealth is the state of the periodic destination checks, CheckedAt is zero until the first check
+type URLHealth struct {
+	StatusCode          int
+	Latency             time.Duration
+	CheckedAt           time.Time
+	LastSuccessAt       time.Time
+	ConsecutiveFailures int
+	Flagged             bool
+}
+
+// Destination is a long url visited by the health checker
+type Destination struct {
+	URLID int64
+	URL   string
+}
+
+// CheckResult.StatusCode is zero when the destination could not be reached at all
+type CheckResult struct {
+	StatusCode int
+	Latency    time.Duration
+	CheckedAt  time.Time
+}
+
+func (r CheckResult) OK() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 400
+}
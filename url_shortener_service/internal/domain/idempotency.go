@@ -0,0 +1,9 @@
+package domain
+
+// IdempotencyRecord is the stored outcome of a request made with an idempotency key.
+// Fingerprint identifies the request, so a key reused for another request is rejected
+type IdempotencyRecord struct {
+	Key         string
+	Fingerprint string
+	Response    string
+}
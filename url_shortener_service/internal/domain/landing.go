@@ -0,0 +1,11 @@
+package domain
+
+type LandingPage struct {
+	Title string
+	Items []LandingItem
+}
+
+type LandingItem struct {
+	Title string
+	URL   string
+}
@@ -2,9 +2,100 @@ package domain
 
 import "time"
 
+// URLData.Domain is empty for links on the default domain.
+// Landing pages have no long url and are rendered instead of redirecting.
+// Flagged is set by the health checker when the long url keeps failing.
+// DeletedAt is zero for live links
 type URLData struct {
 	ID        int64
+	Domain    string
 	ShortUrl  string
 	LongUrl   string
+	BackupURL string
+	Flagged   bool
 	CreatedAt time.Time
+	DeletedAt time.Time
+	Labels    URLLabels
+	Landing   *LandingPage
+	Preview   *URLPreview
+	Metadata  *URLMetadata
+}
+
+// URLKey names a link, a short url is unique within its domain only
+type URLKey struct {
+	Domain   string
+	ShortURL string
+}
+
+// URLLabels group links for filtering and campaign reporting
+type URLLabels struct {
+	Tags       []string
+	CampaignID string
+}
+
+func (l URLLabels) IsEmpty() bool {
+	return len(l.Tags) == 0 && l.CampaignID == ""
+}
+
+type SaveURLRequest struct {
+	Domain    string
+	LongURL   string
+	BackupURL string
+	Alias     string
+	Labels    URLLabels
+	Landing   *LandingPage
+	// IdempotencyKey makes retries of the same request return the first response
+	IdempotencyKey string
+}
+
+type SortOrder string
+
+const (
+	SortNewestFirst SortOrder = "desc"
+	SortOldestFirst SortOrder = "asc"
+)
+
+// URLCursor points at the last link of a page, the next page starts right after it
+type URLCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// ListURLsParams filters are optional. DestinationHost also matches subdomains,
+// Search is a case-insensitive substring of the long url, the created dates are inclusive.
+// Page is ignored when Cursor is set
+type ListURLsParams struct {
+	Domain          string
+	Tag             string
+	CampaignID      string
+	DestinationHost string
+	Search          string
+	CreatedFrom     *time.Time
+	CreatedTo       *time.Time
+	Order           SortOrder
+	Cursor          *URLCursor
+	Page            int
+	Limit           int
+}
+
+// URLList.NextCursor is nil on the last page
+type URLList struct {
+	URLs       []URLData
+	NextCursor *URLCursor
+}
+
+// URLPreview is the Open Graph metadata shown to social crawlers
+type URLPreview struct {
+	Title       string
+	Description string
+	ImageURL    string
+}
+
+// URLMetadata describes the destination page, it is fetched in the background after a link is created
+type URLMetadata struct {
+	Title       string
+	Description string
+	FaviconURL  string
+	FinalURL    string
+	FetchedAt   time.Time
 }
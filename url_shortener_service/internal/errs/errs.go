@@ -2,4 +2,16 @@ package errs
 
 import "errors"
 
-var ErrNoURL = errors.New("url not found")
+var (
+	ErrNoURL          = errors.New("url not found")
+	ErrReservedCode   = errors.New("short url is reserved")
+	ErrAliasTaken     = errors.New("short url is already taken")
+	ErrInvalidLanding = errors.New("landing page needs a title and items with a title and an http or https url")
+	ErrInvalidPreview = errors.New("preview image must be an http or https url")
+	ErrNoMetadata     = errors.New("url metadata not fetched yet")
+	ErrInvalidBackup  = errors.New("backup url must be an http or https url")
+	ErrInvalidSort    = errors.New("sort order must be asc or desc")
+	ErrInvalidCursor  = errors.New("invalid page cursor")
+	ErrCodeRetired    = errors.New("short url was retired")
+	ErrKeyReused      = errors.New("idempotency key was used with another request")
+)
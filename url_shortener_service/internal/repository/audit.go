@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name AuditRepo
+type AuditRepo interface {
+	SaveAuditRecord(ctx context.Context, record domain.AuditRecord) error
+	ListAuditRecords(ctx context.Context, urlID int64) ([]domain.AuditRecord, error)
+	ListURLsCreatedBy(ctx context.Context, actor string) ([]domain.ErasedURL, error)
+	// RedactAuditRecords blanks the actor of the records of urlIDs and of every record made by actor.
+	// The changed values of urlIDs are dropped too
+	RedactAuditRecords(ctx context.Context, urlIDs []int64, actor string) (int, error)
+}
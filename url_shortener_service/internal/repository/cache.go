@@ -1,9 +1,14 @@
 package repository
 
-import "context"
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name URLCache
 type URLCache interface {
-	SetLongURL(ctx context.Context, shortURL string, longURL string) error
-	GetLongURL(ctx context.Context, shortURL string) (string, error)
+	SetURLData(ctx context.Context, urlData domain.URLData) error
+	GetURLData(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error)
+	DeleteURLData(ctx context.Context, urlDomain string, shortURL string) error
 }
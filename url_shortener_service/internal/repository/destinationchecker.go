@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name DestinationChecker
+type DestinationChecker interface {
+	CheckDestination(ctx context.Context, destinationURL string) (domain.CheckResult, error)
+}
@@ -1,10 +1,35 @@
 package repository
 
 import (
+	"context"
+
 	"CoolUrlShortener/internal/repository/models"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name EventsProducer
 type EventsProducer interface {
-	ProduceEvent(event models.URLEvent)
+	ProduceEvent(event models.URLEvent) error
+}
+
+// AsyncEventsProducer publishes events in the background. ProduceEvent only queues
+// the event, so a nil error does not mean it reached kafka
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name AsyncEventsProducer
+type AsyncEventsProducer interface {
+	EventsProducer
+	Stats() models.ProducerStats
+	// Close stops accepting events and waits until the queued ones are published
+	Close() error
+}
+
+// SpoolEventsProducer keeps the events it failed to publish on disk, Run publishes them again
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name SpoolEventsProducer
+type SpoolEventsProducer interface {
+	EventsProducer
+	// SpoolEvent appends the event to the spool without trying to publish it
+	SpoolEvent(event models.URLEvent) error
+	// Pending reports whether spooled events wait to be published, newer events go after them
+	Pending() bool
+	Run(ctx context.Context)
 }
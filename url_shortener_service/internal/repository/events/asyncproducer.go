@@ -0,0 +1,207 @@
+package events
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/IBM/sarama"
+)
+
+var errProducerClosed = errors.New("events producer is closed")
+
+type AsyncProducerConfig struct {
+	QueueSize int
+	// BlockWhenFull makes ProduceEvent wait for a free slot instead of dropping the event
+	BlockWhenFull  bool
+	FlushFrequency time.Duration
+	FlushMessages  int
+	// Fallback gets the events kafka failed to accept after retries, they are lost without it.
+	// While it holds events the new ones are spooled after them, so the order per short url is kept
+	Fallback repository.SpoolEventsProducer
+}
+
+type asyncEventProducer struct {
+	logger        *slog.Logger
+	producer      sarama.AsyncProducer
+	topic         string
+	encode        EventEncoder
+	queue         chan *sarama.ProducerMessage
+	blockWhenFull bool
+	fallback      repository.SpoolEventsProducer
+
+	// mu guards closed, so no event is queued after the queue is closed
+	mu     sync.RWMutex
+	closed bool
+	// closing is closed before mu is taken by Close, so ProduceEvent waiting for a free slot gives up
+	closing     chan struct{}
+	closingOnce sync.Once
+	done        chan struct{}
+
+	sent    atomic.Int64
+	dropped atomic.Int64
+	failed  atomic.Int64
+	spooled atomic.Int64
+}
+
+// NewKafkaAsyncEventProducer returns a producer that queues events in memory and publishes
+// them in compressed batches in the background, so callers do not wait for kafka
+func NewKafkaAsyncEventProducer(
+	logger *slog.Logger,
+	addrs []string,
+	topic string,
+	baseCfg *sarama.Config,
+	encode EventEncoder,
+	cfg AsyncProducerConfig,
+) (repository.AsyncEventsProducer, error) {
+	kafkaCfg := *baseCfg
+	kafkaCfg.Producer.Return.Successes = true
+	kafkaCfg.Producer.Compression = sarama.CompressionSnappy
+	kafkaCfg.Producer.Flush.Frequency = cfg.FlushFrequency
+	kafkaCfg.Producer.Flush.Messages = cfg.FlushMessages
+
+	producer, err := sarama.NewAsyncProducer(addrs, &kafkaCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAsyncEventProducer(logger, producer, topic, encode, cfg), nil
+}
+
+func newAsyncEventProducer(
+	logger *slog.Logger,
+	producer sarama.AsyncProducer,
+	topic string,
+	encode EventEncoder,
+	cfg AsyncProducerConfig,
+) *asyncEventProducer {
+	p := &asyncEventProducer{
+		logger:        logger,
+		producer:      producer,
+		topic:         topic,
+		encode:        encode,
+		queue:         make(chan *sarama.ProducerMessage, cfg.QueueSize),
+		blockWhenFull: cfg.BlockWhenFull,
+		fallback:      cfg.Fallback,
+		closing:       make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range producer.Successes() {
+			p.sent.Add(1)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for err := range producer.Errors() {
+			p.handleFailed(err)
+		}
+	}()
+	go func() {
+		for msg := range p.queue {
+			producer.Input() <- msg
+		}
+		// AsyncClose flushes the buffered messages, then closes Successes and Errors
+		producer.AsyncClose()
+		wg.Wait()
+		close(p.done)
+	}()
+
+	return p
+}
+
+// ProduceEvent queues the event. When the queue is full the event is dropped
+// and counted, unless the producer is configured to block until a slot frees up or it is closed
+func (p *asyncEventProducer) ProduceEvent(event models.URLEvent) error {
+	bytes, err := p.encode(event)
+	if err != nil {
+		return err
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic:    p.topic,
+		Key:      sarama.StringEncoder(event.ShortURL),
+		Value:    sarama.ByteEncoder(bytes),
+		Metadata: event,
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return errProducerClosed
+	}
+
+	if p.fallback != nil && p.fallback.Pending() {
+		err = p.fallback.SpoolEvent(event)
+		if err != nil {
+			return err
+		}
+		p.spooled.Add(1)
+		return nil
+	}
+
+	if p.blockWhenFull {
+		select {
+		case p.queue <- msg:
+			return nil
+		case <-p.closing:
+			return errProducerClosed
+		}
+	}
+
+	select {
+	case p.queue <- msg:
+	default:
+		p.dropped.Add(1)
+	}
+
+	return nil
+}
+
+func (p *asyncEventProducer) handleFailed(producerErr *sarama.ProducerError) {
+	if p.fallback != nil {
+		event, ok := producerErr.Msg.Metadata.(models.URLEvent)
+		if ok && p.fallback.SpoolEvent(event) == nil {
+			p.spooled.Add(1)
+			return
+		}
+	}
+
+	p.failed.Add(1)
+	p.logger.Error(producerErr.Error())
+}
+
+func (p *asyncEventProducer) Stats() models.ProducerStats {
+	return models.ProducerStats{
+		QueueDepth: len(p.queue),
+		QueueSize:  cap(p.queue),
+		Sent:       p.sent.Load(),
+		Dropped:    p.dropped.Load(),
+		Failed:     p.failed.Load(),
+		Spooled:    p.spooled.Load(),
+	}
+}
+
+func (p *asyncEventProducer) Close() error {
+	p.closingOnce.Do(func() {
+		close(p.closing)
+	})
+
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	<-p.done
+	return nil
+}
@@ -0,0 +1,237 @@
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/IBM/sarama"
+	saramamocks "github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+// stuckAsyncProducer accepts nothing until its input is read by the test, like kafka being slow
+type stuckAsyncProducer struct {
+	sarama.AsyncProducer
+	input     chan *sarama.ProducerMessage
+	successes chan *sarama.ProducerMessage
+	errors    chan *sarama.ProducerError
+}
+
+func newStuckAsyncProducer() *stuckAsyncProducer {
+	return &stuckAsyncProducer{
+		input:     make(chan *sarama.ProducerMessage),
+		successes: make(chan *sarama.ProducerMessage),
+		errors:    make(chan *sarama.ProducerError),
+	}
+}
+
+func (p *stuckAsyncProducer) Input() chan<- *sarama.ProducerMessage {
+	return p.input
+}
+
+func (p *stuckAsyncProducer) Successes() <-chan *sarama.ProducerMessage {
+	return p.successes
+}
+
+func (p *stuckAsyncProducer) Errors() <-chan *sarama.ProducerError {
+	return p.errors
+}
+
+func (p *stuckAsyncProducer) AsyncClose() {
+	close(p.successes)
+	close(p.errors)
+}
+
+func checkEventTime(eventTime int64) saramamocks.MessageChecker {
+	return func(msg *sarama.ProducerMessage) error {
+		value, err := msg.Value.Encode()
+		if err != nil {
+			return err
+		}
+
+		var event models.URLEvent
+		err = json.Unmarshal(value, &event)
+		if err != nil {
+			return err
+		}
+		if event.EventTime != eventTime {
+			return fmt.Errorf("got event time %d, expected %d", event.EventTime, eventTime)
+		}
+
+		return nil
+	}
+}
+
+func newTestSaramaConfig() *sarama.Config {
+	kafkaCfg := sarama.NewConfig()
+	kafkaCfg.Producer.Return.Successes = true
+
+	return kafkaCfg
+}
+
+func TestAsyncEventProducer(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testEvent := models.URLEvent{ShortURL: "short", EventType: models.EventTypeFollow}
+
+	t.Run("Queued events are published before close returns", func(t *testing.T) {
+		mockProducer := saramamocks.NewAsyncProducer(t, newTestSaramaConfig())
+		mockProducer.ExpectInputAndSucceed()
+		mockProducer.ExpectInputAndSucceed()
+		mockProducer.ExpectInputAndFail(errors.New("kafka error"))
+
+		producer := newAsyncEventProducer(logger, mockProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 10})
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, producer.ProduceEvent(testEvent))
+		}
+		assert.NoError(t, producer.Close())
+
+		assert.Equal(t, models.ProducerStats{QueueDepth: 0, QueueSize: 10, Sent: 2, Failed: 1}, producer.Stats())
+		assert.Equal(t, errProducerClosed, producer.ProduceEvent(testEvent))
+	})
+
+	t.Run("Kafka rejects an event. It is handed to the fallback", func(t *testing.T) {
+		mockProducer := saramamocks.NewAsyncProducer(t, newTestSaramaConfig())
+		mockProducer.ExpectInputAndFail(errors.New("kafka error"))
+		replayProducer := &fakeEventsProducer{}
+		fallback := newTestSpool(t, replayProducer, filepath.Join(t.TempDir(), "events.spool"), 1<<20)
+
+		producer := newAsyncEventProducer(logger, mockProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 10, Fallback: fallback})
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.NoError(t, producer.Close())
+
+		assert.Equal(t, models.ProducerStats{QueueSize: 10, Spooled: 1}, producer.Stats())
+		assert.Empty(t, replayProducer.published)
+		assert.NoError(t, fallback.replay())
+		assert.Equal(t, []models.URLEvent{testEvent}, replayProducer.published)
+	})
+
+	t.Run("Kafka goes down between live events. Newer events wait behind the spooled ones", func(t *testing.T) {
+		mockProducer := saramamocks.NewAsyncProducer(t, newTestSaramaConfig())
+		mockProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(checkEventTime(1))
+		mockProducer.ExpectInputWithMessageCheckerFunctionAndFail(checkEventTime(2), errors.New("kafka error"))
+		mockProducer.ExpectInputWithMessageCheckerFunctionAndSucceed(checkEventTime(5))
+		replayProducer := &fakeEventsProducer{down: true}
+		fallback := newTestSpool(t, replayProducer, filepath.Join(t.TempDir(), "events.spool"), 1<<20)
+
+		producer := newAsyncEventProducer(logger, mockProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 10, Fallback: fallback})
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: 1}))
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: 2}))
+		assert.Eventually(t, func() bool {
+			return producer.Stats().Spooled == 1
+		}, time.Second, time.Millisecond)
+
+		// Kafka would accept them, but the older event is still spooled
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: 3}))
+		assert.Error(t, fallback.replay())
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: 4}))
+		assert.Equal(t, int64(3), producer.Stats().Spooled)
+
+		replayProducer.setDown(false)
+		assert.NoError(t, fallback.replay())
+		assert.Equal(t, []int64{2, 3, 4}, replayProducer.eventTimes("a"))
+
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: 5}))
+		assert.NoError(t, producer.Close())
+		assert.Equal(t, int64(2), producer.Stats().Sent)
+	})
+
+	t.Run("Queue is full. Event is dropped", func(t *testing.T) {
+		stuckProducer := newStuckAsyncProducer()
+		producer := newAsyncEventProducer(logger, stuckProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 1})
+
+		// The first event is taken from the queue and waits for kafka
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.Eventually(t, func() bool {
+			return producer.Stats().QueueDepth == 0
+		}, time.Second, time.Millisecond)
+
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.Equal(t, models.ProducerStats{QueueDepth: 1, QueueSize: 1, Dropped: 1}, producer.Stats())
+
+		go func() {
+			for range stuckProducer.input {
+			}
+		}()
+		assert.NoError(t, producer.Close())
+		close(stuckProducer.input)
+	})
+
+	t.Run("Queue is full with block policy. Producing waits for a free slot", func(t *testing.T) {
+		stuckProducer := newStuckAsyncProducer()
+		producer := newAsyncEventProducer(logger, stuckProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 1, BlockWhenFull: true})
+
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.Eventually(t, func() bool {
+			return producer.Stats().QueueDepth == 0
+		}, time.Second, time.Millisecond)
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+
+		produced := make(chan struct{})
+		go func() {
+			assert.NoError(t, producer.ProduceEvent(testEvent))
+			close(produced)
+		}()
+
+		select {
+		case <-produced:
+			t.Fatal("event was queued while the queue was full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		<-stuckProducer.input
+		<-produced
+		assert.Equal(t, int64(0), producer.Stats().Dropped)
+
+		go func() {
+			for range stuckProducer.input {
+			}
+		}()
+		assert.NoError(t, producer.Close())
+		close(stuckProducer.input)
+	})
+
+	t.Run("Producing waits for a free slot when closed. It gives up and close returns", func(t *testing.T) {
+		stuckProducer := newStuckAsyncProducer()
+		producer := newAsyncEventProducer(logger, stuckProducer, "events", EncodeEventJSON, AsyncProducerConfig{QueueSize: 1, BlockWhenFull: true})
+
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+		assert.Eventually(t, func() bool {
+			return producer.Stats().QueueDepth == 0
+		}, time.Second, time.Millisecond)
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+
+		produced := make(chan error)
+		go func() {
+			produced <- producer.ProduceEvent(testEvent)
+		}()
+
+		closed := make(chan error)
+		go func() {
+			closed <- producer.Close()
+		}()
+
+		select {
+		case err := <-produced:
+			assert.Equal(t, errProducerClosed, err)
+		case <-time.After(time.Second):
+			t.Fatal("blocked event was not given up on close")
+		}
+
+		go func() {
+			for range stuckProducer.input {
+			}
+		}()
+		assert.NoError(t, <-closed)
+		close(stuckProducer.input)
+	})
+}
@@ -0,0 +1,104 @@
+package events
+
+import (
+	"encoding/json"
+
+	"CoolUrlShortener/internal/repository/models"
+	eventspb "CoolUrlShortener/pkg/proto/events"
+	"google.golang.org/protobuf/proto"
+)
+
+// SchemaVersion is the version of the published event format described in pkg/proto/events/events.proto.
+// It is raised on every change a consumer has to know about
+const SchemaVersion = 1
+
+// EventEncoder turns an event into the payload published to a sink
+type EventEncoder func(event models.URLEvent) ([]byte, error)
+
+// jsonEvent is the row ClickHouse reads from the events topic with JSONEachRow.
+// EventTime keeps second resolution for the existing url_events columns
+type jsonEvent struct {
+	SchemaVersion uint32   `json:"schema_version"`
+	EventID       string   `json:"event_id"`
+	LongURL       string   `json:"long_url"`
+	ShortURL      string   `json:"short_url"`
+	Domain        string   `json:"domain"`
+	EventTime     int64    `json:"event_time"`
+	EventTimeMs   int64    `json:"event_time_ms"`
+	EventType     int8     `json:"event_type"`
+	Tags          []string `json:"tags"`
+	CampaignID    string   `json:"campaign_id"`
+
+	Referrer       string `json:"referrer"`
+	UserAgent      string `json:"user_agent"`
+	IP             string `json:"ip"`
+	AcceptLanguage string `json:"accept_language"`
+	Host           string `json:"host"`
+	DeviceType     string `json:"device_type"`
+	OSFamily       string `json:"os_family"`
+	BrowserFamily  string `json:"browser_family"`
+	Bot            bool   `json:"is_bot"`
+	BotScore       int    `json:"bot_score"`
+}
+
+// EncodeEventJSON encodes the event as a flat json object with the fields of the protobuf schema
+// and the schema version, so JSONEachRow ingestion keeps working
+func EncodeEventJSON(event models.URLEvent) ([]byte, error) {
+	// ClickHouse expects an array, not null
+	tags := event.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return json.Marshal(jsonEvent{
+		SchemaVersion: SchemaVersion,
+		EventID:       event.EventID,
+		LongURL:       event.LongURL,
+		ShortURL:      event.ShortURL,
+		Domain:        event.Domain,
+		EventTime:     event.EventTime / 1000,
+		EventTimeMs:   event.EventTime,
+		EventType:     event.EventType,
+		Tags:          tags,
+		CampaignID:    event.CampaignID,
+
+		Referrer:       event.Referrer,
+		UserAgent:      event.UserAgent,
+		IP:             event.IP,
+		AcceptLanguage: event.AcceptLanguage,
+		Host:           event.Host,
+		DeviceType:     event.DeviceType,
+		OSFamily:       event.OSFamily,
+		BrowserFamily:  event.BrowserFamily,
+		Bot:            event.Bot,
+		BotScore:       event.BotScore,
+	})
+}
+
+// EncodeEventProtobuf encodes the event as an Envelope of the protobuf schema
+func EncodeEventProtobuf(event models.URLEvent) ([]byte, error) {
+	return proto.Marshal(&eventspb.Envelope{
+		SchemaVersion: SchemaVersion,
+		Event: &eventspb.URLEvent{
+			EventId:     event.EventID,
+			EventTimeMs: event.EventTime,
+			EventType:   eventspb.EventType(event.EventType),
+			LongUrl:     event.LongURL,
+			ShortUrl:    event.ShortURL,
+			Domain:      event.Domain,
+			Tags:        event.Tags,
+			CampaignId:  event.CampaignID,
+
+			Referrer:       event.Referrer,
+			UserAgent:      event.UserAgent,
+			Ip:             event.IP,
+			AcceptLanguage: event.AcceptLanguage,
+			Host:           event.Host,
+			DeviceType:     event.DeviceType,
+			OsFamily:       event.OSFamily,
+			BrowserFamily:  event.BrowserFamily,
+			IsBot:          event.Bot,
+			BotScore:       uint32(event.BotScore),
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"CoolUrlShortener/internal/repository/models"
+	eventspb "CoolUrlShortener/pkg/proto/events"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// The golden files are the contract with the analytics side, which decodes the same files.
+// A change here that breaks them needs a new schema version
+const (
+	goldenJSONPath     = "../../../pkg/proto/events/testdata/url_event_v1.json"
+	goldenProtobufPath = "../../../pkg/proto/events/testdata/url_event_v1.binpb"
+)
+
+var goldenEvent = models.URLEvent{
+	EventID:    "0f8fad5b-d9cb-469f-a165-70867728950e",
+	LongURL:    "https://example.com/page",
+	ShortURL:   "abc123",
+	Domain:     "sho.rt",
+	EventTime:  1718000000123,
+	EventType:  models.EventTypeFollow,
+	Tags:       []string{"summer", "email"},
+	CampaignID: "spring-sale",
+
+	Referrer:       "https://news.example.org/",
+	UserAgent:      "Mozilla/5.0 (X11; Linux x86_64) Firefox/126.0",
+	IP:             "203.0.113.0",
+	AcceptLanguage: "en-US,en;q=0.9",
+	Host:           "sho.rt",
+	DeviceType:     "desktop",
+	OSFamily:       "Linux",
+	BrowserFamily:  "Firefox",
+	BotScore:       20,
+}
+
+func TestEncodeEventJSON(t *testing.T) {
+	t.Run("Event matches the golden row", func(t *testing.T) {
+		golden, err := os.ReadFile(goldenJSONPath)
+		assert.NoError(t, err)
+
+		encoded, err := EncodeEventJSON(goldenEvent)
+		assert.NoError(t, err)
+		assert.JSONEq(t, string(golden), string(encoded))
+	})
+
+	t.Run("Nil tags are encoded as an empty array", func(t *testing.T) {
+		encoded, err := EncodeEventJSON(models.URLEvent{ShortURL: "abc123"})
+		assert.NoError(t, err)
+
+		var row map[string]any
+		assert.NoError(t, json.Unmarshal(encoded, &row))
+		assert.Equal(t, []any{}, row["tags"])
+	})
+}
+
+func TestEncodeEventProtobuf(t *testing.T) {
+	t.Run("Event matches the golden envelope", func(t *testing.T) {
+		golden, err := os.ReadFile(goldenProtobufPath)
+		assert.NoError(t, err)
+		var expected eventspb.Envelope
+		assert.NoError(t, proto.Unmarshal(golden, &expected))
+
+		encoded, err := EncodeEventProtobuf(goldenEvent)
+		assert.NoError(t, err)
+		var actual eventspb.Envelope
+		assert.NoError(t, proto.Unmarshal(encoded, &actual))
+
+		assert.True(t, proto.Equal(&expected, &actual), "expected %v, got %v", &expected, &actual)
+	})
+
+	t.Run("Event types keep their values", func(t *testing.T) {
+		assert.Equal(t, int32(models.EventTypeCreate), int32(eventspb.EventType_EVENT_TYPE_CREATE))
+		assert.Equal(t, int32(models.EventTypeFollow), int32(eventspb.EventType_EVENT_TYPE_FOLLOW))
+		assert.Equal(t, int32(models.EventTypePreview), int32(eventspb.EventType_EVENT_TYPE_PREVIEW))
+	})
+}
@@ -0,0 +1,70 @@
+package events
+
+import (
+	"errors"
+
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+)
+
+type fanOutEventProducer struct {
+	producers []repository.EventsProducer
+}
+
+// NewFanOutEventProducer returns a producer writing every event to all producers.
+// An event is written to the rest even if one of them fails, the errors are joined.
+// Stats and Close cover the producers that are asynchronous
+func NewFanOutEventProducer(producers ...repository.EventsProducer) repository.AsyncEventsProducer {
+	return &fanOutEventProducer{
+		producers: producers,
+	}
+}
+
+func (f *fanOutEventProducer) ProduceEvent(event models.URLEvent) error {
+	var errs []error
+	for _, producer := range f.producers {
+		err := producer.ProduceEvent(event)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *fanOutEventProducer) Stats() models.ProducerStats {
+	var stats models.ProducerStats
+	for _, producer := range f.producers {
+		asyncProducer, ok := producer.(repository.AsyncEventsProducer)
+		if !ok {
+			continue
+		}
+
+		producerStats := asyncProducer.Stats()
+		stats.QueueDepth += producerStats.QueueDepth
+		stats.QueueSize += producerStats.QueueSize
+		stats.Sent += producerStats.Sent
+		stats.Dropped += producerStats.Dropped
+		stats.Failed += producerStats.Failed
+		stats.Spooled += producerStats.Spooled
+	}
+
+	return stats
+}
+
+func (f *fanOutEventProducer) Close() error {
+	var errs []error
+	for _, producer := range f.producers {
+		asyncProducer, ok := producer.(repository.AsyncEventsProducer)
+		if !ok {
+			continue
+		}
+
+		err := asyncProducer.Close()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"testing"
+
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFanOutEventProducer(t *testing.T) {
+	testEvent := models.URLEvent{ShortURL: "short", EventTime: 1, EventType: models.EventTypeFollow}
+
+	t.Run("Event is written to every producer", func(t *testing.T) {
+		first := &fakeEventsProducer{}
+		second := &fakeEventsProducer{}
+
+		producer := NewFanOutEventProducer(first, second)
+		assert.NoError(t, producer.ProduceEvent(testEvent))
+
+		assert.Equal(t, []int64{1}, first.eventTimes("short"))
+		assert.Equal(t, []int64{1}, second.eventTimes("short"))
+	})
+
+	t.Run("One producer fails. Event is written to the rest and error is returned", func(t *testing.T) {
+		first := &fakeEventsProducer{down: true}
+		second := &fakeEventsProducer{}
+
+		producer := NewFanOutEventProducer(first, second)
+		assert.Error(t, producer.ProduceEvent(testEvent))
+
+		assert.Empty(t, first.eventTimes("short"))
+		assert.Equal(t, []int64{1}, second.eventTimes("short"))
+	})
+
+	t.Run("Stats and Close cover only async producers", func(t *testing.T) {
+		asyncProducer := mocks.NewAsyncEventsProducer(t)
+		asyncProducer.On("Stats").Return(models.ProducerStats{QueueSize: 10, Sent: 3, Dropped: 1})
+		asyncProducer.On("Close").Return(nil)
+
+		producer := NewFanOutEventProducer(asyncProducer, &fakeEventsProducer{})
+
+		assert.Equal(t, models.ProducerStats{QueueSize: 10, Sent: 3, Dropped: 1}, producer.Stats())
+		assert.NoError(t, producer.Close())
+	})
+}
@@ -1,8 +1,6 @@
 package events
 
 import (
-	"encoding/json"
-	"log"
 	"log/slog"
 
 	"CoolUrlShortener/internal/repository"
@@ -13,15 +11,24 @@ import (
 type kafkaEventProducer struct {
 	logger         *slog.Logger
 	eventsProducer sarama.SyncProducer
+	topic          string
+	encode         EventEncoder
 }
 
+// NewKafkaEventProducer returns a producer publishing events to topic.
+// ProduceEvent returns once kafka acknowledged the event
 func NewKafkaEventProducer(
 	logger *slog.Logger,
 	addrs []string,
-	kafkaCfg *sarama.Config,
+	topic string,
+	baseCfg *sarama.Config,
+	encode EventEncoder,
 	doneCh <-chan struct{},
 ) (repository.EventsProducer, error) {
-	producer, err := sarama.NewSyncProducer(addrs, kafkaCfg)
+	kafkaCfg := *baseCfg
+	kafkaCfg.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(addrs, &kafkaCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -36,25 +43,23 @@ func NewKafkaEventProducer(
 	return &kafkaEventProducer{
 		logger:         logger,
 		eventsProducer: producer,
+		topic:          topic,
+		encode:         encode,
 	}, nil
 }
 
-func (k *kafkaEventProducer) ProduceEvent(event models.URLEvent) {
-	bytes, err := json.Marshal(event)
+func (k *kafkaEventProducer) ProduceEvent(event models.URLEvent) error {
+	bytes, err := k.encode(event)
 	if err != nil {
-		k.logger.Error(err.Error())
-		return
+		return err
 	}
 
-	log.Println(bytes)
 	msg := &sarama.ProducerMessage{
-		Topic: "events",
+		Topic: k.topic,
 		Key:   sarama.StringEncoder(event.ShortURL),
 		Value: sarama.ByteEncoder(bytes),
 	}
 
 	_, _, err = k.eventsProducer.SendMessage(msg)
-	if err != nil {
-		k.logger.Error(err.Error())
-	}
+	return err
 }
@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type NATSProducerConfig struct {
+	URL     string
+	Subject string
+	// Stream is created for Subject when it does not exist yet
+	Stream         string
+	PublishTimeout time.Duration
+}
+
+type natsEventProducer struct {
+	logger *slog.Logger
+	js     jetstream.JetStream
+	cfg    NATSProducerConfig
+	encode EventEncoder
+}
+
+// NewNATSEventProducer returns a producer publishing events to a JetStream stream.
+// ProduceEvent returns once the stream acknowledged the event
+func NewNATSEventProducer(
+	logger *slog.Logger,
+	cfg NATSProducerConfig,
+	encode EventEncoder,
+	doneCh <-chan struct{},
+) (repository.EventsProducer, error) {
+	conn, err := nats.Connect(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.PublishTimeout)
+	defer cancel()
+
+	_, err = js.Stream(ctx, cfg.Stream)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+			Name:     cfg.Stream,
+			Subjects: []string{cfg.Subject},
+		})
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	go func() {
+		<-doneCh
+		err := conn.Drain()
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+
+	return newNATSEventProducer(logger, js, cfg, encode), nil
+}
+
+func newNATSEventProducer(
+	logger *slog.Logger,
+	js jetstream.JetStream,
+	cfg NATSProducerConfig,
+	encode EventEncoder,
+) *natsEventProducer {
+	return &natsEventProducer{
+		logger: logger,
+		js:     js,
+		cfg:    cfg,
+		encode: encode,
+	}
+}
+
+func (n *natsEventProducer) ProduceEvent(event models.URLEvent) error {
+	bytes, err := n.encode(event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.PublishTimeout)
+	defer cancel()
+
+	_, err = n.js.Publish(ctx, n.cfg.Subject, bytes)
+	return err
+}
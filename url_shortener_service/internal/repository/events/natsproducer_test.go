@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeJetStream records published messages, a stream that does not ack fails the publish
+type fakeJetStream struct {
+	jetstream.JetStream
+	subjects []string
+	payloads [][]byte
+	noAck    bool
+	// deadlines are the timeouts the publishes were given
+	deadlines []time.Duration
+}
+
+func (js *fakeJetStream) Publish(ctx context.Context, subject string, payload []byte, _ ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	deadline, ok := ctx.Deadline()
+	if ok {
+		js.deadlines = append(js.deadlines, time.Until(deadline))
+	}
+
+	if js.noAck {
+		return nil, errors.New("nats: no response from stream")
+	}
+	js.subjects = append(js.subjects, subject)
+	js.payloads = append(js.payloads, payload)
+
+	return &jetstream.PubAck{Stream: "URL_EVENTS", Sequence: uint64(len(js.payloads))}, nil
+}
+
+func TestNATSEventProducer(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	cfg := NATSProducerConfig{Subject: "url.events", Stream: "URL_EVENTS", PublishTimeout: time.Second}
+
+	t.Run("Event is acked. It is published to the subject", func(t *testing.T) {
+		js := &fakeJetStream{}
+		producer := newNATSEventProducer(logger, js, cfg, EncodeEventJSON)
+
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "short", EventTime: 1}))
+		assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "short", EventTime: 2}))
+		assert.Equal(t, []string{"url.events", "url.events"}, js.subjects)
+
+		var times []int64
+		for _, payload := range js.payloads {
+			var event models.URLEvent
+			assert.NoError(t, json.Unmarshal(payload, &event))
+			times = append(times, event.EventTime)
+		}
+		assert.Equal(t, []int64{1, 2}, times)
+
+		for _, deadline := range js.deadlines {
+			assert.LessOrEqual(t, deadline, cfg.PublishTimeout)
+		}
+		assert.Len(t, js.deadlines, 2)
+	})
+
+	t.Run("Stream does not ack. Error is returned", func(t *testing.T) {
+		js := &fakeJetStream{noAck: true}
+		producer := newNATSEventProducer(logger, js, cfg, EncodeEventJSON)
+
+		assert.Error(t, producer.ProduceEvent(models.URLEvent{ShortURL: "short"}))
+		assert.Empty(t, js.payloads)
+	})
+}
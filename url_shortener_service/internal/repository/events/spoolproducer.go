@@ -0,0 +1,219 @@
+package events
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+)
+
+var errSpoolFull = errors.New("events spool is full")
+
+type SpoolConfig struct {
+	Path string
+	// MaxBytes caps the spool file, events that do not fit are dropped
+	MaxBytes       int64
+	ReplayInterval time.Duration
+}
+
+type spoolEventProducer struct {
+	logger   *slog.Logger
+	producer repository.EventsProducer
+	cfg      SpoolConfig
+
+	// mu guards the spool file and size
+	mu   sync.Mutex
+	size int64
+}
+
+// NewSpoolEventProducer wraps producer. Events it fails to publish are appended to a file on disk,
+// Run publishes them again in the order they were spooled once producer works again.
+// A spool left by a previous run is replayed as well
+func NewSpoolEventProducer(
+	logger *slog.Logger,
+	producer repository.EventsProducer,
+	cfg SpoolConfig,
+) (repository.SpoolEventsProducer, error) {
+	file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_RDONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return &spoolEventProducer{
+		logger:   logger,
+		producer: producer,
+		cfg:      cfg,
+		size:     info.Size(),
+	}, nil
+}
+
+// ProduceEvent publishes the event unless older events are waiting in the spool,
+// then it is spooled after them so the order is kept
+func (s *spoolEventProducer) ProduceEvent(event models.URLEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size == 0 {
+		err := s.producer.ProduceEvent(event)
+		if err == nil {
+			return nil
+		}
+		s.logger.Warn(fmt.Sprintf("Spool event for %s: %s", event.ShortURL, err.Error()))
+	}
+
+	return s.appendEvent(event)
+}
+
+func (s *spoolEventProducer) SpoolEvent(event models.URLEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.appendEvent(event)
+}
+
+func (s *spoolEventProducer) Pending() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.size > 0
+}
+
+func (s *spoolEventProducer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.ReplayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.replay()
+			if err != nil {
+				s.logger.Error(err.Error())
+			}
+		}
+	}
+}
+
+func (s *spoolEventProducer) appendEvent(event models.URLEvent) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	if s.size+int64(len(line)) > s.cfg.MaxBytes {
+		return errSpoolFull
+	}
+
+	file, err := os.OpenFile(s.cfg.Path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(line)
+	if err != nil {
+		return err
+	}
+	s.size += int64(len(line))
+
+	return file.Sync()
+}
+
+// replay publishes the spooled events in order and stops at the first failure.
+// The published events are removed from the spool, an event is published again
+// if the process stops before that. The spool is not locked while publishing,
+// events spooled meanwhile are appended after the replayed ones and stay
+func (s *spoolEventProducer) replay() error {
+	content, err := s.read()
+	if len(content) == 0 || err != nil {
+		return err
+	}
+
+	var published int
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+
+		var event models.URLEvent
+		err = json.Unmarshal(line, &event)
+		if err != nil {
+			// A line torn by a crash while appending can not be replayed
+			s.logger.Error(fmt.Sprintf("Skip spooled event: %s", err.Error()))
+		} else if err = s.producer.ProduceEvent(event); err != nil {
+			break
+		}
+		published += len(line) + 1
+	}
+	if published > len(content) {
+		published = len(content)
+	}
+	if published == 0 {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, readErr := os.ReadFile(s.cfg.Path)
+	if readErr != nil {
+		return readErr
+	}
+	rewriteErr := s.rewrite(content[published:])
+	if rewriteErr != nil {
+		return rewriteErr
+	}
+	s.logger.Info(fmt.Sprintf("Replayed %d bytes of spooled events", published))
+
+	return err
+}
+
+// read returns the spooled events
+func (s *spoolEventProducer) read() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.size == 0 {
+		return nil, nil
+	}
+	content, err := os.ReadFile(s.cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return content[:s.size], nil
+}
+
+// rewrite replaces the spool with rest, so a crash leaves either the old or the new spool
+func (s *spoolEventProducer) rewrite(rest []byte) error {
+	tmpPath := s.cfg.Path + ".tmp"
+	err := os.WriteFile(tmpPath, rest, 0o644)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpPath, s.cfg.Path)
+	if err != nil {
+		return err
+	}
+	s.size = int64(len(rest))
+
+	return nil
+}
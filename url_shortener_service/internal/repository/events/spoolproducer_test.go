@@ -0,0 +1,166 @@
+package events
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEventsProducer records published events, while down it fails every publish
+type fakeEventsProducer struct {
+	mu        sync.Mutex
+	published []models.URLEvent
+	down      bool
+	// failAfter makes the producer go down after publishing that many more events
+	failAfter int
+}
+
+func (p *fakeEventsProducer) ProduceEvent(event models.URLEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.down {
+		return errors.New("kafka is unavailable")
+	}
+	p.published = append(p.published, event)
+	if p.failAfter > 0 {
+		p.failAfter--
+		if p.failAfter == 0 {
+			p.down = true
+		}
+	}
+
+	return nil
+}
+
+func (p *fakeEventsProducer) setDown(down bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.down = down
+}
+
+func (p *fakeEventsProducer) eventTimes(shortURL string) []int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var times []int64
+	for _, event := range p.published {
+		if event.ShortURL == shortURL {
+			times = append(times, event.EventTime)
+		}
+	}
+
+	return times
+}
+
+func newTestSpool(t *testing.T, producer *fakeEventsProducer, path string, maxBytes int64) *spoolEventProducer {
+	spool, err := NewSpoolEventProducer(
+		slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		producer,
+		SpoolConfig{Path: path, MaxBytes: maxBytes},
+	)
+	assert.NoError(t, err)
+
+	return spool.(*spoolEventProducer)
+}
+
+func produceTestEvents(t *testing.T, spool *spoolEventProducer, from int64, to int64) {
+	for i := from; i <= to; i++ {
+		shortURL := "a"
+		if i%2 == 0 {
+			shortURL = "b"
+		}
+		assert.NoError(t, spool.ProduceEvent(models.URLEvent{ShortURL: shortURL, EventTime: i}))
+	}
+}
+
+func TestSpoolEventProducer(t *testing.T) {
+	t.Run("Kafka is up. Events are published right away", func(t *testing.T) {
+		producer := &fakeEventsProducer{}
+		spool := newTestSpool(t, producer, filepath.Join(t.TempDir(), "events.spool"), 1<<20)
+
+		produceTestEvents(t, spool, 1, 4)
+		assert.Equal(t, []int64{1, 3}, producer.eventTimes("a"))
+		assert.Equal(t, []int64{2, 4}, producer.eventTimes("b"))
+		assert.Equal(t, int64(0), spool.size)
+	})
+
+	t.Run("Kafka is down. Events are spooled and replayed in order once it is back", func(t *testing.T) {
+		producer := &fakeEventsProducer{down: true}
+		spool := newTestSpool(t, producer, filepath.Join(t.TempDir(), "events.spool"), 1<<20)
+
+		produceTestEvents(t, spool, 1, 4)
+		assert.Error(t, spool.replay())
+
+		// Events keep going to the spool while older ones wait there
+		producer.setDown(false)
+		produceTestEvents(t, spool, 5, 6)
+		assert.Empty(t, producer.eventTimes("a"))
+
+		assert.NoError(t, spool.replay())
+		assert.Equal(t, []int64{1, 3, 5}, producer.eventTimes("a"))
+		assert.Equal(t, []int64{2, 4, 6}, producer.eventTimes("b"))
+		assert.Equal(t, int64(0), spool.size)
+
+		produceTestEvents(t, spool, 7, 7)
+		assert.Equal(t, []int64{1, 3, 5, 7}, producer.eventTimes("a"))
+	})
+
+	t.Run("Kafka fails during replay. The rest stays spooled", func(t *testing.T) {
+		producer := &fakeEventsProducer{down: true}
+		spool := newTestSpool(t, producer, filepath.Join(t.TempDir(), "events.spool"), 1<<20)
+		produceTestEvents(t, spool, 1, 6)
+
+		producer.setDown(false)
+		producer.failAfter = 3
+		assert.Error(t, spool.replay())
+		assert.Equal(t, []int64{1, 3}, producer.eventTimes("a"))
+		assert.Equal(t, []int64{2}, producer.eventTimes("b"))
+
+		producer.setDown(false)
+		assert.NoError(t, spool.replay())
+		assert.Equal(t, []int64{1, 3, 5}, producer.eventTimes("a"))
+		assert.Equal(t, []int64{2, 4, 6}, producer.eventTimes("b"))
+	})
+
+	t.Run("Process restarts. Spooled events are replayed by the new instance", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events.spool")
+		produceTestEvents(t, newTestSpool(t, &fakeEventsProducer{down: true}, path, 1<<20), 1, 3)
+
+		producer := &fakeEventsProducer{}
+		restarted := newTestSpool(t, producer, path, 1<<20)
+		assert.NoError(t, restarted.replay())
+		assert.Equal(t, []int64{1, 3}, producer.eventTimes("a"))
+		assert.Equal(t, []int64{2}, producer.eventTimes("b"))
+	})
+
+	t.Run("Spool of the previous release. Event time in seconds is read as milliseconds", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events.spool")
+		spooled := `{"short_url":"a","event_time":1700000000,"event_type":2}` + "\n" +
+			`{"short_url":"a","event_time_ms":1700000001500,"event_type":2}` + "\n"
+		assert.NoError(t, os.WriteFile(path, []byte(spooled), 0o644))
+
+		producer := &fakeEventsProducer{}
+		assert.NoError(t, newTestSpool(t, producer, path, 1<<20).replay())
+		assert.Equal(t, []int64{1700000000000, 1700000001500}, producer.eventTimes("a"))
+	})
+
+	t.Run("Spool is full. Event is rejected", func(t *testing.T) {
+		producer := &fakeEventsProducer{down: true}
+		spool := newTestSpool(t, producer, filepath.Join(t.TempDir(), "events.spool"), 200)
+
+		var err error
+		for i := 0; i < 10 && err == nil; i++ {
+			err = spool.ProduceEvent(models.URLEvent{ShortURL: "a", EventTime: int64(i)})
+		}
+		assert.Equal(t, errSpoolFull, err)
+		assert.LessOrEqual(t, spool.size, int64(200))
+	})
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+)
+
+type writerEventProducer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewFileEventProducer returns a producer appending events to the file at path as newline-delimited json
+func NewFileEventProducer(
+	logger *slog.Logger,
+	path string,
+	doneCh <-chan struct{},
+) (repository.EventsProducer, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-doneCh
+		err := file.Close()
+		if err != nil {
+			logger.Error(err.Error())
+		}
+	}()
+
+	return newWriterEventProducer(file), nil
+}
+
+// NewStdoutEventProducer returns a producer printing events to stdout as newline-delimited json.
+// It is meant for local development
+func NewStdoutEventProducer() repository.EventsProducer {
+	return newWriterEventProducer(os.Stdout)
+}
+
+func newWriterEventProducer(w io.Writer) *writerEventProducer {
+	return &writerEventProducer{
+		w: w,
+	}
+}
+
+func (p *writerEventProducer) ProduceEvent(event models.URLEvent) error {
+	line, err := EncodeEventJSON(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err = p.w.Write(line)
+	return err
+}
@@ -0,0 +1,44 @@
+package events
+
+import (
+	"bufio"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileEventProducer(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	t.Run("Events are appended as newline-delimited json", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "events.ndjson")
+		doneCh := make(chan struct{})
+
+		producer, err := NewFileEventProducer(logger, path, doneCh)
+		assert.NoError(t, err)
+		for i := int64(1); i <= 3; i++ {
+			assert.NoError(t, producer.ProduceEvent(models.URLEvent{ShortURL: "short", EventTime: i}))
+		}
+		close(doneCh)
+
+		file, err := os.Open(path)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		var times []int64
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var event models.URLEvent
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			times = append(times, event.EventTime)
+		}
+		assert.Equal(t, []int64{1, 2, 3}, times)
+	})
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name IdempotencyRepo
+type IdempotencyRepo interface {
+	// ClaimIdempotencyKey claims a new or expired key within the current transaction and returns true.
+	// A key claimed by a concurrent transaction blocks the call until it ends, then the committed record is returned
+	ClaimIdempotencyKey(
+		ctx context.Context,
+		key string,
+		fingerprint string,
+		expiredBefore time.Time,
+	) (domain.IdempotencyRecord, bool, error)
+	SaveIdempotentResponse(ctx context.Context, key string, response string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int, error)
+}
@@ -0,0 +1,59 @@
+package linkchecker
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+)
+
+const (
+	userAgent = "CoolUrlShortener/1.0 (+link health check)"
+)
+
+type httpLinkChecker struct {
+	client *http.Client
+}
+
+// NewHTTPLinkChecker leaves timeouts and redirects to the client
+func NewHTTPLinkChecker(client *http.Client) repository.DestinationChecker {
+	return &httpLinkChecker{
+		client: client,
+	}
+}
+
+// CheckDestination asks with HEAD first and falls back to GET for servers that do not implement HEAD.
+// The error is returned together with the result, so an unreachable destination is still recorded
+func (c *httpLinkChecker) CheckDestination(ctx context.Context, destinationURL string) (domain.CheckResult, error) {
+	checkedAt := time.Now()
+
+	statusCode, err := c.request(ctx, http.MethodHead, destinationURL)
+	if err == nil && (statusCode == http.StatusMethodNotAllowed || statusCode == http.StatusNotImplemented) {
+		statusCode, err = c.request(ctx, http.MethodGet, destinationURL)
+	}
+
+	return domain.CheckResult{
+		StatusCode: statusCode,
+		Latency:    time.Since(checkedAt),
+		CheckedAt:  checkedAt,
+	}, err
+}
+
+func (c *httpLinkChecker) request(ctx context.Context, method string, destinationURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, destinationURL, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	// The body is never read, closing it drops the connection instead of downloading the page
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,65 @@
+package linkchecker
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDestination(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/gone", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/get_only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	checker := NewHTTPLinkChecker(client)
+
+	testCases := []struct {
+		name               string
+		path               string
+		expectedStatusCode int
+		expectedOK         bool
+		isErrExpected      bool
+	}{
+		{name: "available destination", path: "/ok", expectedStatusCode: http.StatusOK, expectedOK: true},
+		{name: "missing destination", path: "/gone", expectedStatusCode: http.StatusGone, expectedOK: false},
+		{name: "redirect to missing destination", path: "/moved", expectedStatusCode: http.StatusGone, expectedOK: false},
+		{name: "head is not allowed", path: "/get_only", expectedStatusCode: http.StatusOK, expectedOK: true},
+		{name: "timeout", path: "/slow", expectedStatusCode: 0, expectedOK: false, isErrExpected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := checker.CheckDestination(context.Background(), server.URL+tc.path)
+
+			assert.Equal(t, tc.isErrExpected, err != nil)
+			assert.Equal(t, tc.expectedStatusCode, result.StatusCode)
+			assert.Equal(t, tc.expectedOK, result.OK())
+			assert.False(t, result.CheckedAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "CoolUrlShortener/internal/repository/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AsyncEventsProducer is an autogenerated mock type for the AsyncEventsProducer type
+type AsyncEventsProducer struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields:
+func (_m *AsyncEventsProducer) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProduceEvent provides a mock function with given fields: event
+func (_m *AsyncEventsProducer) ProduceEvent(event models.URLEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.URLEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Stats provides a mock function with given fields:
+func (_m *AsyncEventsProducer) Stats() models.ProducerStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 models.ProducerStats
+	if rf, ok := ret.Get(0).(func() models.ProducerStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.ProducerStats)
+	}
+
+	return r0
+}
+
+// NewAsyncEventsProducer creates a new instance of AsyncEventsProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAsyncEventsProducer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AsyncEventsProducer {
+	mock := &AsyncEventsProducer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "CoolUrlShortener/internal/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuditRepo is an autogenerated mock type for the AuditRepo type
+type AuditRepo struct {
+	mock.Mock
+}
+
+// ListAuditRecords provides a mock function with given fields: ctx, urlID
+func (_m *AuditRepo) ListAuditRecords(ctx context.Context, urlID int64) ([]domain.AuditRecord, error) {
+	ret := _m.Called(ctx, urlID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditRecords")
+	}
+
+	var r0 []domain.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]domain.AuditRecord, error)); ok {
+		return rf(ctx, urlID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []domain.AuditRecord); ok {
+		r0 = rf(ctx, urlID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, urlID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListURLsCreatedBy provides a mock function with given fields: ctx, actor
+func (_m *AuditRepo) ListURLsCreatedBy(ctx context.Context, actor string) ([]domain.ErasedURL, error) {
+	ret := _m.Called(ctx, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListURLsCreatedBy")
+	}
+
+	var r0 []domain.ErasedURL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.ErasedURL, error)); ok {
+		return rf(ctx, actor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.ErasedURL); ok {
+		r0 = rf(ctx, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ErasedURL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactAuditRecords provides a mock function with given fields: ctx, urlIDs, actor
+func (_m *AuditRepo) RedactAuditRecords(ctx context.Context, urlIDs []int64, actor string) (int, error) {
+	ret := _m.Called(ctx, urlIDs, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedactAuditRecords")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, string) (int, error)); ok {
+		return rf(ctx, urlIDs, actor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64, string) int); ok {
+		r0 = rf(ctx, urlIDs, actor)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64, string) error); ok {
+		r1 = rf(ctx, urlIDs, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveAuditRecord provides a mock function with given fields: ctx, record
+func (_m *AuditRepo) SaveAuditRecord(ctx context.Context, record domain.AuditRecord) error {
+	ret := _m.Called(ctx, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveAuditRecord")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.AuditRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAuditRepo creates a new instance of AuditRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditRepo {
+	mock := &AuditRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "CoolUrlShortener/internal/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DestinationChecker is an autogenerated mock type for the DestinationChecker type
+type DestinationChecker struct {
+	mock.Mock
+}
+
+// CheckDestination provides a mock function with given fields: ctx, destinationURL
+func (_m *DestinationChecker) CheckDestination(ctx context.Context, destinationURL string) (domain.CheckResult, error) {
+	ret := _m.Called(ctx, destinationURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDestination")
+	}
+
+	var r0 domain.CheckResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.CheckResult, error)); ok {
+		return rf(ctx, destinationURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.CheckResult); ok {
+		r0 = rf(ctx, destinationURL)
+	} else {
+		r0 = ret.Get(0).(domain.CheckResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, destinationURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewDestinationChecker creates a new instance of DestinationChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDestinationChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DestinationChecker {
+	mock := &DestinationChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
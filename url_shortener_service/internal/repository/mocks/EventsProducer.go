@@ -14,8 +14,21 @@ type EventsProducer struct {
 }
 
 // ProduceEvent provides a mock function with given fields: event
-func (_m *EventsProducer) ProduceEvent(event models.URLEvent) {
-	_m.Called(event)
+func (_m *EventsProducer) ProduceEvent(event models.URLEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.URLEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // NewEventsProducer creates a new instance of EventsProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
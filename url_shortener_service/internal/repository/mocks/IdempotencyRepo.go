@@ -0,0 +1,111 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "CoolUrlShortener/internal/domain"
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyRepo is an autogenerated mock type for the IdempotencyRepo type
+type IdempotencyRepo struct {
+	mock.Mock
+}
+
+// ClaimIdempotencyKey provides a mock function with given fields: ctx, key, fingerprint, expiredBefore
+func (_m *IdempotencyRepo) ClaimIdempotencyKey(ctx context.Context, key string, fingerprint string, expiredBefore time.Time) (domain.IdempotencyRecord, bool, error) {
+	ret := _m.Called(ctx, key, fingerprint, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimIdempotencyKey")
+	}
+
+	var r0 domain.IdempotencyRecord
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (domain.IdempotencyRecord, bool, error)); ok {
+		return rf(ctx, key, fingerprint, expiredBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) domain.IdempotencyRecord); ok {
+		r0 = rf(ctx, key, fingerprint, expiredBefore)
+	} else {
+		r0 = ret.Get(0).(domain.IdempotencyRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) bool); ok {
+		r1 = rf(ctx, key, fingerprint, expiredBefore)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, time.Time) error); ok {
+		r2 = rf(ctx, key, fingerprint, expiredBefore)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// DeleteExpiredIdempotencyKeys provides a mock function with given fields: ctx, expiredBefore
+func (_m *IdempotencyRepo) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredIdempotencyKeys")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, expiredBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, expiredBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, expiredBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveIdempotentResponse provides a mock function with given fields: ctx, key, response
+func (_m *IdempotencyRepo) SaveIdempotentResponse(ctx context.Context, key string, response string) error {
+	ret := _m.Called(ctx, key, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotentResponse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, key, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIdempotencyRepo creates a new instance of IdempotencyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyRepo {
+	mock := &IdempotencyRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "CoolUrlShortener/internal/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "CoolUrlShortener/internal/repository/models"
+
+	time "time"
+)
+
+// OutboxRepo is an autogenerated mock type for the OutboxRepo type
+type OutboxRepo struct {
+	mock.Mock
+}
+
+// DeleteSentOutboxEvents provides a mock function with given fields: ctx, sentBefore
+func (_m *OutboxRepo) DeleteSentOutboxEvents(ctx context.Context, sentBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, sentBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSentOutboxEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, sentBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, sentBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, sentBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteURLOutboxEvents provides a mock function with given fields: ctx, erasedURLs
+func (_m *OutboxRepo) DeleteURLOutboxEvents(ctx context.Context, erasedURLs []domain.ErasedURL) (int, error) {
+	ret := _m.Called(ctx, erasedURLs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteURLOutboxEvents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ErasedURL) (int, error)); ok {
+		return rf(ctx, erasedURLs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.ErasedURL) int); ok {
+		r0 = rf(ctx, erasedURLs)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.ErasedURL) error); ok {
+		r1 = rf(ctx, erasedURLs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPendingOutboxEvents provides a mock function with given fields: ctx, limit
+func (_m *OutboxRepo) ListPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPendingOutboxEvents")
+	}
+
+	var r0 []models.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.OutboxEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.OutboxEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOutboxEventSinksSent provides a mock function with given fields: ctx, id, sinks
+func (_m *OutboxRepo) MarkOutboxEventSinksSent(ctx context.Context, id int64, sinks []string) error {
+	ret := _m.Called(ctx, id, sinks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventSinksSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string) error); ok {
+		r0 = rf(ctx, id, sinks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkOutboxEventsSent provides a mock function with given fields: ctx, ids
+func (_m *OutboxRepo) MarkOutboxEventsSent(ctx context.Context, ids []int64) error {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventsSent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) error); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveOutboxEvent provides a mock function with given fields: ctx, event
+func (_m *OutboxRepo) SaveOutboxEvent(ctx context.Context, event models.URLEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOutboxEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.URLEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOutboxRepo creates a new instance of OutboxRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepo {
+	mock := &OutboxRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
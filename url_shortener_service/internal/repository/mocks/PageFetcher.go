@@ -0,0 +1,57 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "CoolUrlShortener/internal/domain"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PageFetcher is an autogenerated mock type for the PageFetcher type
+type PageFetcher struct {
+	mock.Mock
+}
+
+// FetchMetadata provides a mock function with given fields: ctx, pageURL
+func (_m *PageFetcher) FetchMetadata(ctx context.Context, pageURL string) (domain.URLMetadata, error) {
+	ret := _m.Called(ctx, pageURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchMetadata")
+	}
+
+	var r0 domain.URLMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (domain.URLMetadata, error)); ok {
+		return rf(ctx, pageURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.URLMetadata); ok {
+		r0 = rf(ctx, pageURL)
+	} else {
+		r0 = ret.Get(0).(domain.URLMetadata)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, pageURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPageFetcher creates a new instance of PageFetcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPageFetcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PageFetcher {
+	mock := &PageFetcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "CoolUrlShortener/internal/repository/models"
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpoolEventsProducer is an autogenerated mock type for the SpoolEventsProducer type
+type SpoolEventsProducer struct {
+	mock.Mock
+}
+
+// Pending provides a mock function with given fields:
+func (_m *SpoolEventsProducer) Pending() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ProduceEvent provides a mock function with given fields: event
+func (_m *SpoolEventsProducer) ProduceEvent(event models.URLEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.URLEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *SpoolEventsProducer) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// SpoolEvent provides a mock function with given fields: event
+func (_m *SpoolEventsProducer) SpoolEvent(event models.URLEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpoolEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.URLEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSpoolEventsProducer creates a new instance of SpoolEventsProducer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSpoolEventsProducer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SpoolEventsProducer {
+	mock := &SpoolEventsProducer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
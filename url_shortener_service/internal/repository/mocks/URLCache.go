@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	domain "CoolUrlShortener/internal/domain"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
@@ -13,27 +14,45 @@ type URLCache struct {
 	mock.Mock
 }
 
-// GetLongURL provides a mock function with given fields: ctx, shortURL
-func (_m *URLCache) GetLongURL(ctx context.Context, shortURL string) (string, error) {
-	ret := _m.Called(ctx, shortURL)
+// DeleteURLData provides a mock function with given fields: ctx, urlDomain, shortURL
+func (_m *URLCache) DeleteURLData(ctx context.Context, urlDomain string, shortURL string) error {
+	ret := _m.Called(ctx, urlDomain, shortURL)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLongURL")
+		panic("no return value specified for DeleteURLData")
 	}
 
-	var r0 string
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, urlDomain, shortURL)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetURLData provides a mock function with given fields: ctx, urlDomain, shortURL
+func (_m *URLCache) GetURLData(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLData")
+	}
+
+	var r0 domain.URLData
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortURL)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortURL)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(domain.URLData)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortURL)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortURL)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,17 +60,17 @@ func (_m *URLCache) GetLongURL(ctx context.Context, shortURL string) (string, er
 	return r0, r1
 }
 
-// SetLongURL provides a mock function with given fields: ctx, shortURL, longURL
-func (_m *URLCache) SetLongURL(ctx context.Context, shortURL string, longURL string) error {
-	ret := _m.Called(ctx, shortURL, longURL)
+// SetURLData provides a mock function with given fields: ctx, urlData
+func (_m *URLCache) SetURLData(ctx context.Context, urlData domain.URLData) error {
+	ret := _m.Called(ctx, urlData)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetLongURL")
+		panic("no return value specified for SetURLData")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = rf(ctx, shortURL, longURL)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.URLData) error); ok {
+		r0 = rf(ctx, urlData)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -7,6 +7,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UrlRepo is an autogenerated mock type for the UrlRepo type
@@ -14,27 +16,55 @@ type UrlRepo struct {
 	mock.Mock
 }
 
-// GetLongURL provides a mock function with given fields: ctx, shortUrl
-func (_m *UrlRepo) GetLongURL(ctx context.Context, shortUrl string) (string, error) {
-	ret := _m.Called(ctx, shortUrl)
+// FindURLID provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlRepo) FindURLID(ctx context.Context, urlDomain string, shortUrl string) (int64, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLongURL")
+		panic("no return value specified for FindURLID")
 	}
 
-	var r0 string
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedURLData provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlRepo) GetDeletedURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedURLData")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -42,9 +72,9 @@ func (_m *UrlRepo) GetLongURL(ctx context.Context, shortUrl string) (string, err
 	return r0, r1
 }
 
-// GetShortURLByLongURL provides a mock function with given fields: ctx, longURL
-func (_m *UrlRepo) GetShortURLByLongURL(ctx context.Context, longURL string) (string, error) {
-	ret := _m.Called(ctx, longURL)
+// GetShortURLByLongURL provides a mock function with given fields: ctx, urlDomain, longURL
+func (_m *UrlRepo) GetShortURLByLongURL(ctx context.Context, urlDomain string, longURL string) (string, error) {
+	ret := _m.Called(ctx, urlDomain, longURL)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetShortURLByLongURL")
@@ -52,17 +82,189 @@ func (_m *UrlRepo) GetShortURLByLongURL(ctx context.Context, longURL string) (st
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, longURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, urlDomain, longURL)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, longURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, urlDomain, longURL)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, longURL)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, longURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLData provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *UrlRepo) GetURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLData")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLHealth provides a mock function with given fields: ctx, urlID
+func (_m *UrlRepo) GetURLHealth(ctx context.Context, urlID int64) (domain.URLHealth, error) {
+	ret := _m.Called(ctx, urlID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLHealth")
+	}
+
+	var r0 domain.URLHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.URLHealth, error)); ok {
+		return rf(ctx, urlID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.URLHealth); ok {
+		r0 = rf(ctx, urlID)
+	} else {
+		r0 = ret.Get(0).(domain.URLHealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, urlID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLMetadata provides a mock function with given fields: ctx, urlID
+func (_m *UrlRepo) GetURLMetadata(ctx context.Context, urlID int64) (domain.URLMetadata, error) {
+	ret := _m.Called(ctx, urlID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLMetadata")
+	}
+
+	var r0 domain.URLMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (domain.URLMetadata, error)); ok {
+		return rf(ctx, urlID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) domain.URLMetadata); ok {
+		r0 = rf(ctx, urlID)
+	} else {
+		r0 = ret.Get(0).(domain.URLMetadata)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, urlID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsCodeRetired provides a mock function with given fields: ctx, urlDomain, shortUrl, longURL
+func (_m *UrlRepo) IsCodeRetired(ctx context.Context, urlDomain string, shortUrl string, longURL string) (bool, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, longURL)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsCodeRetired")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, longURL)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, longURL)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, longURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDestinations provides a mock function with given fields: ctx, afterID, limit
+func (_m *UrlRepo) ListDestinations(ctx context.Context, afterID int64, limit int) ([]domain.Destination, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDestinations")
+	}
+
+	var r0 []domain.Destination
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]domain.Destination, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []domain.Destination); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Destination)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListURLData provides a mock function with given fields: ctx, keys
+func (_m *UrlRepo) ListURLData(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListURLData")
+	}
+
+	var r0 []domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.URLKey) ([]domain.URLData, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.URLKey) []domain.URLData); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.URLData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.URLKey) error); ok {
+		r1 = rf(ctx, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -70,6 +272,158 @@ func (_m *UrlRepo) GetShortURLByLongURL(ctx context.Context, longURL string) (st
 	return r0, r1
 }
 
+// ListURLMetadata provides a mock function with given fields: ctx, urlIDs
+func (_m *UrlRepo) ListURLMetadata(ctx context.Context, urlIDs []int64) (map[int64]domain.URLMetadata, error) {
+	ret := _m.Called(ctx, urlIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListURLMetadata")
+	}
+
+	var r0 map[int64]domain.URLMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]domain.URLMetadata, error)); ok {
+		return rf(ctx, urlIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]domain.URLMetadata); ok {
+		r0 = rf(ctx, urlIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]domain.URLMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, urlIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListURLs provides a mock function with given fields: ctx, params
+func (_m *UrlRepo) ListURLs(ctx context.Context, params domain.ListURLsParams) ([]domain.URLData, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListURLs")
+	}
+
+	var r0 []domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListURLsParams) ([]domain.URLData, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListURLsParams) []domain.URLData); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.URLData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListURLsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeletedURLs provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *UrlRepo) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedURLs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) (int, error)); ok {
+		return rf(ctx, deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) int); ok {
+		r0 = rf(ctx, deletedBefore, limit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeURL provides a mock function with given fields: ctx, urlID
+func (_m *UrlRepo) PurgeURL(ctx context.Context, urlID int64) (bool, error) {
+	ret := _m.Called(ctx, urlID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeURL")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (bool, error)); ok {
+		return rf(ctx, urlID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) bool); ok {
+		r0 = rf(ctx, urlID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, urlID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreURL provides a mock function with given fields: ctx, urlID
+func (_m *UrlRepo) RestoreURL(ctx context.Context, urlID int64) error {
+	ret := _m.Called(ctx, urlID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreURL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, urlID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveCheckResult provides a mock function with given fields: ctx, urlID, result, failureThreshold
+func (_m *UrlRepo) SaveCheckResult(ctx context.Context, urlID int64, result domain.CheckResult, failureThreshold int) error {
+	ret := _m.Called(ctx, urlID, result, failureThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCheckResult")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.CheckResult, int) error); ok {
+		r0 = rf(ctx, urlID, result, failureThreshold)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveURL provides a mock function with given fields: ctx, urlData
 func (_m *UrlRepo) SaveURL(ctx context.Context, urlData domain.URLData) error {
 	ret := _m.Called(ctx, urlData)
@@ -88,6 +442,60 @@ func (_m *UrlRepo) SaveURL(ctx context.Context, urlData domain.URLData) error {
 	return r0
 }
 
+// SetURLMetadata provides a mock function with given fields: ctx, urlID, metadata
+func (_m *UrlRepo) SetURLMetadata(ctx context.Context, urlID int64, metadata domain.URLMetadata) error {
+	ret := _m.Called(ctx, urlID, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetURLMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, domain.URLMetadata) error); ok {
+		r0 = rf(ctx, urlID, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetURLPreview provides a mock function with given fields: ctx, urlDomain, shortUrl, preview
+func (_m *UrlRepo) SetURLPreview(ctx context.Context, urlDomain string, shortUrl string, preview domain.URLPreview) error {
+	ret := _m.Called(ctx, urlDomain, shortUrl, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetURLPreview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.URLPreview) error); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, preview)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteURL provides a mock function with given fields: ctx, urlID, deletedAt
+func (_m *UrlRepo) SoftDeleteURL(ctx context.Context, urlID int64, deletedAt time.Time) error {
+	ret := _m.Called(ctx, urlID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteURL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, urlID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewUrlRepo creates a new instance of UrlRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUrlRepo(t interface {
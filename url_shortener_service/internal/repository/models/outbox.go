@@ -0,0 +1,9 @@
+package models
+
+// OutboxEvent is an event saved in the outbox and not published yet
+type OutboxEvent struct {
+	ID    int64
+	Event URLEvent
+	// SentSinks are the sinks that already got the event
+	SentSinks []string
+}
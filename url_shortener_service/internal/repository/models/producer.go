@@ -0,0 +1,12 @@
+package models
+
+// ProducerStats are the counters of the asynchronous events producer
+type ProducerStats struct {
+	QueueDepth int   `json:"queue_depth"`
+	QueueSize  int   `json:"queue_size"`
+	Sent       int64 `json:"sent"`
+	Dropped    int64 `json:"dropped"`
+	Failed     int64 `json:"failed"`
+	// Spooled counts the failed events handed to the fallback instead of being lost
+	Spooled int64 `json:"spooled"`
+}
@@ -1,13 +1,55 @@
 package models
 
+import "encoding/json"
+
 const (
-	EventTypeCreate = 1
-	EventTypeFollow = 2
+	EventTypeCreate  = 1
+	EventTypeFollow  = 2
+	EventTypePreview = 3
 )
 
 type URLEvent struct {
-	LongURL   string `json:"long_url"`
-	ShortURL  string `json:"short_url"`
-	EventTime int64  `json:"event_time"`
-	EventType int8   `json:"event_type"`
+	EventID  string `json:"event_id"`
+	LongURL  string `json:"long_url"`
+	ShortURL string `json:"short_url"`
+	// Domain is the key of the domain the short url is on, empty for the default one
+	Domain string `json:"domain"`
+	// EventTime is unix time in milliseconds
+	EventTime  int64    `json:"event_time_ms"`
+	EventType  int8     `json:"event_type"`
+	Tags       []string `json:"tags"`
+	CampaignID string   `json:"campaign_id"`
+	// The request context is set for follow and preview events
+	Referrer       string `json:"referrer"`
+	UserAgent      string `json:"user_agent"`
+	IP             string `json:"ip"`
+	AcceptLanguage string `json:"accept_language"`
+	Host           string `json:"host"`
+	DeviceType     string `json:"device_type"`
+	OSFamily       string `json:"os_family"`
+	BrowserFamily  string `json:"browser_family"`
+	Bot            bool   `json:"is_bot"`
+	BotScore       int    `json:"bot_score"`
+}
+
+// UnmarshalJSON also reads the events saved to the outbox and the spool before
+// the time was kept in milliseconds, they have event_time in seconds
+func (e *URLEvent) UnmarshalJSON(data []byte) error {
+	type urlEvent URLEvent
+	var event struct {
+		urlEvent
+		EventTimeSeconds *int64 `json:"event_time"`
+	}
+
+	err := json.Unmarshal(data, &event)
+	if err != nil {
+		return err
+	}
+
+	*e = URLEvent(event.urlEvent)
+	if e.EventTime == 0 && event.EventTimeSeconds != nil {
+		e.EventTime = *event.EventTimeSeconds * 1000
+	}
+
+	return nil
 }
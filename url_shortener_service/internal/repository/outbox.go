@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository/models"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name OutboxRepo
+type OutboxRepo interface {
+	SaveOutboxEvent(ctx context.Context, event models.URLEvent) error
+	// ListPendingOutboxEvents returns the oldest unsent events. Within a transaction they stay locked
+	// until it ends and a concurrent relay skips them
+	ListPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkOutboxEventsSent(ctx context.Context, ids []int64) error
+	// MarkOutboxEventSinksSent records the sinks that got an event still pending for the others
+	MarkOutboxEventSinksSent(ctx context.Context, id int64, sinks []string) error
+	DeleteSentOutboxEvents(ctx context.Context, sentBefore time.Time) (int, error)
+	// DeleteURLOutboxEvents removes sent and pending events of the links, a link is its short url on its domain
+	DeleteURLOutboxEvents(ctx context.Context, erasedURLs []domain.ErasedURL) (int, error)
+}
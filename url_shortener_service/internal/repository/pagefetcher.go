@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name PageFetcher
+type PageFetcher interface {
+	FetchMetadata(ctx context.Context, pageURL string) (domain.URLMetadata, error)
+}
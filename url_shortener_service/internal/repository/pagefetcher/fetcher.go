@@ -0,0 +1,217 @@
+package pagefetcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 500
+
+	defaultFaviconPath = "/favicon.ico"
+	userAgent          = "CoolUrlShortener/1.0 (+link metadata)"
+)
+
+type httpPageFetcher struct {
+	client      *http.Client
+	maxBodySize int64
+}
+
+// NewHTTPPageFetcher reads at most maxBodySize bytes of a page,
+// timeouts and redirects are up to the client
+func NewHTTPPageFetcher(client *http.Client, maxBodySize int64) repository.PageFetcher {
+	return &httpPageFetcher{
+		client:      client,
+		maxBodySize: maxBodySize,
+	}
+}
+
+func (f *httpPageFetcher) FetchMetadata(ctx context.Context, pageURL string) (domain.URLMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return domain.URLMetadata{}, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.1")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return domain.URLMetadata{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return domain.URLMetadata{}, fmt.Errorf("fetch %s: unexpected status %d", pageURL, resp.StatusCode)
+	}
+
+	// The request of the response is the last one made, so its url is where the redirects ended
+	finalURL := resp.Request.URL
+	metadata := domain.URLMetadata{
+		FinalURL:  finalURL.String(),
+		FetchedAt: time.Now(),
+	}
+
+	var head pageHead
+	if isHTML(resp.Header.Get("Content-Type")) {
+		head = parseHead(io.LimitReader(resp.Body, f.maxBodySize))
+	}
+
+	metadata.Title = truncate(firstNonEmpty(head.title, head.ogTitle), maxTitleLength)
+	metadata.Description = truncate(firstNonEmpty(head.description, head.ogDescription), maxDescriptionLength)
+	metadata.FaviconURL = resolveFavicon(finalURL, head.icon)
+
+	return metadata, nil
+}
+
+type pageHead struct {
+	title         string
+	ogTitle       string
+	description   string
+	ogDescription string
+	icon          string
+}
+
+// parseHead stops at the body, everything it looks for lives in the head.
+// A page cut by the size limit gives whatever was read before the cut
+func parseHead(r io.Reader) pageHead {
+	var head pageHead
+	var title strings.Builder
+	inTitle := false
+
+	tokenizer := html.NewTokenizer(r)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			head.title = collapseSpaces(title.String())
+			return head
+		case html.TextToken:
+			if inTitle {
+				title.Write(tokenizer.Text())
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				head.title = collapseSpaces(title.String())
+				return head
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := tokenizer.Token()
+			switch token.DataAtom {
+			case atom.Title:
+				inTitle = title.Len() == 0
+			case atom.Meta:
+				head.readMeta(token)
+			case atom.Link:
+				head.readLink(token)
+			case atom.Body:
+				head.title = collapseSpaces(title.String())
+				return head
+			}
+		}
+	}
+}
+
+func (h *pageHead) readMeta(token html.Token) {
+	name := strings.ToLower(attr(token, "name"))
+	property := strings.ToLower(attr(token, "property"))
+	content := collapseSpaces(attr(token, "content"))
+
+	switch {
+	case name == "description" && h.description == "":
+		h.description = content
+	case property == "og:description" && h.ogDescription == "":
+		h.ogDescription = content
+	case property == "og:title" && h.ogTitle == "":
+		h.ogTitle = content
+	}
+}
+
+func (h *pageHead) readLink(token html.Token) {
+	if h.icon != "" {
+		return
+	}
+
+	for _, rel := range strings.Fields(strings.ToLower(attr(token, "rel"))) {
+		if rel == "icon" {
+			h.icon = strings.TrimSpace(attr(token, "href"))
+			return
+		}
+	}
+}
+
+func attr(token html.Token, key string) string {
+	for _, a := range token.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
+
+// resolveFavicon falls back to /favicon.ico of the final host, icons that are not http or https
+// are dropped as well, the url ends up in an img tag of the frontend
+func resolveFavicon(pageURL *url.URL, href string) string {
+	if href == "" {
+		href = defaultFaviconPath
+	}
+
+	iconURL, err := pageURL.Parse(href)
+	if err != nil || (iconURL.Scheme != "http" && iconURL.Scheme != "https") {
+		iconURL, _ = pageURL.Parse(defaultFaviconPath)
+	}
+
+	return iconURL.String()
+}
+
+// isHTML treats a missing content type as html, many small sites do not send it
+func isHTML(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "text/html" || mediaType == "application/xhtml+xml"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func truncate(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+
+	return string(runes[:maxLength])
+}
@@ -0,0 +1,165 @@
+package pagefetcher
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>
+    Cool   &amp; Short
+  </title>
+  <meta name="description" content="Page description">
+  <meta property="og:title" content="Open Graph title">
+  <meta property="og:description" content="Open Graph description">
+  <link rel="apple-touch-icon" href="/apple.png">
+  <link rel="Shortcut Icon" href="/static/icon.png">
+</head>
+<body><title>Not a title</title></body>
+</html>`
+
+func TestFetchMetadata(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(testPage))
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/page", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/og", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head>
+<meta property="og:title" content="Open Graph title">
+<meta property="og:description" content="Open Graph description">
+<link rel="icon" href="javascript:alert(1)">
+</head></html>`))
+	})
+	mux.HandleFunc("/file.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("<title>pdf</title>"))
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	fetcher := NewHTTPPageFetcher(server.Client(), 1<<20)
+
+	testCases := []struct {
+		name            string
+		path            string
+		wantTitle       string
+		wantDescription string
+		wantFavicon     string
+		wantFinalURL    string
+		wantErr         bool
+	}{
+		{
+			name:            "html page",
+			path:            "/page",
+			wantTitle:       "Cool & Short",
+			wantDescription: "Page description",
+			wantFavicon:     server.URL + "/static/icon.png",
+			wantFinalURL:    server.URL + "/page",
+		},
+		{
+			name:            "follows redirects",
+			path:            "/redirect",
+			wantTitle:       "Cool & Short",
+			wantDescription: "Page description",
+			wantFavicon:     server.URL + "/static/icon.png",
+			wantFinalURL:    server.URL + "/page",
+		},
+		{
+			name:            "open graph fallback and unsafe icon",
+			path:            "/og",
+			wantTitle:       "Open Graph title",
+			wantDescription: "Open Graph description",
+			wantFavicon:     server.URL + "/favicon.ico",
+			wantFinalURL:    server.URL + "/og",
+		},
+		{
+			name:         "not html",
+			path:         "/file.pdf",
+			wantFavicon:  server.URL + "/favicon.ico",
+			wantFinalURL: server.URL + "/file.pdf",
+		},
+		{
+			name:    "error status",
+			path:    "/missing",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, err := fetcher.FetchMetadata(context.Background(), server.URL+tc.path)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantTitle, metadata.Title)
+			assert.Equal(t, tc.wantDescription, metadata.Description)
+			assert.Equal(t, tc.wantFavicon, metadata.FaviconURL)
+			assert.Equal(t, tc.wantFinalURL, metadata.FinalURL)
+			assert.False(t, metadata.FetchedAt.IsZero())
+		})
+	}
+}
+
+func TestFetchMetadataBodyLimit(t *testing.T) {
+	padding := "<!--" + strings.Repeat("x", 4096) + "-->"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><head>" + padding + "<title>Too far</title></head></html>"))
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPPageFetcher(server.Client(), 1024)
+
+	metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+	require.NoError(t, err)
+	assert.Empty(t, metadata.Title)
+	assert.Equal(t, server.URL+"/favicon.ico", metadata.FaviconURL)
+}
+
+func TestFetchMetadataTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	fetcher := NewHTTPPageFetcher(client, 1<<20)
+
+	_, err := fetcher.FetchMetadata(context.Background(), server.URL)
+	assert.Error(t, err)
+}
+
+func TestPublicHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<title>internal</title>"))
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPPageFetcher(NewPublicHTTPClient(time.Second), 1<<20)
+
+	_, err := fetcher.FetchMetadata(context.Background(), server.URL)
+	assert.True(t, errors.Is(err, errForbiddenAddress))
+}
@@ -0,0 +1,153 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type auditRepoPostgres struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewAuditRepoPostgres(dbPool *pgxpool.Pool) repository.AuditRepo {
+	return &auditRepoPostgres{
+		dbPool: dbPool,
+	}
+}
+
+const saveAuditRecordQuery = `INSERT INTO url_audit_log (url_id, domain, short_url, action, actor, source_ip, old_value, new_value)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+func (r *auditRepoPostgres) SaveAuditRecord(ctx context.Context, record domain.AuditRecord) error {
+	oldValue, err := marshalAuditValue(record.OldValue)
+	if err != nil {
+		return err
+	}
+	newValue, err := marshalAuditValue(record.NewValue)
+	if err != nil {
+		return err
+	}
+
+	_, err = connFromContext(ctx, r.dbPool).Exec(
+		ctx, saveAuditRecordQuery,
+		record.URLID, record.Domain, record.ShortURL, record.Action,
+		record.Actor.Name, record.Actor.IP, oldValue, newValue,
+	)
+
+	return err
+}
+
+const listAuditRecordsQuery = `SELECT id, url_id, domain, short_url, action, actor, source_ip, old_value, new_value, created_at
+FROM url_audit_log
+WHERE url_id = $1
+ORDER BY id`
+
+func (r *auditRepoPostgres) ListAuditRecords(ctx context.Context, urlID int64) ([]domain.AuditRecord, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listAuditRecordsQuery, urlID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]domain.AuditRecord, 0)
+	for rows.Next() {
+		var record domain.AuditRecord
+		var oldValue, newValue []byte
+		err = rows.Scan(
+			&record.ID,
+			&record.URLID,
+			&record.Domain,
+			&record.ShortURL,
+			&record.Action,
+			&record.Actor.Name,
+			&record.Actor.IP,
+			&oldValue,
+			&newValue,
+			&record.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		record.OldValue, err = unmarshalAuditValue(oldValue)
+		if err != nil {
+			return nil, err
+		}
+		record.NewValue, err = unmarshalAuditValue(newValue)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+// Restored links are not counted, whoever restores a link does not own it
+const listURLsCreatedByQuery = `SELECT DISTINCT url_id, domain, short_url
+FROM url_audit_log
+WHERE action = 'create'
+  AND actor = $1
+ORDER BY url_id`
+
+func (r *auditRepoPostgres) ListURLsCreatedBy(ctx context.Context, actor string) ([]domain.ErasedURL, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listURLsCreatedByQuery, actor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := make([]domain.ErasedURL, 0)
+	for rows.Next() {
+		var erasedURL domain.ErasedURL
+		err = rows.Scan(&erasedURL.ID, &erasedURL.Domain, &erasedURL.ShortURL)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, erasedURL)
+	}
+
+	return urls, rows.Err()
+}
+
+// redactAuditRecordsQuery skips already redacted records, so they are not counted twice
+const redactAuditRecordsQuery = `UPDATE url_audit_log
+SET actor     = '',
+    source_ip = '',
+    old_value = CASE WHEN url_id = ANY ($1) THEN NULL ELSE old_value END,
+    new_value = CASE WHEN url_id = ANY ($1) THEN NULL ELSE new_value END
+WHERE (url_id = ANY ($1) AND (actor <> '' OR source_ip <> '' OR old_value IS NOT NULL OR new_value IS NOT NULL))
+   OR ($2 <> '' AND actor = $2)`
+
+func (r *auditRepoPostgres) RedactAuditRecords(ctx context.Context, urlIDs []int64, actor string) (int, error) {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, redactAuditRecordsQuery, urlIDs, actor)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// marshalAuditValue keeps a missing value as NULL instead of a json null
+func marshalAuditValue(value map[string]any) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(value)
+}
+
+func unmarshalAuditValue(raw []byte) (map[string]any, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	var value map[string]any
+	err := json.Unmarshal(raw, &value)
+	return value, err
+}
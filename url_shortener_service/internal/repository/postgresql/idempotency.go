@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type idempotencyRepoPostgres struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewIdempotencyRepoPostgres(dbPool *pgxpool.Pool) repository.IdempotencyRepo {
+	return &idempotencyRepoPostgres{
+		dbPool: dbPool,
+	}
+}
+
+// An insert conflicting with an uncommitted claim waits for that transaction,
+// so concurrent duplicates are serialized by the database
+const claimIdempotencyKeyQuery = `INSERT INTO idempotency_keys (key, fingerprint)
+VALUES ($1, $2)
+ON CONFLICT (key) DO UPDATE
+    SET fingerprint = EXCLUDED.fingerprint,
+        response    = '',
+        created_at  = now()
+    WHERE idempotency_keys.created_at < $3
+RETURNING key`
+
+const getIdempotencyRecordQuery = `SELECT key, fingerprint, response
+FROM idempotency_keys
+WHERE key = $1`
+
+func (r *idempotencyRepoPostgres) ClaimIdempotencyKey(
+	ctx context.Context,
+	key string,
+	fingerprint string,
+	expiredBefore time.Time,
+) (domain.IdempotencyRecord, bool, error) {
+	conn := connFromContext(ctx, r.dbPool)
+
+	var claimedKey string
+	err := conn.QueryRow(ctx, claimIdempotencyKeyQuery, key, fingerprint, expiredBefore).Scan(&claimedKey)
+	if err == nil {
+		return domain.IdempotencyRecord{}, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return domain.IdempotencyRecord{}, false, err
+	}
+
+	var record domain.IdempotencyRecord
+	err = conn.QueryRow(ctx, getIdempotencyRecordQuery, key).Scan(&record.Key, &record.Fingerprint, &record.Response)
+	if err != nil {
+		return domain.IdempotencyRecord{}, false, err
+	}
+
+	return record, false, nil
+}
+
+const saveIdempotentResponseQuery = `UPDATE idempotency_keys SET response = $2 WHERE key = $1`
+
+func (r *idempotencyRepoPostgres) SaveIdempotentResponse(ctx context.Context, key string, response string) error {
+	_, err := connFromContext(ctx, r.dbPool).Exec(ctx, saveIdempotentResponseQuery, key, response)
+	return err
+}
+
+const deleteExpiredIdempotencyKeysQuery = `DELETE FROM idempotency_keys WHERE created_at < $1`
+
+func (r *idempotencyRepoPostgres) DeleteExpiredIdempotencyKeys(ctx context.Context, expiredBefore time.Time) (int, error) {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, deleteExpiredIdempotencyKeysQuery, expiredBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
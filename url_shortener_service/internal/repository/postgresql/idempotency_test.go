@@ -0,0 +1,111 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimIdempotencyKey(t *testing.T) {
+	dbPool := newTestDBPool(t)
+	idempotencyRepo := NewIdempotencyRepoPostgres(dbPool)
+	transactor := NewTransactor(dbPool)
+	ctx := context.Background()
+	notExpired := time.Now().Add(-time.Hour)
+
+	t.Run("Key is claimed by an open transaction. A duplicate waits and gets its response", func(t *testing.T) {
+		claimed := make(chan struct{})
+		release := make(chan struct{})
+		firstDone := make(chan error)
+		go func() {
+			firstDone <- transactor.WithinTx(ctx, func(ctx context.Context) error {
+				_, ok, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "concurrent", "fingerprint", notExpired)
+				if err != nil {
+					return err
+				}
+				assert.True(t, ok)
+				close(claimed)
+
+				<-release
+				return idempotencyRepo.SaveIdempotentResponse(ctx, "concurrent", "short")
+			})
+		}()
+		<-claimed
+
+		type claimResult struct {
+			record  domain.IdempotencyRecord
+			claimed bool
+			err     error
+		}
+		duplicateDone := make(chan claimResult)
+		go func() {
+			var result claimResult
+			result.err = transactor.WithinTx(ctx, func(ctx context.Context) error {
+				var err error
+				result.record, result.claimed, err = idempotencyRepo.ClaimIdempotencyKey(ctx, "concurrent", "fingerprint", notExpired)
+				return err
+			})
+			duplicateDone <- result
+		}()
+
+		select {
+		case <-duplicateDone:
+			t.Fatal("duplicate did not wait for the open claim")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		close(release)
+		require.NoError(t, <-firstDone)
+		result := <-duplicateDone
+		require.NoError(t, result.err)
+		assert.False(t, result.claimed)
+		assert.Equal(t, domain.IdempotencyRecord{Key: "concurrent", Fingerprint: "fingerprint", Response: "short"}, result.record)
+	})
+
+	t.Run("Claim is rolled back. The key is claimed again", func(t *testing.T) {
+		err := transactor.WithinTx(ctx, func(ctx context.Context) error {
+			_, ok, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "rolled-back", "fingerprint", notExpired)
+			require.NoError(t, err)
+			assert.True(t, ok)
+			return assert.AnError
+		})
+		assert.Equal(t, assert.AnError, err)
+
+		err = transactor.WithinTx(ctx, func(ctx context.Context) error {
+			_, ok, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "rolled-back", "fingerprint", notExpired)
+			assert.True(t, ok)
+			return err
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Key is expired. It is taken over", func(t *testing.T) {
+		err := transactor.WithinTx(ctx, func(ctx context.Context) error {
+			_, _, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "expired", "old", notExpired)
+			if err != nil {
+				return err
+			}
+			return idempotencyRepo.SaveIdempotentResponse(ctx, "expired", "old-short")
+		})
+		require.NoError(t, err)
+
+		err = transactor.WithinTx(ctx, func(ctx context.Context) error {
+			_, ok, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "expired", "new", time.Now().Add(time.Minute))
+			assert.True(t, ok)
+			return err
+		})
+		require.NoError(t, err)
+
+		err = transactor.WithinTx(ctx, func(ctx context.Context) error {
+			record, ok, err := idempotencyRepo.ClaimIdempotencyKey(ctx, "expired", "new", notExpired)
+			assert.False(t, ok)
+			assert.Equal(t, domain.IdempotencyRecord{Key: "expired", Fingerprint: "new"}, record)
+			return err
+		})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,114 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type outboxRepoPostgres struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewOutboxRepoPostgres(dbPool *pgxpool.Pool) repository.OutboxRepo {
+	return &outboxRepoPostgres{
+		dbPool: dbPool,
+	}
+}
+
+const saveOutboxEventQuery = `INSERT INTO events_outbox (payload) VALUES ($1)`
+
+func (r *outboxRepoPostgres) SaveOutboxEvent(ctx context.Context, event models.URLEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = connFromContext(ctx, r.dbPool).Exec(ctx, saveOutboxEventQuery, payload)
+	return err
+}
+
+const listPendingOutboxEventsQuery = `SELECT id, payload, sent_sinks
+FROM events_outbox
+WHERE sent_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE SKIP LOCKED`
+
+func (r *outboxRepoPostgres) ListPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listPendingOutboxEventsQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]models.OutboxEvent, 0, limit)
+	for rows.Next() {
+		var event models.OutboxEvent
+		var payload []byte
+		err = rows.Scan(&event.ID, &payload, &event.SentSinks)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(payload, &event.Event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+const markOutboxEventsSentQuery = `UPDATE events_outbox SET sent_at = now() WHERE id = ANY($1)`
+
+func (r *outboxRepoPostgres) MarkOutboxEventsSent(ctx context.Context, ids []int64) error {
+	_, err := connFromContext(ctx, r.dbPool).Exec(ctx, markOutboxEventsSentQuery, ids)
+	return err
+}
+
+const markOutboxEventSinksSentQuery = `UPDATE events_outbox SET sent_sinks = $2 WHERE id = $1`
+
+func (r *outboxRepoPostgres) MarkOutboxEventSinksSent(ctx context.Context, id int64, sinks []string) error {
+	_, err := connFromContext(ctx, r.dbPool).Exec(ctx, markOutboxEventSinksSentQuery, id, sinks)
+	return err
+}
+
+const deleteSentOutboxEventsQuery = `DELETE FROM events_outbox WHERE sent_at < $1`
+
+func (r *outboxRepoPostgres) DeleteSentOutboxEvents(ctx context.Context, sentBefore time.Time) (int, error) {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, deleteSentOutboxEventsQuery, sentBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// deleteURLOutboxEventsQuery matches events without a domain to the default one, they were saved before the field
+const deleteURLOutboxEventsQuery = `DELETE FROM events_outbox
+USING unnest($1::text[], $2::text[]) AS erased (domain, short_url)
+WHERE events_outbox.payload ->> 'short_url' = erased.short_url
+  AND coalesce(events_outbox.payload ->> 'domain', '') = erased.domain`
+
+func (r *outboxRepoPostgres) DeleteURLOutboxEvents(ctx context.Context, erasedURLs []domain.ErasedURL) (int, error) {
+	domains := make([]string, len(erasedURLs))
+	shortURLs := make([]string, len(erasedURLs))
+	for i, erasedURL := range erasedURLs {
+		domains[i] = erasedURL.Domain
+		shortURLs[i] = erasedURL.ShortURL
+	}
+
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, deleteURLOutboxEventsQuery, domains, shortURLs)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,46 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteURLOutboxEvents(t *testing.T) {
+	dbPool := newTestDBPool(t)
+	outboxRepo := NewOutboxRepoPostgres(dbPool)
+	ctx := context.Background()
+
+	events := []models.URLEvent{
+		{ShortURL: "short", EventType: 1},
+		{ShortURL: "short", Domain: "go.brand.com", EventType: 1},
+		{ShortURL: "short", Domain: "go.brand.com", EventType: 2},
+		{ShortURL: "other", Domain: "go.brand.com", EventType: 1},
+	}
+	for _, event := range events {
+		require.NoError(t, outboxRepo.SaveOutboxEvent(ctx, event))
+	}
+	// Events saved before the domain field are on the default domain
+	_, err := dbPool.Exec(ctx, `INSERT INTO events_outbox (payload) VALUES ('{"short_url":"short","event_type":2}')`)
+	require.NoError(t, err)
+
+	deleted, err := outboxRepo.DeleteURLOutboxEvents(ctx, []domain.ErasedURL{{Domain: "go.brand.com", ShortURL: "short"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	pending, err := outboxRepo.ListPendingOutboxEvents(ctx, 10)
+	require.NoError(t, err)
+	left := make([]string, len(pending))
+	for i, event := range pending {
+		left[i] = event.Event.Domain + "/" + event.Event.ShortURL
+	}
+	assert.Equal(t, []string{"/short", "go.brand.com/other", "/short"}, left)
+
+	deleted, err = outboxRepo.DeleteURLOutboxEvents(ctx, []domain.ErasedURL{{ShortURL: "short"}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+}
@@ -0,0 +1,60 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDBPool connects to TEST_POSTGRES_DSN and migrates a schema of its own that is dropped after the test.
+// The tests that need a database are skipped without one
+func newTestDBPool(t *testing.T) *pgxpool.Pool {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+	ctx := context.Background()
+
+	adminPool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+	t.Cleanup(adminPool.Close)
+
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	_, err = adminPool.Exec(ctx, "CREATE SCHEMA "+schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := adminPool.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE")
+		require.NoError(t, err)
+	})
+
+	// Extensions may already live in public
+	config, err := pgxpool.ParseConfig(dsn)
+	require.NoError(t, err)
+	config.ConnConfig.RuntimeParams["search_path"] = schema + ", public"
+
+	dbPool, err := pgxpool.NewWithConfig(ctx, config)
+	require.NoError(t, err)
+	t.Cleanup(dbPool.Close)
+
+	migrations, err := filepath.Glob("../../../migrations/postgresql/*.up.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	sort.Strings(migrations)
+
+	for _, migration := range migrations {
+		content, err := os.ReadFile(migration)
+		require.NoError(t, err)
+
+		_, err = dbPool.Exec(ctx, string(content))
+		require.NoError(t, err, migration)
+	}
+
+	return dbPool
+}
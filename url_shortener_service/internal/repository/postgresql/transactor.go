@@ -0,0 +1,61 @@
+package postgresql
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type txKey struct{}
+
+// querier is implemented by both the pool and a transaction. Begin on a transaction starts a savepoint,
+// repository methods use it to roll back their own statements. WithinTx does not, see its comment
+type querier interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type pgTransactor struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewTransactor(dbPool *pgxpool.Pool) repository.Transactor {
+	return &pgTransactor{
+		dbPool: dbPool,
+	}
+}
+
+// WithinTx runs fn in a transaction. A nested call joins the outer transaction without a savepoint,
+// so it can not be rolled back on its own: its statements are committed or rolled back with the outer transaction
+func (t *pgTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// connFromContext returns the transaction started by WithinTx, or the pool outside of one
+func connFromContext(ctx context.Context, dbPool *pgxpool.Pool) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return dbPool
+}
@@ -3,48 +3,167 @@ package postgresql
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"CoolUrlShortener/internal/domain"
 	"CoolUrlShortener/internal/errs"
 	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/pkg/shortener"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	uniqueViolationCode = "23505"
+)
+
 type urlRepoPostgres struct {
-	dbPool *pgxpool.Pool
+	dbPool         *pgxpool.Pool
+	codeNormalizer shortener.CodeNormalizer
 }
 
 func NewUrlRepoPostgres(
 	dbPool *pgxpool.Pool,
+	codeNormalizer shortener.CodeNormalizer,
 ) repository.UrlRepo {
 	return &urlRepoPostgres{
-		dbPool: dbPool,
+		dbPool:         dbPool,
+		codeNormalizer: codeNormalizer,
 	}
 }
 
-const getLongURLQuery = `SELECT long_url FROM url_data WHERE short_url = $1`
+const selectURLDataQuery = `SELECT d.id, d.domain, d.short_url, d.long_url, d.backup_url, d.created_at, d.deleted_at,
+       COALESCE(h.flagged, false),
+       COALESCE(c.campaign_id, ''),
+       COALESCE(array_agg(t.tag ORDER BY t.tag) FILTER (WHERE t.tag IS NOT NULL), '{}'),
+       lp.title,
+       pv.title,
+       pv.description,
+       pv.image_url
+FROM url_data d
+         LEFT JOIN url_campaigns c ON c.url_id = d.id
+         LEFT JOIN url_tags t ON t.url_id = d.id
+         LEFT JOIN landing_pages lp ON lp.url_id = d.id
+         LEFT JOIN url_previews pv ON pv.url_id = d.id
+         LEFT JOIN url_health h ON h.url_id = d.id
+`
+
+const getURLDataQuery = selectURLDataQuery + `WHERE d.domain = $1 AND d.short_url = $2 AND d.deleted_at IS NULL
+GROUP BY d.id, c.campaign_id, lp.title, pv.url_id, h.url_id`
+
+func (r *urlRepoPostgres) GetURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	return r.getURLData(ctx, getURLDataQuery, urlDomain, shortUrl)
+}
+
+const listURLDataQuery = selectURLDataQuery + `JOIN unnest($1::text[], $2::text[]) AS k (domain, short_url)
+              ON k.domain = d.domain AND k.short_url = d.short_url
+WHERE d.deleted_at IS NULL
+GROUP BY d.id, c.campaign_id, lp.title, pv.url_id, h.url_id`
+
+func (r *urlRepoPostgres) ListURLData(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error) {
+	domains := make([]string, len(keys))
+	shortURLs := make([]string, len(keys))
+	for i, key := range keys {
+		domains[i] = key.Domain
+		shortURLs[i] = r.codeNormalizer.NormalizeCode(key.ShortURL)
+	}
+
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listURLDataQuery, domains, shortURLs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-func (r *urlRepoPostgres) GetLongURL(ctx context.Context, shortUrl string) (string, error) {
-	var longURL string
-	row := r.dbPool.QueryRow(ctx, getLongURLQuery, shortUrl)
+	urls := make([]domain.URLData, 0, len(keys))
+	for rows.Next() {
+		urlData, err := scanURLData(rows)
+		if err != nil {
+			return nil, err
+		}
 
-	err := row.Scan(&longURL)
+		urls = append(urls, urlData)
+	}
+
+	return urls, rows.Err()
+}
+
+const getDeletedURLDataQuery = selectURLDataQuery + `WHERE d.domain = $1 AND d.short_url = $2 AND d.deleted_at IS NOT NULL
+GROUP BY d.id, c.campaign_id, lp.title, pv.url_id, h.url_id`
+
+// GetDeletedURLData finds a deleted link that is not purged yet
+func (r *urlRepoPostgres) GetDeletedURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	return r.getURLData(ctx, getDeletedURLDataQuery, urlDomain, shortUrl)
+}
+
+func (r *urlRepoPostgres) getURLData(ctx context.Context, query string, urlDomain string, shortUrl string) (domain.URLData, error) {
+	row := connFromContext(ctx, r.dbPool).QueryRow(ctx, query, urlDomain, r.codeNormalizer.NormalizeCode(shortUrl))
+
+	urlData, err := scanURLData(row)
 	if errors.Is(err, pgx.ErrNoRows) {
-		return "", errs.ErrNoURL
+		return domain.URLData{}, errs.ErrNoURL
+	}
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	if urlData.Landing != nil {
+		urlData.Landing.Items, err = r.getLandingItems(ctx, urlData.ID)
+		if err != nil {
+			return domain.URLData{}, err
+		}
 	}
 
-	return longURL, err
+	return urlData, nil
 }
 
-const saveURLQuery = `INSERT INTO url_data (id, short_url, long_url, created_at) 
-VALUES ($1, $2, $3, $4)`
+const getLandingItemsQuery = `SELECT title, url FROM landing_items WHERE url_id = $1 ORDER BY position`
+
+func (r *urlRepoPostgres) getLandingItems(ctx context.Context, urlID int64) ([]domain.LandingItem, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, getLandingItemsQuery, urlID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := make([]domain.LandingItem, 0)
+	for rows.Next() {
+		var item domain.LandingItem
+		err = rows.Scan(&item.Title, &item.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
 
-const getShortURLByLongURL = `SELECT short_url FROM url_data WHERE long_url = $1`
+const saveURLQuery = `INSERT INTO url_data (id, domain, short_url, long_url, backup_url, created_at) 
+VALUES ($1, $2, $3, $4, $5, $6)`
 
-func (r *urlRepoPostgres) GetShortURLByLongURL(ctx context.Context, longURL string) (string, error) {
+const saveTagQuery = `INSERT INTO url_tags (url_id, tag) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+const saveCampaignQuery = `INSERT INTO url_campaigns (url_id, campaign_id) VALUES ($1, $2)`
+
+const saveLandingPageQuery = `INSERT INTO landing_pages (url_id, title) VALUES ($1, $2)`
+
+const saveLandingItemQuery = `INSERT INTO landing_items (url_id, position, title, url) VALUES ($1, $2, $3, $4)`
+
+// getShortURLByLongURL skips labeled links and links with a backup url,
+// so they are not shared between campaigns or owners of a backup
+const getShortURLByLongURL = `SELECT short_url FROM url_data d WHERE domain = $1 AND long_url = $2 AND backup_url = ''
+AND NOT EXISTS (SELECT 1 FROM url_tags t WHERE t.url_id = d.id)
+AND NOT EXISTS (SELECT 1 FROM url_campaigns c WHERE c.url_id = d.id)
+AND NOT EXISTS (SELECT 1 FROM landing_pages lp WHERE lp.url_id = d.id)
+AND deleted_at IS NULL`
+
+func (r *urlRepoPostgres) GetShortURLByLongURL(ctx context.Context, urlDomain string, longURL string) (string, error) {
 	var shortURL string
-	row := r.dbPool.QueryRow(ctx, getShortURLByLongURL, longURL)
+	row := connFromContext(ctx, r.dbPool).QueryRow(ctx, getShortURLByLongURL, urlDomain, longURL)
 
 	err := row.Scan(&shortURL)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -55,6 +174,446 @@ func (r *urlRepoPostgres) GetShortURLByLongURL(ctx context.Context, longURL stri
 }
 
 func (r *urlRepoPostgres) SaveURL(ctx context.Context, urlData domain.URLData) error {
-	_, err := r.dbPool.Exec(ctx, saveURLQuery, urlData.ID, urlData.ShortUrl, urlData.LongUrl, urlData.CreatedAt)
+	tx, err := connFromContext(ctx, r.dbPool).Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	shortURL := r.codeNormalizer.NormalizeCode(urlData.ShortUrl)
+	_, err = tx.Exec(
+		ctx, saveURLQuery,
+		urlData.ID, urlData.Domain, shortURL, urlData.LongUrl, urlData.BackupURL, urlData.CreatedAt,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return errs.ErrAliasTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range urlData.Labels.Tags {
+		_, err = tx.Exec(ctx, saveTagQuery, urlData.ID, tag)
+		if err != nil {
+			return err
+		}
+	}
+
+	if urlData.Labels.CampaignID != "" {
+		_, err = tx.Exec(ctx, saveCampaignQuery, urlData.ID, urlData.Labels.CampaignID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if urlData.Landing != nil {
+		_, err = tx.Exec(ctx, saveLandingPageQuery, urlData.ID, urlData.Landing.Title)
+		if err != nil {
+			return err
+		}
+
+		for i, item := range urlData.Landing.Items {
+			_, err = tx.Exec(ctx, saveLandingItemQuery, urlData.ID, i, item.Title, item.URL)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// listURLsQueryTemplate is completed with the cursor comparison and the sort direction,
+// both of them have to follow the same order to page through the index. $8 is the start of the last day
+// the links were created on, so the whole day is included
+const listURLsQueryTemplate = selectURLDataQuery + `WHERE d.domain = $1 AND d.deleted_at IS NULL
+  AND ($2::text = '' OR EXISTS (SELECT 1 FROM url_tags ft WHERE ft.url_id = d.id AND ft.tag = $2))
+  AND ($3::text = '' OR c.campaign_id = $3)
+  AND ($4::text = '' OR d.long_url_host = $4 OR d.long_url_host LIKE '%%.' || $5)
+  AND ($6::text = '' OR d.long_url ILIKE '%%' || $6 || '%%')
+  AND ($7::timestamptz IS NULL OR d.created_at >= $7)
+  AND ($8::timestamptz IS NULL OR d.created_at < $8::timestamptz + interval '1 day')
+  AND ($9::timestamptz IS NULL OR (d.created_at, d.id) %[1]s ($9::timestamptz, $10::bigint))
+GROUP BY d.id, c.campaign_id, lp.title, pv.url_id, h.url_id
+ORDER BY d.created_at %[2]s, d.id %[2]s
+LIMIT $11 OFFSET $12`
+
+var (
+	listURLsNewestFirstQuery = fmt.Sprintf(listURLsQueryTemplate, "<", "DESC")
+	listURLsOldestFirstQuery = fmt.Sprintf(listURLsQueryTemplate, ">", "ASC")
+)
+
+func (r *urlRepoPostgres) ListURLs(ctx context.Context, params domain.ListURLsParams) ([]domain.URLData, error) {
+	query := listURLsNewestFirstQuery
+	if params.Order == domain.SortOldestFirst {
+		query = listURLsOldestFirstQuery
+	}
+
+	var cursorCreatedAt *time.Time
+	var cursorID *int64
+	if params.Cursor != nil {
+		cursorCreatedAt = &params.Cursor.CreatedAt
+		cursorID = &params.Cursor.ID
+	}
+	offset := params.Limit * (params.Page - 1)
+
+	rows, err := connFromContext(ctx, r.dbPool).Query(
+		ctx, query,
+		params.Domain, params.Tag, params.CampaignID,
+		params.DestinationHost, escapeLike(params.DestinationHost),
+		escapeLike(params.Search),
+		params.CreatedFrom, params.CreatedTo,
+		cursorCreatedAt, cursorID,
+		params.Limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	urls := make([]domain.URLData, 0)
+	for rows.Next() {
+		urlData, err := scanURLData(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		urls = append(urls, urlData)
+	}
+
+	return urls, rows.Err()
+}
+
+// escapeLike makes the LIKE wildcards in user input match literally
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+const setURLPreviewQuery = `INSERT INTO url_previews (url_id, title, description, image_url)
+SELECT id, $3, $4, $5 FROM url_data WHERE domain = $1 AND short_url = $2 AND deleted_at IS NULL
+ON CONFLICT (url_id) DO UPDATE SET title       = excluded.title,
+                                   description = excluded.description,
+                                   image_url   = excluded.image_url`
+
+func (r *urlRepoPostgres) SetURLPreview(ctx context.Context, urlDomain string, shortUrl string, preview domain.URLPreview) error {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(
+		ctx, setURLPreviewQuery,
+		urlDomain, r.codeNormalizer.NormalizeCode(shortUrl), preview.Title, preview.Description, preview.ImageURL,
+	)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errs.ErrNoURL
+	}
+
+	return nil
+}
+
+const getURLMetadataQuery = `SELECT title, description, favicon_url, final_url, fetched_at
+FROM url_metadata WHERE url_id = $1`
+
+func (r *urlRepoPostgres) GetURLMetadata(ctx context.Context, urlID int64) (domain.URLMetadata, error) {
+	var metadata domain.URLMetadata
+	row := connFromContext(ctx, r.dbPool).QueryRow(ctx, getURLMetadataQuery, urlID)
+
+	err := row.Scan(&metadata.Title, &metadata.Description, &metadata.FaviconURL, &metadata.FinalURL, &metadata.FetchedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.URLMetadata{}, errs.ErrNoMetadata
+	}
+
+	return metadata, err
+}
+
+const listURLMetadataQuery = `SELECT url_id, title, description, favicon_url, final_url, fetched_at
+FROM url_metadata WHERE url_id = ANY($1)`
+
+// ListURLMetadata has no entry for the links whose page is not fetched yet
+func (r *urlRepoPostgres) ListURLMetadata(ctx context.Context, urlIDs []int64) (map[int64]domain.URLMetadata, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listURLMetadataQuery, urlIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	metadata := make(map[int64]domain.URLMetadata, len(urlIDs))
+	for rows.Next() {
+		var urlID int64
+		var urlMetadata domain.URLMetadata
+		err = rows.Scan(
+			&urlID, &urlMetadata.Title, &urlMetadata.Description,
+			&urlMetadata.FaviconURL, &urlMetadata.FinalURL, &urlMetadata.FetchedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata[urlID] = urlMetadata
+	}
+
+	return metadata, rows.Err()
+}
+
+const setURLMetadataQuery = `INSERT INTO url_metadata (url_id, title, description, favicon_url, final_url, fetched_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (url_id) DO UPDATE SET title       = excluded.title,
+                                   description = excluded.description,
+                                   favicon_url = excluded.favicon_url,
+                                   final_url   = excluded.final_url,
+                                   fetched_at  = excluded.fetched_at`
+
+func (r *urlRepoPostgres) SetURLMetadata(ctx context.Context, urlID int64, metadata domain.URLMetadata) error {
+	_, err := connFromContext(ctx, r.dbPool).Exec(
+		ctx, setURLMetadataQuery,
+		urlID, metadata.Title, metadata.Description, metadata.FaviconURL, metadata.FinalURL, metadata.FetchedAt,
+	)
+
+	return err
+}
+
+// listDestinationsQuery walks the live links by id, landing pages have no destination to check
+const listDestinationsQuery = `SELECT d.id, d.long_url FROM url_data d
+WHERE d.id > $1 AND d.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM landing_pages lp WHERE lp.url_id = d.id)
+ORDER BY d.id
+LIMIT $2`
+
+func (r *urlRepoPostgres) ListDestinations(ctx context.Context, afterID int64, limit int) ([]domain.Destination, error) {
+	rows, err := connFromContext(ctx, r.dbPool).Query(ctx, listDestinationsQuery, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	destinations := make([]domain.Destination, 0)
+	for rows.Next() {
+		var destination domain.Destination
+		err = rows.Scan(&destination.URLID, &destination.URL)
+		if err != nil {
+			return nil, err
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations, rows.Err()
+}
+
+// saveCheckResultQuery counts failures in a row, a single success resets the counter and the flag
+const saveCheckResultQuery = `INSERT INTO url_health (url_id, status_code, latency_ms, checked_at, last_success_at,
+                        consecutive_failures, flagged)
+VALUES ($1, $2, $3, $4, CASE WHEN $5 THEN $4::timestamptz END,
+        CASE WHEN $5 THEN 0 ELSE 1 END, NOT $5 AND 1 >= $6)
+ON CONFLICT (url_id) DO UPDATE SET status_code          = excluded.status_code,
+                                   latency_ms           = excluded.latency_ms,
+                                   checked_at           = excluded.checked_at,
+                                   last_success_at      = COALESCE(excluded.last_success_at, url_health.last_success_at),
+                                   consecutive_failures = CASE WHEN $5 THEN 0 ELSE url_health.consecutive_failures + 1 END,
+                                   flagged              = NOT $5 AND url_health.consecutive_failures + 1 >= $6`
+
+func (r *urlRepoPostgres) SaveCheckResult(
+	ctx context.Context,
+	urlID int64,
+	result domain.CheckResult,
+	failureThreshold int,
+) error {
+	_, err := connFromContext(ctx, r.dbPool).Exec(
+		ctx, saveCheckResultQuery,
+		urlID, result.StatusCode, result.Latency.Milliseconds(), result.CheckedAt, result.OK(), failureThreshold,
+	)
+
 	return err
 }
+
+const getURLHealthQuery = `SELECT status_code, latency_ms, checked_at, last_success_at, consecutive_failures, flagged
+FROM url_health WHERE url_id = $1`
+
+// GetURLHealth returns an empty health for a link that was not checked yet
+func (r *urlRepoPostgres) GetURLHealth(ctx context.Context, urlID int64) (domain.URLHealth, error) {
+	var health domain.URLHealth
+	var latencyMs int64
+	var lastSuccessAt *time.Time
+	row := connFromContext(ctx, r.dbPool).QueryRow(ctx, getURLHealthQuery, urlID)
+
+	err := row.Scan(
+		&health.StatusCode,
+		&latencyMs,
+		&health.CheckedAt,
+		&lastSuccessAt,
+		&health.ConsecutiveFailures,
+		&health.Flagged,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.URLHealth{}, nil
+	}
+	if err != nil {
+		return domain.URLHealth{}, err
+	}
+
+	health.Latency = time.Duration(latencyMs) * time.Millisecond
+	if lastSuccessAt != nil {
+		health.LastSuccessAt = *lastSuccessAt
+	}
+
+	return health, nil
+}
+
+// scanURLData leaves landing items empty, they are loaded only for a single url
+func scanURLData(row pgx.Row) (domain.URLData, error) {
+	var urlData domain.URLData
+	var landingTitle *string
+	var previewTitle, previewDescription, previewImageURL *string
+	var deletedAt *time.Time
+	err := row.Scan(
+		&urlData.ID,
+		&urlData.Domain,
+		&urlData.ShortUrl,
+		&urlData.LongUrl,
+		&urlData.BackupURL,
+		&urlData.CreatedAt,
+		&deletedAt,
+		&urlData.Flagged,
+		&urlData.Labels.CampaignID,
+		&urlData.Labels.Tags,
+		&landingTitle,
+		&previewTitle,
+		&previewDescription,
+		&previewImageURL,
+	)
+	if deletedAt != nil {
+		urlData.DeletedAt = *deletedAt
+	}
+	if landingTitle != nil {
+		urlData.Landing = &domain.LandingPage{Title: *landingTitle}
+	}
+	if previewTitle != nil {
+		urlData.Preview = &domain.URLPreview{
+			Title:       *previewTitle,
+			Description: *previewDescription,
+			ImageURL:    *previewImageURL,
+		}
+	}
+
+	return urlData, err
+}
+
+const softDeleteURLQuery = `UPDATE url_data SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`
+
+func (r *urlRepoPostgres) SoftDeleteURL(ctx context.Context, urlID int64, deletedAt time.Time) error {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, softDeleteURLQuery, urlID, deletedAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errs.ErrNoURL
+	}
+
+	return nil
+}
+
+const restoreURLQuery = `UPDATE url_data SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`
+
+func (r *urlRepoPostgres) RestoreURL(ctx context.Context, urlID int64) error {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, restoreURLQuery, urlID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errs.ErrNoURL
+	}
+
+	return nil
+}
+
+// purgeDeletedURLsQuery removes a batch of links deleted before $1 and leaves a tombstone for each code.
+// A code reissued to the same destination and purged again gets its tombstone refreshed
+const purgeDeletedURLsQuery = `WITH purged AS (
+    DELETE FROM url_data
+        WHERE id IN (SELECT id FROM url_data WHERE deleted_at < $1 ORDER BY deleted_at LIMIT $2)
+        RETURNING domain, short_url, id, long_url)
+INSERT
+INTO url_tombstones (domain, short_url, url_id, long_url, purged_at)
+SELECT domain, short_url, id, long_url, now()
+FROM purged
+ON CONFLICT (domain, short_url) DO UPDATE SET url_id    = excluded.url_id,
+                                              long_url  = excluded.long_url,
+                                              purged_at = excluded.purged_at`
+
+func (r *urlRepoPostgres) PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time, limit int) (int, error) {
+	tag, err := connFromContext(ctx, r.dbPool).Exec(ctx, purgeDeletedURLsQuery, deletedBefore, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
+
+// purgeURLQuery serves erasure, so the tombstone keeps no destination and the code stays retired for good.
+// The tombstone of a link the cleanup job purged before is wiped as well
+const purgeURLQuery = `WITH purged AS (
+    DELETE FROM url_data
+        WHERE id = $1
+        RETURNING domain, short_url, id),
+     tombstoned AS (
+         INSERT
+             INTO url_tombstones (domain, short_url, url_id, long_url, purged_at)
+             SELECT domain, short_url, id, '', now()
+             FROM purged
+             ON CONFLICT (domain, short_url) DO UPDATE SET url_id    = excluded.url_id,
+                                                           long_url  = '',
+                                                           purged_at = excluded.purged_at),
+     wiped AS (
+         UPDATE url_tombstones SET long_url = ''
+             WHERE url_id = $1 AND long_url <> '')
+SELECT count(*) FROM purged`
+
+func (r *urlRepoPostgres) PurgeURL(ctx context.Context, urlID int64) (bool, error) {
+	var purged int
+	err := connFromContext(ctx, r.dbPool).QueryRow(ctx, purgeURLQuery, urlID).Scan(&purged)
+	if err != nil {
+		return false, err
+	}
+
+	return purged > 0, nil
+}
+
+// isCodeRetiredQuery lets a purged code back only for the destination it had,
+// landing pages have no destination, so their codes stay retired
+const isCodeRetiredQuery = `SELECT EXISTS(SELECT 1
+              FROM url_tombstones
+              WHERE domain = $1
+                AND short_url = $2
+                AND (long_url = '' OR long_url <> $3))`
+
+func (r *urlRepoPostgres) IsCodeRetired(ctx context.Context, urlDomain string, shortUrl string, longURL string) (bool, error) {
+	var retired bool
+	row := connFromContext(ctx, r.dbPool).QueryRow(
+		ctx, isCodeRetiredQuery,
+		urlDomain, r.codeNormalizer.NormalizeCode(shortUrl), longURL,
+	)
+
+	err := row.Scan(&retired)
+	return retired, err
+}
+
+// findURLIDQuery also finds deleted and purged links, their audit records outlive them
+const findURLIDQuery = `SELECT id FROM url_data WHERE domain = $1 AND short_url = $2
+UNION ALL
+SELECT url_id FROM url_tombstones WHERE domain = $1 AND short_url = $2
+LIMIT 1`
+
+func (r *urlRepoPostgres) FindURLID(ctx context.Context, urlDomain string, shortUrl string) (int64, error) {
+	var urlID int64
+	row := connFromContext(ctx, r.dbPool).QueryRow(ctx, findURLIDQuery, urlDomain, r.codeNormalizer.NormalizeCode(shortUrl))
+
+	err := row.Scan(&urlID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errs.ErrNoURL
+	}
+
+	return urlID, err
+}
@@ -0,0 +1,162 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListURLsPagesByCursor(t *testing.T) {
+	urlRepo := NewUrlRepoPostgres(newTestDBPool(t), shortener.NewBase62UrlShortener())
+	ctx := context.Background()
+
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	// Links of the same day at different times, some of them within the same second or at the same instant
+	createdAt := []time.Time{
+		day.Add(9*time.Hour + 100*time.Microsecond),
+		day.Add(9*time.Hour + 200*time.Microsecond),
+		day.Add(9*time.Hour + 200*time.Microsecond),
+		day.Add(9*time.Hour + 900*time.Millisecond),
+		day.Add(15 * time.Hour),
+		day.Add(23*time.Hour + 59*time.Minute),
+		day.Add(23*time.Hour + 59*time.Minute),
+	}
+
+	saved := make([]domain.URLData, len(createdAt))
+	for i, at := range createdAt {
+		saved[i] = domain.URLData{
+			ID:        int64(i + 1),
+			Domain:    "sho.rt",
+			ShortUrl:  fmt.Sprintf("code%d", i),
+			LongUrl:   fmt.Sprintf("https://example.com/%d", i),
+			CreatedAt: at,
+		}
+		require.NoError(t, urlRepo.SaveURL(ctx, saved[i]))
+	}
+	// A link of the next day and one of another domain are left out
+	require.NoError(t, urlRepo.SaveURL(ctx, domain.URLData{
+		ID: 100, Domain: "sho.rt", ShortUrl: "nextday", LongUrl: "https://example.com/next", CreatedAt: day.Add(24 * time.Hour),
+	}))
+	require.NoError(t, urlRepo.SaveURL(ctx, domain.URLData{
+		ID: 101, Domain: "other.domain", ShortUrl: "code0", LongUrl: "https://example.com/other", CreatedAt: day.Add(time.Hour),
+	}))
+
+	testCases := []struct {
+		name        string
+		order       domain.SortOrder
+		expectedIDs []int64
+	}{
+		{name: "newest first", order: domain.SortNewestFirst, expectedIDs: []int64{7, 6, 5, 4, 3, 2, 1}},
+		{name: "oldest first", order: domain.SortOldestFirst, expectedIDs: []int64{1, 2, 3, 4, 5, 6, 7}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := domain.ListURLsParams{
+				Domain:      "sho.rt",
+				CreatedFrom: &day,
+				CreatedTo:   &day,
+				Order:       tc.order,
+				Page:        1,
+				Limit:       2,
+			}
+
+			var ids []int64
+			for pages := 0; pages < len(createdAt); pages++ {
+				urls, err := urlRepo.ListURLs(ctx, params)
+				require.NoError(t, err)
+				if len(urls) == 0 {
+					break
+				}
+
+				for _, urlData := range urls {
+					ids = append(ids, urlData.ID)
+				}
+				last := urls[len(urls)-1]
+				params.Cursor = &domain.URLCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+			}
+
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
+func TestListURLData(t *testing.T) {
+	urlRepo := NewUrlRepoPostgres(newTestDBPool(t), shortener.NewBase62UrlShortener())
+	ctx := context.Background()
+
+	saved := []domain.URLData{
+		{ID: 1, ShortUrl: "code", LongUrl: "https://example.com/default"},
+		{ID: 2, Domain: "sho.rt", ShortUrl: "code", LongUrl: "https://example.com/short"},
+		{ID: 3, Domain: "sho.rt", ShortUrl: "other", LongUrl: "https://example.com/other"},
+	}
+	for _, urlData := range saved {
+		urlData.CreatedAt = time.Now()
+		require.NoError(t, urlRepo.SaveURL(ctx, urlData))
+	}
+	require.NoError(t, urlRepo.SetURLMetadata(ctx, 2, domain.URLMetadata{Title: "short", FetchedAt: time.Now()}))
+
+	// The same code is looked up on its own domain only, a missing link is skipped
+	urls, err := urlRepo.ListURLData(ctx, []domain.URLKey{
+		{Domain: "sho.rt", ShortURL: "code"},
+		{Domain: "sho.rt", ShortURL: "missing"},
+	})
+	require.NoError(t, err)
+	require.Len(t, urls, 1)
+	assert.Equal(t, int64(2), urls[0].ID)
+
+	metadata, err := urlRepo.ListURLMetadata(ctx, []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, "short", metadata[2].Title)
+	assert.Len(t, metadata, 1)
+}
+
+func TestPurgeURLKeepsNoDestination(t *testing.T) {
+	dbPool := newTestDBPool(t)
+	urlRepo := NewUrlRepoPostgres(dbPool, shortener.NewBase62UrlShortener())
+	ctx := context.Background()
+
+	tombstoneLongURL := func(t *testing.T, shortURL string) string {
+		var longURL string
+		err := dbPool.QueryRow(ctx, `SELECT long_url FROM url_tombstones WHERE domain = '' AND short_url = $1`, shortURL).
+			Scan(&longURL)
+		require.NoError(t, err)
+		return longURL
+	}
+
+	t.Run("live link", func(t *testing.T) {
+		require.NoError(t, urlRepo.SaveURL(ctx, domain.URLData{
+			ID: 1, ShortUrl: "live", LongUrl: "https://example.com/private", CreatedAt: time.Now(),
+		}))
+
+		purged, err := urlRepo.PurgeURL(ctx, 1)
+		require.NoError(t, err)
+		assert.True(t, purged)
+		assert.Equal(t, "", tombstoneLongURL(t, "live"))
+
+		retired, err := urlRepo.IsCodeRetired(ctx, "", "live", "https://example.com/private")
+		require.NoError(t, err)
+		assert.True(t, retired)
+	})
+
+	t.Run("link purged by the cleanup job before", func(t *testing.T) {
+		require.NoError(t, urlRepo.SaveURL(ctx, domain.URLData{
+			ID: 2, ShortUrl: "cleaned", LongUrl: "https://example.com/private", CreatedAt: time.Now(),
+		}))
+		require.NoError(t, urlRepo.SoftDeleteURL(ctx, 2, time.Now().Add(-time.Hour)))
+		_, err := urlRepo.PurgeDeletedURLs(ctx, time.Now(), 10)
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/private", tombstoneLongURL(t, "cleaned"))
+
+		purged, err := urlRepo.PurgeURL(ctx, 2)
+		require.NoError(t, err)
+		assert.False(t, purged)
+		assert.Equal(t, "", tombstoneLongURL(t, "cleaned"))
+	})
+}
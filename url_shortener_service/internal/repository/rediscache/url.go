@@ -2,27 +2,57 @@ package rediscache
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
+	"CoolUrlShortener/internal/domain"
 	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/pkg/shortener"
 	"github.com/redis/go-redis/v9"
 )
 
 type urlCacheRedis struct {
-	client *redis.Client
+	client         *redis.Client
+	codeNormalizer shortener.CodeNormalizer
 }
 
-func NewURLCacheRedis(client *redis.Client) repository.URLCache {
+func NewURLCacheRedis(client *redis.Client, codeNormalizer shortener.CodeNormalizer) repository.URLCache {
 	return &urlCacheRedis{
-		client: client,
+		client:         client,
+		codeNormalizer: codeNormalizer,
 	}
 }
 
-func (u *urlCacheRedis) SetLongURL(ctx context.Context, shortURL string, longURL string) error {
-	return u.client.Set(ctx, shortURL, longURL, 10*time.Minute).Err()
+func (u *urlCacheRedis) SetURLData(ctx context.Context, urlData domain.URLData) error {
+	bytes, err := json.Marshal(urlData)
+	if err != nil {
+		return err
+	}
+
+	return u.client.Set(ctx, u.key(urlData.Domain, urlData.ShortUrl), bytes, 10*time.Minute).Err()
+}
+
+func (u *urlCacheRedis) GetURLData(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error) {
+	bytes, err := u.client.Get(ctx, u.key(urlDomain, shortURL)).Bytes()
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	var urlData domain.URLData
+	err = json.Unmarshal(bytes, &urlData)
+	return urlData, err
+}
+
+func (u *urlCacheRedis) DeleteURLData(ctx context.Context, urlDomain string, shortURL string) error {
+	return u.client.Del(ctx, u.key(urlDomain, shortURL)).Err()
 }
 
-func (u *urlCacheRedis) GetLongURL(ctx context.Context, shortURL string) (string, error) {
-	longURL, err := u.client.Get(ctx, shortURL).Result()
-	return longURL, err
+// key keeps bare codes for the default domain, so existing entries stay valid
+func (u *urlCacheRedis) key(urlDomain string, shortURL string) string {
+	code := u.codeNormalizer.NormalizeCode(shortURL)
+	if urlDomain == "" {
+		return code
+	}
+
+	return urlDomain + "/" + code
 }
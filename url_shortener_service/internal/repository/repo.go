@@ -2,13 +2,33 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"CoolUrlShortener/internal/domain"
 )
 
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name UrlRepo
 type UrlRepo interface {
-	GetLongURL(ctx context.Context, shortUrl string) (string, error)
-	GetShortURLByLongURL(ctx context.Context, longURL string) (string, error)
+	GetURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error)
+	// ListURLData skips the links that are not found and leaves out landing items
+	ListURLData(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error)
+	GetShortURLByLongURL(ctx context.Context, urlDomain string, longURL string) (string, error)
 	SaveURL(ctx context.Context, urlData domain.URLData) error
+	ListURLs(ctx context.Context, params domain.ListURLsParams) ([]domain.URLData, error)
+	SetURLPreview(ctx context.Context, urlDomain string, shortUrl string, preview domain.URLPreview) error
+	GetURLMetadata(ctx context.Context, urlID int64) (domain.URLMetadata, error)
+	ListURLMetadata(ctx context.Context, urlIDs []int64) (map[int64]domain.URLMetadata, error)
+	SetURLMetadata(ctx context.Context, urlID int64, metadata domain.URLMetadata) error
+	ListDestinations(ctx context.Context, afterID int64, limit int) ([]domain.Destination, error)
+	SaveCheckResult(ctx context.Context, urlID int64, result domain.CheckResult, failureThreshold int) error
+	GetURLHealth(ctx context.Context, urlID int64) (domain.URLHealth, error)
+	GetDeletedURLData(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error)
+	SoftDeleteURL(ctx context.Context, urlID int64, deletedAt time.Time) error
+	RestoreURL(ctx context.Context, urlID int64) error
+	PurgeDeletedURLs(ctx context.Context, deletedBefore time.Time, limit int) (int, error)
+	// PurgeURL removes a link right away, deleted or not, and leaves a tombstone without its destination.
+	// It is false if the link was purged before
+	PurgeURL(ctx context.Context, urlID int64) (bool, error)
+	IsCodeRetired(ctx context.Context, urlDomain string, shortUrl string, longURL string) (bool, error)
+	FindURLID(ctx context.Context, urlDomain string, shortUrl string) (int64, error)
 }
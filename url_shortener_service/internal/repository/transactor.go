@@ -0,0 +1,11 @@
+package repository
+
+import "context"
+
+// Transactor runs fn in a transaction, repositories called with the ctx passed to fn join it.
+// A nested call joins the outer transaction
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name Transactor
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
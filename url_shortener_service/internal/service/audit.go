@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+type actorKey struct{}
+
+// WithActor is set by the transport, so audit records know who made a change
+func WithActor(ctx context.Context, actor domain.Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) domain.Actor {
+	actor, _ := ctx.Value(actorKey{}).(domain.Actor)
+	return actor
+}
+
+// audit has to be called within the transaction of the change, so a change is never saved without its record
+func (s *urlService) audit(
+	ctx context.Context,
+	urlData domain.URLData,
+	action domain.AuditAction,
+	oldValue map[string]any,
+	newValue map[string]any,
+) error {
+	return s.auditRepo.SaveAuditRecord(ctx, domain.AuditRecord{
+		URLID:    urlData.ID,
+		Domain:   urlData.Domain,
+		ShortURL: urlData.ShortUrl,
+		Action:   action,
+		Actor:    ActorFromContext(ctx),
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// GetURLAuditLog also works for deleted and purged links
+func (s *urlService) GetURLAuditLog(ctx context.Context, urlDomain string, shortURL string) ([]domain.AuditRecord, error) {
+	urlID, err := s.urlRepo.FindURLID(ctx, urlDomain, s.urlShortener.NormalizeCode(shortURL))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.auditRepo.ListAuditRecords(ctx, urlID)
+}
+
+func auditURLValue(urlData domain.URLData) map[string]any {
+	value := map[string]any{
+		"long_url":    urlData.LongUrl,
+		"backup_url":  urlData.BackupURL,
+		"tags":        urlData.Labels.Tags,
+		"campaign_id": urlData.Labels.CampaignID,
+	}
+	if urlData.Landing != nil {
+		items := make([]map[string]any, len(urlData.Landing.Items))
+		for i, item := range urlData.Landing.Items {
+			items[i] = map[string]any{"title": item.Title, "url": item.URL}
+		}
+		value["landing_page"] = map[string]any{"title": urlData.Landing.Title, "items": items}
+	}
+
+	return value
+}
+
+// auditPreviewValue is nil for a link without a preview
+func auditPreviewValue(preview *domain.URLPreview) map[string]any {
+	if preview == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"preview": map[string]any{
+			"title":       preview.Title,
+			"description": preview.Description,
+			"image_url":   preview.ImageURL,
+		},
+	}
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
+)
+
+type testTxKey struct{}
+
+// newTxTrackingTransactor marks the ctx passed to the transaction body,
+// so a test can check that a repo call was made within the transaction
+func newTxTrackingTransactor(t *testing.T) *mocks.Transactor {
+	transactor := mocks.NewTransactor(t)
+	transactor.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, testTxKey{}, true))
+		}).
+		Once()
+
+	return transactor
+}
+
+func inTx() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		inTx, _ := ctx.Value(testTxKey{}).(bool)
+		return inTx
+	})
+}
+
+func TestSaveURLAudit(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testActor := domain.Actor{Name: "alice", IP: "10.0.0.1"}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name           string
+		buildAuditRepo func() repository.AuditRepo
+		buildURLCache  func() repository.URLCache
+		buildProducer  func() repository.EventsProducer
+		expectedErr    error
+	}{
+		{
+			name: "Created link is audited within the save transaction",
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("SaveAuditRecord", inTx(), mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.Action == domain.AuditActionCreate &&
+						record.Actor == testActor &&
+						record.ShortURL != "" &&
+						record.OldValue == nil &&
+						record.NewValue["long_url"] == testLongURL
+				})).
+					Return(nil)
+
+				return mockAuditRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return mockCache
+			},
+			buildProducer: func() repository.EventsProducer {
+				mockProducer := mocks.NewEventsProducer(t)
+
+				return mockProducer
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Audit fails. Link is not cached and no event is produced",
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("SaveAuditRecord", inTx(), mock.Anything).
+					Return(testErr)
+
+				return mockAuditRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildProducer: func() repository.EventsProducer {
+				return mocks.NewEventsProducer(t)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := mocks.NewUrlRepo(t)
+			mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
+				Return("", errs.ErrNoURL)
+			mockRepo.On("IsCodeRetired", inTx(), mock.Anything, mock.Anything, mock.Anything).
+				Return(false, nil)
+			mockRepo.On("SaveURL", inTx(), mock.Anything).
+				Return(nil)
+
+			urlService := NewURLService(
+				logger,
+				mockRepo,
+				tc.buildURLCache(),
+				tc.buildProducer(),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTxTrackingTransactor(t),
+				tc.buildAuditRepo(),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			ctx := WithActor(context.Background(), testActor)
+			_, err := urlService.SaveURL(ctx, domain.SaveURLRequest{LongURL: testLongURL})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdatePreviewAudit(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testOldPreview := domain.URLPreview{Title: "old"}
+	testPreview := domain.URLPreview{Title: "new"}
+	testURLData := domain.URLData{ID: 1, ShortUrl: testShortURL, Preview: &testOldPreview}
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetURLData", inTx(), "", testShortURL).
+		Return(testURLData, nil).
+		Once()
+	mockRepo.On("SetURLPreview", inTx(), "", testShortURL, testPreview).
+		Return(nil)
+	mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+		Return(testURLData, nil).
+		Once()
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, mock.Anything).
+		Return(nil)
+
+	mockAuditRepo := mocks.NewAuditRepo(t)
+	mockAuditRepo.On("SaveAuditRecord", inTx(), domain.AuditRecord{
+		URLID:    1,
+		ShortURL: testShortURL,
+		Action:   domain.AuditActionUpdate,
+		Actor:    domain.Actor{IP: "10.0.0.1"},
+		OldValue: map[string]any{
+			"preview": map[string]any{"title": "old", "description": "", "image_url": ""},
+		},
+		NewValue: map[string]any{
+			"preview": map[string]any{"title": "new", "description": "", "image_url": ""},
+		},
+	}).
+		Return(nil)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mocks.NewEventsProducer(t),
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTxTrackingTransactor(t),
+		mockAuditRepo,
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	ctx := WithActor(context.Background(), domain.Actor{IP: "10.0.0.1"})
+	_, err := urlService.UpdatePreview(ctx, "", testShortURL, testPreview)
+	assert.NoError(t, err)
+}
+
+func TestGetURLAuditLog(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testRecords := []domain.AuditRecord{
+		{ID: 1, URLID: 7, ShortURL: testShortURL, Action: domain.AuditActionCreate},
+	}
+
+	testCases := []struct {
+		name            string
+		buildURLRepo    func() repository.UrlRepo
+		buildAuditRepo  func() repository.AuditRepo
+		expectedRecords []domain.AuditRecord
+		expectedErr     error
+	}{
+		{
+			name: "Records of the link",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("FindURLID", mock.Anything, "", testShortURL).
+					Return(int64(7), nil)
+
+				return mockRepo
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("ListAuditRecords", mock.Anything, int64(7)).
+					Return(testRecords, nil)
+
+				return mockAuditRepo
+			},
+			expectedRecords: testRecords,
+			expectedErr:     nil,
+		},
+		{
+			name: "Short url not found. Should return ErrNoURL",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("FindURLID", mock.Anything, "", testShortURL).
+					Return(int64(0), errs.ErrNoURL)
+
+				return mockRepo
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				return mocks.NewAuditRepo(t)
+			},
+			expectedRecords: nil,
+			expectedErr:     errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				mocks.NewURLCache(t),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				tc.buildAuditRepo(),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			records, err := urlService.GetURLAuditLog(context.Background(), "", testShortURL)
+			assert.Equal(t, tc.expectedRecords, records)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+)
+
+// DeleteURL hides a link from lookups, it can be restored until the returned time.
+// The code stays taken until the cleanup job purges the link
+func (s *urlService) DeleteURL(ctx context.Context, urlDomain string, shortURL string) (time.Time, error) {
+	shortURL = s.urlShortener.NormalizeCode(shortURL)
+	deletedAt := time.Now()
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		urlData, err := s.urlRepo.GetURLData(ctx, urlDomain, shortURL)
+		if err != nil {
+			return err
+		}
+
+		err = s.urlRepo.SoftDeleteURL(ctx, urlData.ID, deletedAt)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, urlData, domain.AuditActionDelete, auditURLValue(urlData), nil)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = s.urlCache.DeleteURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+
+	return deletedAt.Add(s.deleteGracePeriod), nil
+}
+
+// RestoreURL brings back a link deleted within the grace period
+func (s *urlService) RestoreURL(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error) {
+	shortURL = s.urlShortener.NormalizeCode(shortURL)
+
+	var urlData domain.URLData
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		urlData, err = s.urlRepo.GetDeletedURLData(ctx, urlDomain, shortURL)
+		if err != nil {
+			return err
+		}
+		// The cleanup job may not have purged an expired link yet
+		if time.Since(urlData.DeletedAt) > s.deleteGracePeriod {
+			return errs.ErrNoURL
+		}
+
+		err = s.urlRepo.RestoreURL(ctx, urlData.ID)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, urlData, domain.AuditActionRestore, nil, auditURLValue(urlData))
+	})
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	urlData.DeletedAt = time.Time{}
+	return urlData, nil
+}
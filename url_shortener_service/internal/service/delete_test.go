@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
+)
+
+func TestDeleteURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testURLData := domain.URLData{ID: 7, ShortUrl: testShortURL, LongUrl: "https://test.longurl"}
+
+	testCases := []struct {
+		name           string
+		buildURLRepo   func() repository.UrlRepo
+		buildURLCache  func() repository.URLCache
+		buildAuditRepo func() repository.AuditRepo
+		expectedErr    error
+	}{
+		{
+			name: "Link is deleted, audited and evicted from cache",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", inTx(), "", testShortURL).
+					Return(testURLData, nil)
+				mockRepo.On("SoftDeleteURL", inTx(), int64(7), mock.Anything).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("DeleteURLData", mock.Anything, "", testShortURL).
+					Return(nil)
+
+				return mockCache
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("SaveAuditRecord", inTx(), mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.URLID == 7 &&
+						record.Action == domain.AuditActionDelete &&
+						record.OldValue["long_url"] == testURLData.LongUrl &&
+						record.NewValue == nil
+				})).Return(nil)
+
+				return mockAuditRepo
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Short url not found. Should return ErrNoURL",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", inTx(), "", testShortURL).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				return mocks.NewAuditRepo(t)
+			},
+			expectedErr: errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTxTrackingTransactor(t),
+				tc.buildAuditRepo(),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			before := time.Now()
+			restorableUntil, err := urlService.DeleteURL(context.Background(), "", testShortURL)
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.False(t, restorableUntil.Before(before.Add(testDeleteGracePeriod)))
+			}
+		})
+	}
+}
+
+func TestRestoreURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testLongURL := "https://test.longurl"
+
+	testCases := []struct {
+		name            string
+		buildURLRepo    func() repository.UrlRepo
+		buildAuditRepo  func() repository.AuditRepo
+		expectedURLData domain.URLData
+		expectedErr     error
+	}{
+		{
+			name: "Deleted within the grace period. Should restore",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetDeletedURLData", inTx(), "", testShortURL).
+					Return(domain.URLData{
+						ID:        7,
+						ShortUrl:  testShortURL,
+						LongUrl:   testLongURL,
+						DeletedAt: time.Now().Add(-time.Hour),
+					}, nil)
+				mockRepo.On("RestoreURL", inTx(), int64(7)).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("SaveAuditRecord", inTx(), mock.MatchedBy(func(record domain.AuditRecord) bool {
+					return record.URLID == 7 &&
+						record.Action == domain.AuditActionRestore &&
+						record.OldValue == nil &&
+						record.NewValue["long_url"] == testLongURL
+				})).Return(nil)
+
+				return mockAuditRepo
+			},
+			expectedURLData: domain.URLData{ID: 7, ShortUrl: testShortURL, LongUrl: testLongURL},
+			expectedErr:     nil,
+		},
+		{
+			name: "Grace period is over but link is not purged yet. Should return ErrNoURL",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetDeletedURLData", inTx(), "", testShortURL).
+					Return(domain.URLData{
+						ID:        7,
+						ShortUrl:  testShortURL,
+						LongUrl:   testLongURL,
+						DeletedAt: time.Now().Add(-testDeleteGracePeriod - time.Hour),
+					}, nil)
+
+				return mockRepo
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				return mocks.NewAuditRepo(t)
+			},
+			expectedURLData: domain.URLData{},
+			expectedErr:     errs.ErrNoURL,
+		},
+		{
+			name: "Link is not deleted. Should return ErrNoURL",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetDeletedURLData", inTx(), "", testShortURL).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockRepo
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				return mocks.NewAuditRepo(t)
+			},
+			expectedURLData: domain.URLData{},
+			expectedErr:     errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				mocks.NewURLCache(t),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTxTrackingTransactor(t),
+				tc.buildAuditRepo(),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			urlData, err := urlService.RestoreURL(context.Background(), "", testShortURL)
+			assert.Equal(t, tc.expectedURLData, urlData)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestSaveURLSkipsRetiredCode(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
+		Return("", errs.ErrNoURL)
+	mockRepo.On("IsCodeRetired", mock.Anything, "", mock.Anything, testLongURL).
+		Return(true, nil).
+		Once()
+	mockRepo.On("IsCodeRetired", mock.Anything, "", mock.Anything, testLongURL).
+		Return(false, nil).
+		Once()
+	mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, mock.Anything).Return(nil)
+
+	mockProducer := mocks.NewEventsProducer(t)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mockProducer,
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, shortURL)
+}
+
+func TestSaveURLWithRetiredAlias(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testAlias := "oldalias"
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+		Return(domain.URLData{}, errs.ErrNoURL)
+	mockRepo.On("IsCodeRetired", mock.Anything, "", testAlias, testLongURL).
+		Return(true, nil)
+
+	mockCodeFilter := shortenermocks.NewCodeFilter(t)
+	mockCodeFilter.On("IsAllowed", testAlias).Return(true)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mocks.NewURLCache(t),
+		mocks.NewEventsProducer(t),
+		shortener.NewBase62UrlShortener(),
+		mockCodeFilter,
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL, Alias: testAlias})
+	assert.Equal(t, "", shortURL)
+	assert.Equal(t, errs.ErrCodeRetired, err)
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"CoolUrlShortener/internal/domain"
+)
+
+// EraseURLData removes a link or every link of an owner for an erasure request.
+// Erased links get purged right away and their audit records lose who made the changes and what they were.
+// Audit records an owner made on other links keep the change but lose the owner
+func (s *urlService) EraseURLData(ctx context.Context, req domain.ErasureRequest) (domain.ErasureReport, error) {
+	var report domain.ErasureReport
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		report, err = s.eraseURLData(ctx, req)
+		return err
+	})
+	if err != nil {
+		return domain.ErasureReport{}, err
+	}
+
+	for _, erasedURL := range report.URLs {
+		err = s.urlCache.DeleteURLData(ctx, erasedURL.Domain, erasedURL.ShortURL)
+		if err != nil {
+			s.logger.Error(err.Error())
+		}
+	}
+
+	return report, nil
+}
+
+func (s *urlService) eraseURLData(ctx context.Context, req domain.ErasureRequest) (domain.ErasureReport, error) {
+	report := domain.ErasureReport{URLs: make([]domain.ErasedURL, 0)}
+
+	if req.ShortURL != "" {
+		shortURL := s.urlShortener.NormalizeCode(req.ShortURL)
+		urlID, err := s.urlRepo.FindURLID(ctx, req.Domain, shortURL)
+		if err != nil {
+			return domain.ErasureReport{}, err
+		}
+		report.URLs = append(report.URLs, domain.ErasedURL{ID: urlID, Domain: req.Domain, ShortURL: shortURL})
+	} else {
+		var err error
+		report.URLs, err = s.auditRepo.ListURLsCreatedBy(ctx, req.Owner)
+		if err != nil {
+			return domain.ErasureReport{}, err
+		}
+	}
+
+	urlIDs := make([]int64, len(report.URLs))
+	for i, erasedURL := range report.URLs {
+		deleted, err := s.urlRepo.PurgeURL(ctx, erasedURL.ID)
+		if err != nil {
+			return domain.ErasureReport{}, err
+		}
+		if deleted {
+			report.LinksDeleted++
+		}
+
+		urlIDs[i] = erasedURL.ID
+	}
+
+	var err error
+	report.AuditRecordsRedacted, err = s.auditRepo.RedactAuditRecords(ctx, urlIDs, req.Owner)
+	if err != nil {
+		return domain.ErasureReport{}, err
+	}
+
+	// Pending create events would bring the link back into analytics after it is erased there
+	report.OutboxEventsDeleted, err = s.outboxRepo.DeleteURLOutboxEvents(ctx, report.URLs)
+	if err != nil {
+		return domain.ErasureReport{}, err
+	}
+
+	return report, nil
+}
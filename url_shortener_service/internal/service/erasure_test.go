@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/repository"
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
+)
+
+func TestEraseURLData(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	ownerURLs := []domain.ErasedURL{
+		{ID: 7, Domain: "", ShortURL: "first"},
+		{ID: 9, Domain: "go.brand.com", ShortURL: "second"},
+	}
+
+	testCases := []struct {
+		name           string
+		req            domain.ErasureRequest
+		buildURLRepo   func() repository.UrlRepo
+		buildURLCache  func() repository.URLCache
+		buildAuditRepo func() repository.AuditRepo
+		buildOutbox    func() repository.OutboxRepo
+		expectedReport domain.ErasureReport
+		expectedErr    error
+	}{
+		{
+			name: "Single link is purged, its audit records and outbox events are erased",
+			req:  domain.ErasureRequest{ShortURL: "short"},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("FindURLID", inTx(), "", "short").Return(int64(7), nil)
+				mockRepo.On("PurgeURL", inTx(), int64(7)).Return(true, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("DeleteURLData", mock.Anything, "", "short").Return(nil)
+
+				return mockCache
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("RedactAuditRecords", inTx(), []int64{7}, "").Return(3, nil)
+
+				return mockAuditRepo
+			},
+			buildOutbox: func() repository.OutboxRepo {
+				mockOutbox := mocks.NewOutboxRepo(t)
+				mockOutbox.On("DeleteURLOutboxEvents", inTx(), []domain.ErasedURL{{ID: 7, ShortURL: "short"}}).Return(1, nil)
+
+				return mockOutbox
+			},
+			expectedReport: domain.ErasureReport{
+				URLs:                 []domain.ErasedURL{{ID: 7, ShortURL: "short"}},
+				LinksDeleted:         1,
+				AuditRecordsRedacted: 3,
+				OutboxEventsDeleted:  1,
+			},
+		},
+		{
+			name: "Owner links are erased, an already purged link is not counted as deleted",
+			req:  domain.ErasureRequest{Owner: "alice"},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("PurgeURL", inTx(), int64(7)).Return(true, nil)
+				mockRepo.On("PurgeURL", inTx(), int64(9)).Return(false, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("DeleteURLData", mock.Anything, "", "first").Return(nil)
+				mockCache.On("DeleteURLData", mock.Anything, "go.brand.com", "second").Return(nil)
+
+				return mockCache
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				mockAuditRepo := mocks.NewAuditRepo(t)
+				mockAuditRepo.On("ListURLsCreatedBy", inTx(), "alice").Return(ownerURLs, nil)
+				mockAuditRepo.On("RedactAuditRecords", inTx(), []int64{7, 9}, "alice").Return(5, nil)
+
+				return mockAuditRepo
+			},
+			buildOutbox: func() repository.OutboxRepo {
+				mockOutbox := mocks.NewOutboxRepo(t)
+				mockOutbox.On("DeleteURLOutboxEvents", inTx(), ownerURLs).Return(0, nil)
+
+				return mockOutbox
+			},
+			expectedReport: domain.ErasureReport{
+				URLs:                 ownerURLs,
+				LinksDeleted:         1,
+				AuditRecordsRedacted: 5,
+			},
+		},
+		{
+			name: "Short url not found. Should return ErrNoURL",
+			req:  domain.ErasureRequest{ShortURL: "short"},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("FindURLID", inTx(), "", "short").Return(int64(0), errs.ErrNoURL)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildAuditRepo: func() repository.AuditRepo {
+				return mocks.NewAuditRepo(t)
+			},
+			buildOutbox: func() repository.OutboxRepo {
+				return mocks.NewOutboxRepo(t)
+			},
+			expectedReport: domain.ErasureReport{},
+			expectedErr:    errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTxTrackingTransactor(t),
+				tc.buildAuditRepo(),
+				mocks.NewIdempotencyRepo(t),
+				tc.buildOutbox(),
+				testDeleteGracePeriod,
+			)
+
+			report, err := urlService.EraseURLData(context.Background(), tc.req)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expectedReport, report)
+		})
+	}
+}
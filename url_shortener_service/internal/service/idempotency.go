@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+)
+
+const (
+	idempotencyKeyTTL = 24 * time.Hour
+	// saveURLRoute scopes the keys of SaveURL
+	saveURLRoute = "SaveURL"
+)
+
+// saveURLOnce returns the short url saved earlier with the same key instead of saving another one.
+// The key is claimed in the save transaction: a concurrent duplicate waits for the first request
+// and replays its response, or takes the key over if the first request failed
+func (s *urlService) saveURLOnce(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return "", err
+	}
+	key := scopedIdempotencyKey(ctx, saveURLRoute, req.IdempotencyKey)
+
+	var shortURL string
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		record, claimed, err := s.idempotencyRepo.ClaimIdempotencyKey(
+			ctx, key, fingerprint, time.Now().Add(-idempotencyKeyTTL),
+		)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			if record.Fingerprint != fingerprint {
+				return errs.ErrKeyReused
+			}
+			shortURL = record.Response
+			return nil
+		}
+
+		shortURL, err = s.saveURL(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		return s.idempotencyRepo.SaveIdempotentResponse(ctx, key, shortURL)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return shortURL, nil
+}
+
+// scopedIdempotencyKey is the stored key. It is scoped to the caller and the route,
+// so callers that picked the same key do not get each other's responses.
+// Anonymous callers are told apart by their ip
+func scopedIdempotencyKey(ctx context.Context, route string, key string) string {
+	actor := ActorFromContext(ctx)
+	caller := "actor:" + actor.Name
+	if actor.Name == "" {
+		caller = "ip:" + actor.IP
+	}
+
+	sum := sha256.Sum256([]byte(route + "\n" + caller + "\n" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+func requestFingerprint(req domain.SaveURLRequest) (string, error) {
+	req.IdempotencyKey = ""
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
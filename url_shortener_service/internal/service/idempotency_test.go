@@ -0,0 +1,347 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
+)
+
+type fakeTxKey struct{}
+
+type fakeTx struct {
+	pending map[string]domain.IdempotencyRecord
+	locks   []*sync.Mutex
+}
+
+// fakeIdempotencyStore keeps keys in memory and locks a claimed key until its transaction ends,
+// the way an insert conflicting with an uncommitted row waits in postgres
+type fakeIdempotencyStore struct {
+	mu        sync.Mutex
+	keyLocks  map[string]*sync.Mutex
+	records   map[string]domain.IdempotencyRecord
+	createdAt map[string]time.Time
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{
+		keyLocks:  make(map[string]*sync.Mutex),
+		records:   make(map[string]domain.IdempotencyRecord),
+		createdAt: make(map[string]time.Time),
+	}
+}
+
+func (f *fakeIdempotencyStore) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(fakeTxKey{}).(*fakeTx); ok {
+		return fn(ctx)
+	}
+
+	tx := &fakeTx{pending: make(map[string]domain.IdempotencyRecord)}
+	err := fn(context.WithValue(ctx, fakeTxKey{}, tx))
+
+	f.mu.Lock()
+	if err == nil {
+		for key, record := range tx.pending {
+			f.records[key] = record
+			f.createdAt[key] = time.Now()
+		}
+	}
+	f.mu.Unlock()
+
+	for _, lock := range tx.locks {
+		lock.Unlock()
+	}
+
+	return err
+}
+
+func (f *fakeIdempotencyStore) ClaimIdempotencyKey(
+	ctx context.Context,
+	key string,
+	fingerprint string,
+	expiredBefore time.Time,
+) (domain.IdempotencyRecord, bool, error) {
+	tx := ctx.Value(fakeTxKey{}).(*fakeTx)
+
+	f.mu.Lock()
+	lock, ok := f.keyLocks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		f.keyLocks[key] = lock
+	}
+	f.mu.Unlock()
+
+	lock.Lock()
+	tx.locks = append(tx.locks, lock)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	record, ok := f.records[key]
+	if ok && !f.createdAt[key].Before(expiredBefore) {
+		return record, false, nil
+	}
+
+	tx.pending[key] = domain.IdempotencyRecord{Key: key, Fingerprint: fingerprint}
+	return domain.IdempotencyRecord{}, true, nil
+}
+
+func (f *fakeIdempotencyStore) SaveIdempotentResponse(ctx context.Context, key string, response string) error {
+	tx := ctx.Value(fakeTxKey{}).(*fakeTx)
+
+	record := tx.pending[key]
+	record.Response = response
+	tx.pending[key] = record
+
+	return nil
+}
+
+func (f *fakeIdempotencyStore) DeleteExpiredIdempotencyKeys(_ context.Context, expiredBefore time.Time) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var deleted int
+	for key, createdAt := range f.createdAt {
+		if createdAt.Before(expiredBefore) {
+			delete(f.records, key)
+			delete(f.createdAt, key)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func TestSaveURLConcurrentDuplicates(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	duplicates := 8
+
+	// The first save is slow, so the duplicates arrive while its key is still claimed
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil).
+		Once()
+	mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) {
+			time.Sleep(20 * time.Millisecond)
+		}).
+		Return(nil).
+		Once()
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+	store := newFakeIdempotencyStore()
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mockEventsServiceProducer,
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		store,
+		newTestAuditRepo(t),
+		store,
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	req := domain.SaveURLRequest{
+		LongURL:        testLongURL,
+		Labels:         domain.URLLabels{Tags: []string{"retry"}},
+		IdempotencyKey: "create-1",
+	}
+
+	shortURLs := make([]string, duplicates)
+	saveErrs := make([]error, duplicates)
+	var wg sync.WaitGroup
+	for i := 0; i < duplicates; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			shortURLs[i], saveErrs[i] = urlService.SaveURL(context.Background(), req)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < duplicates; i++ {
+		assert.NoError(t, saveErrs[i])
+		assert.NotEmpty(t, shortURLs[i])
+		assert.Equal(t, shortURLs[0], shortURLs[i])
+	}
+
+	// A later retry replays the stored response too
+	shortURL, err := urlService.SaveURL(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, shortURLs[0], shortURL)
+}
+
+func TestSaveURLIdempotencyKey(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testErr := errors.New("test error")
+	req := domain.SaveURLRequest{
+		LongURL:        testLongURL,
+		Labels:         domain.URLLabels{Tags: []string{"retry"}},
+		IdempotencyKey: "create-1",
+	}
+
+	t.Run("Key reused with another request. Should return ErrKeyReused", func(t *testing.T) {
+		store := newFakeIdempotencyStore()
+		fingerprint, err := requestFingerprint(req)
+		assert.NoError(t, err)
+		key := scopedIdempotencyKey(context.Background(), saveURLRoute, req.IdempotencyKey)
+		store.records[key] = domain.IdempotencyRecord{Key: key, Fingerprint: fingerprint, Response: "short"}
+		store.createdAt[key] = time.Now()
+
+		urlService := NewURLService(
+			logger,
+			mocks.NewUrlRepo(t),
+			mocks.NewURLCache(t),
+			mocks.NewEventsProducer(t),
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			newTestAuditRepo(t),
+			store,
+			newTestOutboxRepo(t),
+			testDeleteGracePeriod,
+		)
+
+		anotherReq := req
+		anotherReq.LongURL = "https://another.longurl"
+		shortURL, err := urlService.SaveURL(context.Background(), anotherReq)
+		assert.Equal(t, "", shortURL)
+		assert.Equal(t, errs.ErrKeyReused, err)
+	})
+
+	t.Run("Expired key. Should save again", func(t *testing.T) {
+		store := newFakeIdempotencyStore()
+		fingerprint, err := requestFingerprint(req)
+		assert.NoError(t, err)
+		key := scopedIdempotencyKey(context.Background(), saveURLRoute, req.IdempotencyKey)
+		store.records[key] = domain.IdempotencyRecord{Key: key, Fingerprint: fingerprint, Response: "old"}
+		store.createdAt[key] = time.Now().Add(-idempotencyKeyTTL - time.Minute)
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil)
+		mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+			Return(nil).
+			Once()
+
+		mockCache := mocks.NewURLCache(t)
+		mockCache.On("SetURLData", mock.Anything, mock.Anything).Return(nil)
+
+		mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+		urlService := NewURLService(
+			logger,
+			mockRepo,
+			mockCache,
+			mockEventsServiceProducer,
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			newTestAuditRepo(t),
+			store,
+			newTestOutboxRepo(t),
+			testDeleteGracePeriod,
+		)
+
+		shortURL, err := urlService.SaveURL(context.Background(), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, "old", shortURL)
+		assert.Equal(t, shortURL, store.records[key].Response)
+	})
+
+	t.Run("Same key of another caller. Should save again", func(t *testing.T) {
+		store := newFakeIdempotencyStore()
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil)
+		mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+			Return(nil).
+			Twice()
+
+		mockCache := mocks.NewURLCache(t)
+		mockCache.On("SetURLData", mock.Anything, mock.Anything).Return(nil)
+
+		urlService := NewURLService(
+			logger,
+			mockRepo,
+			mockCache,
+			mocks.NewEventsProducer(t),
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			newTestAuditRepo(t),
+			store,
+			newTestOutboxRepo(t),
+			testDeleteGracePeriod,
+		)
+
+		aliceShortURL, err := urlService.SaveURL(WithActor(context.Background(), domain.Actor{Name: "alice"}), req)
+		assert.NoError(t, err)
+		bobShortURL, err := urlService.SaveURL(WithActor(context.Background(), domain.Actor{Name: "bob"}), req)
+		assert.NoError(t, err)
+		assert.NotEqual(t, aliceShortURL, bobShortURL)
+		assert.Len(t, store.records, 2)
+	})
+
+	t.Run("Save fails. Key is released for a retry", func(t *testing.T) {
+		store := newFakeIdempotencyStore()
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil)
+		mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+			Return(testErr).
+			Once()
+
+		urlService := NewURLService(
+			logger,
+			mockRepo,
+			mocks.NewURLCache(t),
+			mocks.NewEventsProducer(t),
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			newTestAuditRepo(t),
+			store,
+			newTestOutboxRepo(t),
+			testDeleteGracePeriod,
+		)
+
+		shortURL, err := urlService.SaveURL(context.Background(), req)
+		assert.Equal(t, "", shortURL)
+		assert.Equal(t, testErr, err)
+		assert.Empty(t, store.records)
+	})
+}
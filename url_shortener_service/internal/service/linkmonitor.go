@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository"
+)
+
+const (
+	destinationsBatchSize = 500
+)
+
+type LinkMonitor interface {
+	// Run checks all destinations right away and then once per interval until ctx is done
+	Run(ctx context.Context)
+}
+
+type linkMonitor struct {
+	logger             *slog.Logger
+	urlRepo            repository.UrlRepo
+	destinationChecker repository.DestinationChecker
+	interval           time.Duration
+	workers            int
+	checkTimeout       time.Duration
+	failureThreshold   int
+}
+
+func NewLinkMonitor(
+	logger *slog.Logger,
+	urlRepo repository.UrlRepo,
+	destinationChecker repository.DestinationChecker,
+	interval time.Duration,
+	workers int,
+	checkTimeout time.Duration,
+	failureThreshold int,
+) LinkMonitor {
+	return &linkMonitor{
+		logger:             logger,
+		urlRepo:            urlRepo,
+		destinationChecker: destinationChecker,
+		interval:           interval,
+		workers:            workers,
+		checkTimeout:       checkTimeout,
+		failureThreshold:   failureThreshold,
+	}
+}
+
+func (m *linkMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.checkAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkAll reads destinations in batches, so a round does not hold all links in memory
+func (m *linkMonitor) checkAll(ctx context.Context) {
+	var afterID int64
+	for ctx.Err() == nil {
+		destinations, err := m.urlRepo.ListDestinations(ctx, afterID, destinationsBatchSize)
+		if err != nil {
+			m.logger.Error(err.Error())
+			return
+		}
+
+		m.checkBatch(ctx, destinations)
+		if len(destinations) < destinationsBatchSize {
+			return
+		}
+		afterID = destinations[len(destinations)-1].URLID
+	}
+}
+
+func (m *linkMonitor) checkBatch(ctx context.Context, destinations []domain.Destination) {
+	destinationsCh := make(chan domain.Destination)
+
+	var wg sync.WaitGroup
+	for i := 0; i < m.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for destination := range destinationsCh {
+				m.check(ctx, destination)
+			}
+		}()
+	}
+
+loop:
+	for _, destination := range destinations {
+		select {
+		case <-ctx.Done():
+			break loop
+		case destinationsCh <- destination:
+		}
+	}
+	close(destinationsCh)
+
+	wg.Wait()
+}
+
+func (m *linkMonitor) check(ctx context.Context, destination domain.Destination) {
+	checkCtx, cancel := context.WithTimeout(ctx, m.checkTimeout)
+	defer cancel()
+
+	result, err := m.destinationChecker.CheckDestination(checkCtx, destination.URL)
+	if err != nil {
+		m.logger.Debug(fmt.Sprintf("check destination of url %d: %s", destination.URLID, err.Error()))
+	}
+	// A check cut by shutdown says nothing about the destination
+	if ctx.Err() != nil {
+		return
+	}
+
+	err = m.urlRepo.SaveCheckResult(ctx, destination.URLID, result, m.failureThreshold)
+	if err != nil {
+		m.logger.Error(err.Error())
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLinkMonitorCheckAll(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	failureThreshold := 3
+	okResult := domain.CheckResult{StatusCode: http.StatusOK, Latency: time.Millisecond}
+	goneResult := domain.CheckResult{StatusCode: http.StatusGone, Latency: time.Millisecond}
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("ListDestinations", mock.Anything, int64(0), destinationsBatchSize).
+		Return([]domain.Destination{
+			{URLID: 1, URL: "https://test.ok"},
+			{URLID: 2, URL: "https://test.gone"},
+			{URLID: 3, URL: "https://test.unreachable"},
+		}, nil)
+	mockRepo.On("SaveCheckResult", mock.Anything, int64(1), okResult, failureThreshold).
+		Return(nil).
+		Once()
+	mockRepo.On("SaveCheckResult", mock.Anything, int64(2), goneResult, failureThreshold).
+		Return(nil).
+		Once()
+	mockRepo.On("SaveCheckResult", mock.Anything, int64(3), domain.CheckResult{}, failureThreshold).
+		Return(nil).
+		Once()
+
+	mockChecker := mocks.NewDestinationChecker(t)
+	mockChecker.On("CheckDestination", mock.Anything, "https://test.ok").
+		Return(okResult, nil)
+	mockChecker.On("CheckDestination", mock.Anything, "https://test.gone").
+		Return(goneResult, nil)
+	mockChecker.On("CheckDestination", mock.Anything, "https://test.unreachable").
+		Return(domain.CheckResult{}, errors.New("connection refused"))
+
+	monitor := NewLinkMonitor(logger, mockRepo, mockChecker, time.Hour, 2, time.Second, failureThreshold)
+	monitor.(*linkMonitor).checkAll(context.Background())
+}
+
+func TestLinkMonitorRun(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	listed := make(chan struct{}, 1)
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("ListDestinations", mock.Anything, int64(0), destinationsBatchSize).
+		Return([]domain.Destination{}, nil).
+		Run(func(args mock.Arguments) {
+			select {
+			case listed <- struct{}{}:
+			default:
+			}
+		})
+
+	monitor := NewLinkMonitor(logger, mockRepo, mocks.NewDestinationChecker(t), time.Hour, 1, time.Second, 3)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		monitor.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-listed:
+	case <-time.After(time.Second):
+		t.Fatal("first round did not start right away")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("monitor did not stop")
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name MetadataFetcher
+type MetadataFetcher interface {
+	// Enqueue never blocks the caller, the link is skipped when the queue is full
+	Enqueue(urlID int64, longURL string)
+	// Run blocks until ctx is done and the workers have finished their current fetch
+	Run(ctx context.Context)
+}
+
+type metadataJob struct {
+	urlID   int64
+	longURL string
+}
+
+type metadataFetcher struct {
+	logger       *slog.Logger
+	urlRepo      repository.UrlRepo
+	pageFetcher  repository.PageFetcher
+	jobs         chan metadataJob
+	workers      int
+	fetchTimeout time.Duration
+}
+
+func NewMetadataFetcher(
+	logger *slog.Logger,
+	urlRepo repository.UrlRepo,
+	pageFetcher repository.PageFetcher,
+	workers int,
+	queueSize int,
+	fetchTimeout time.Duration,
+) MetadataFetcher {
+	return &metadataFetcher{
+		logger:       logger,
+		urlRepo:      urlRepo,
+		pageFetcher:  pageFetcher,
+		jobs:         make(chan metadataJob, queueSize),
+		workers:      workers,
+		fetchTimeout: fetchTimeout,
+	}
+}
+
+func (f *metadataFetcher) Enqueue(urlID int64, longURL string) {
+	select {
+	case f.jobs <- metadataJob{urlID: urlID, longURL: longURL}:
+	default:
+		f.logger.Warn(fmt.Sprintf("metadata queue is full, skip url %d", urlID))
+	}
+}
+
+func (f *metadataFetcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < f.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f.work(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (f *metadataFetcher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-f.jobs:
+			f.fetch(ctx, job)
+		}
+	}
+}
+
+// fetch gives up on a page after fetchTimeout, a link without metadata is still usable
+func (f *metadataFetcher) fetch(ctx context.Context, job metadataJob) {
+	fetchCtx, cancel := context.WithTimeout(ctx, f.fetchTimeout)
+	defer cancel()
+
+	metadata, err := f.pageFetcher.FetchMetadata(fetchCtx, job.longURL)
+	if err != nil {
+		f.logger.Warn(fmt.Sprintf("fetch metadata of url %d: %s", job.urlID, err.Error()))
+		return
+	}
+
+	err = f.urlRepo.SetURLMetadata(ctx, job.urlID, metadata)
+	if err != nil {
+		f.logger.Error(err.Error())
+	}
+}
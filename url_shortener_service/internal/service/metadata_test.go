@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMetadataFetcher(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testURLID := int64(1)
+	testLongURL := "https://test.longurl"
+	testMetadata := domain.URLMetadata{Title: "title", FinalURL: testLongURL}
+
+	t.Run("stores fetched metadata", func(t *testing.T) {
+		stored := make(chan struct{})
+
+		mockPageFetcher := mocks.NewPageFetcher(t)
+		mockPageFetcher.On("FetchMetadata", mock.MatchedBy(func(ctx context.Context) bool {
+			_, ok := ctx.Deadline()
+			return ok
+		}), testLongURL).
+			Return(testMetadata, nil)
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("SetURLMetadata", mock.Anything, testURLID, testMetadata).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				close(stored)
+			})
+
+		metadataFetcher := NewMetadataFetcher(logger, mockRepo, mockPageFetcher, 2, 10, time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go metadataFetcher.Run(ctx)
+
+		metadataFetcher.Enqueue(testURLID, testLongURL)
+
+		select {
+		case <-stored:
+		case <-time.After(time.Second):
+			t.Fatal("metadata was not stored")
+		}
+	})
+
+	t.Run("skips failed fetch", func(t *testing.T) {
+		fetched := make(chan struct{})
+
+		mockPageFetcher := mocks.NewPageFetcher(t)
+		mockPageFetcher.On("FetchMetadata", mock.Anything, testLongURL).
+			Return(domain.URLMetadata{}, errors.New("test error")).
+			Run(func(args mock.Arguments) {
+				close(fetched)
+			})
+
+		metadataFetcher := NewMetadataFetcher(logger, mocks.NewUrlRepo(t), mockPageFetcher, 1, 10, time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			metadataFetcher.Run(ctx)
+			close(done)
+		}()
+
+		metadataFetcher.Enqueue(testURLID, testLongURL)
+		<-fetched
+		cancel()
+		<-done
+	})
+
+	t.Run("drops urls when the queue is full", func(t *testing.T) {
+		fetcher := NewMetadataFetcher(logger, mocks.NewUrlRepo(t), mocks.NewPageFetcher(t), 1, 1, time.Second)
+
+		enqueued := make(chan struct{})
+		go func() {
+			fetcher.Enqueue(testURLID, testLongURL)
+			fetcher.Enqueue(testURLID+1, testLongURL)
+			close(enqueued)
+		}()
+
+		select {
+		case <-enqueued:
+		case <-time.After(time.Second):
+			t.Fatal("enqueue blocked on a full queue")
+		}
+		assert.Len(t, fetcher.(*metadataFetcher).jobs, 1)
+	})
+}
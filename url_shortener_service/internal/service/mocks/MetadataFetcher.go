@@ -0,0 +1,38 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetadataFetcher is an autogenerated mock type for the MetadataFetcher type
+type MetadataFetcher struct {
+	mock.Mock
+}
+
+// Enqueue provides a mock function with given fields: urlID, longURL
+func (_m *MetadataFetcher) Enqueue(urlID int64, longURL string) {
+	_m.Called(urlID, longURL)
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MetadataFetcher) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// NewMetadataFetcher creates a new instance of MetadataFetcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetadataFetcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetadataFetcher {
+	mock := &MetadataFetcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
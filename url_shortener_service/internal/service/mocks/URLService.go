@@ -3,9 +3,12 @@
 package mocks
 
 import (
+	domain "CoolUrlShortener/internal/domain"
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // URLService is an autogenerated mock type for the URLService type
@@ -13,27 +16,83 @@ type URLService struct {
 	mock.Mock
 }
 
-// GetLongURL provides a mock function with given fields: ctx, shortUrl
-func (_m *URLService) GetLongURL(ctx context.Context, shortUrl string) (string, error) {
-	ret := _m.Called(ctx, shortUrl)
+// DeleteURL provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *URLService) DeleteURL(ctx context.Context, urlDomain string, shortUrl string) (time.Time, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLongURL")
+		panic("no return value specified for DeleteURL")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (time.Time, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) time.Time); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EraseURLData provides a mock function with given fields: ctx, req
+func (_m *URLService) EraseURLData(ctx context.Context, req domain.ErasureRequest) (domain.ErasureReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EraseURLData")
+	}
+
+	var r0 domain.ErasureReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ErasureRequest) (domain.ErasureReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ErasureRequest) domain.ErasureReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(domain.ErasureReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ErasureRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowLandingItem provides a mock function with given fields: ctx, urlDomain, shortUrl, position, click
+func (_m *URLService) FollowLandingItem(ctx context.Context, urlDomain string, shortUrl string, position int, click domain.ClickContext) (string, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, position, click)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowLandingItem")
 	}
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, domain.ClickContext) (string, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, position, click)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, domain.ClickContext) string); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, position, click)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, shortUrl)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, domain.ClickContext) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, position, click)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,9 +100,237 @@ func (_m *URLService) GetLongURL(ctx context.Context, shortUrl string) (string,
 	return r0, r1
 }
 
-// SaveURL provides a mock function with given fields: ctx, longURL
-func (_m *URLService) SaveURL(ctx context.Context, longURL string) (string, error) {
-	ret := _m.Called(ctx, longURL)
+// FollowURL provides a mock function with given fields: ctx, urlDomain, shortUrl, click
+func (_m *URLService) FollowURL(ctx context.Context, urlDomain string, shortUrl string, click domain.ClickContext) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, click)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowURL")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.ClickContext) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, click)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.ClickContext) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, click)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, domain.ClickContext) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, click)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLAuditLog provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *URLService) GetURLAuditLog(ctx context.Context, urlDomain string, shortUrl string) ([]domain.AuditRecord, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLAuditLog")
+	}
+
+	var r0 []domain.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]domain.AuditRecord, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []domain.AuditRecord); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLHealth provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *URLService) GetURLHealth(ctx context.Context, urlDomain string, shortUrl string) (domain.URLHealth, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLHealth")
+	}
+
+	var r0 domain.URLHealth
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLHealth, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLHealth); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(domain.URLHealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLInfo provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *URLService) GetURLInfo(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLInfo")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetURLInfos provides a mock function with given fields: ctx, keys
+func (_m *URLService) GetURLInfos(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetURLInfos")
+	}
+
+	var r0 []domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.URLKey) ([]domain.URLData, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.URLKey) []domain.URLData); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.URLData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.URLKey) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListURLs provides a mock function with given fields: ctx, params
+func (_m *URLService) ListURLs(ctx context.Context, params domain.ListURLsParams) (domain.URLList, error) {
+	ret := _m.Called(ctx, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListURLs")
+	}
+
+	var r0 domain.URLList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListURLsParams) (domain.URLList, error)); ok {
+		return rf(ctx, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListURLsParams) domain.URLList); ok {
+		r0 = rf(ctx, params)
+	} else {
+		r0 = ret.Get(0).(domain.URLList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListURLsParams) error); ok {
+		r1 = rf(ctx, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreviewURL provides a mock function with given fields: ctx, urlDomain, shortUrl, click
+func (_m *URLService) PreviewURL(ctx context.Context, urlDomain string, shortUrl string, click domain.ClickContext) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, click)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewURL")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.ClickContext) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, click)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.ClickContext) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, click)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, domain.ClickContext) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, click)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreURL provides a mock function with given fields: ctx, urlDomain, shortUrl
+func (_m *URLService) RestoreURL(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreURL")
+	}
+
+	var r0 domain.URLData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (domain.URLData, error)); ok {
+		return rf(ctx, urlDomain, shortUrl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) domain.URLData); ok {
+		r0 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r0 = ret.Get(0).(domain.URLData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveURL provides a mock function with given fields: ctx, req
+func (_m *URLService) SaveURL(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SaveURL")
@@ -51,17 +338,45 @@ func (_m *URLService) SaveURL(ctx context.Context, longURL string) (string, erro
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
-		return rf(ctx, longURL)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SaveURLRequest) (string, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
-		r0 = rf(ctx, longURL)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SaveURLRequest) string); ok {
+		r0 = rf(ctx, req)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, longURL)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SaveURLRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePreview provides a mock function with given fields: ctx, urlDomain, shortUrl, preview
+func (_m *URLService) UpdatePreview(ctx context.Context, urlDomain string, shortUrl string, preview domain.URLPreview) (domain.URLPreview, error) {
+	ret := _m.Called(ctx, urlDomain, shortUrl, preview)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePreview")
+	}
+
+	var r0 domain.URLPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.URLPreview) (domain.URLPreview, error)); ok {
+		return rf(ctx, urlDomain, shortUrl, preview)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, domain.URLPreview) domain.URLPreview); ok {
+		r0 = rf(ctx, urlDomain, shortUrl, preview)
+	} else {
+		r0 = ret.Get(0).(domain.URLPreview)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, domain.URLPreview) error); ok {
+		r1 = rf(ctx, urlDomain, shortUrl, preview)
 	} else {
 		r1 = ret.Error(1)
 	}
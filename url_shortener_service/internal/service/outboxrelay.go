@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+)
+
+const (
+	maxRelayBackoff = time.Minute
+	// sentEventsRetention is how long published events stay in the outbox before the cleanup job deletes them
+	sentEventsRetention = 24 * time.Hour
+)
+
+type OutboxRelay interface {
+	// Run publishes outbox events until ctx is done. When publishing fails the relay
+	// retries with a growing delay. An event is published again to the sinks it was not
+	// marked sent for, so consumers may see an event more than once
+	Run(ctx context.Context)
+}
+
+// OutboxSink is a sink the relay publishes events to, its delivery is tracked by Name
+type OutboxSink struct {
+	Name     string
+	Producer repository.EventsProducer
+}
+
+type outboxRelay struct {
+	logger     *slog.Logger
+	transactor repository.Transactor
+	outboxRepo repository.OutboxRepo
+	sinks      []OutboxSink
+	interval   time.Duration
+	batchSize  int
+}
+
+func NewOutboxRelay(
+	logger *slog.Logger,
+	transactor repository.Transactor,
+	outboxRepo repository.OutboxRepo,
+	sinks []OutboxSink,
+	interval time.Duration,
+	batchSize int,
+) OutboxRelay {
+	return &outboxRelay{
+		logger:     logger,
+		transactor: transactor,
+		outboxRepo: outboxRepo,
+		sinks:      sinks,
+		interval:   interval,
+		batchSize:  batchSize,
+	}
+}
+
+func (r *outboxRelay) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	backoff := r.interval
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		sent, err := r.relayBatch(ctx)
+		switch {
+		case err != nil:
+			r.logger.Error(err.Error())
+			backoff = min(backoff*2, maxRelayBackoff)
+			timer.Reset(backoff)
+		case sent == r.batchSize:
+			// A full batch means more events are waiting
+			backoff = r.interval
+			timer.Reset(0)
+		default:
+			backoff = r.interval
+			timer.Reset(r.interval)
+		}
+	}
+}
+
+// relayBatch publishes the oldest pending events in order. A sink that fails gets none of the
+// later events of the batch, so it sees them in order on the next try. The other sinks go on,
+// the sinks an event reached are recorded so a retry does not publish it to them again.
+// Events that reached every sink are marked sent
+func (r *outboxRelay) relayBatch(ctx context.Context) (int, error) {
+	var sent int
+	var publishErrs []error
+	err := r.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		events, err := r.outboxRepo.ListPendingOutboxEvents(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		failed := make(map[string]bool, len(r.sinks))
+		sentIDs := make([]int64, 0, len(events))
+		for _, event := range events {
+			if len(failed) == len(r.sinks) {
+				break
+			}
+
+			sentSinks := slices.Clone(event.SentSinks)
+			for _, sink := range r.sinks {
+				if failed[sink.Name] || slices.Contains(sentSinks, sink.Name) {
+					continue
+				}
+
+				publishErr := sink.Producer.ProduceEvent(event.Event)
+				if publishErr != nil {
+					failed[sink.Name] = true
+					publishErrs = append(publishErrs, fmt.Errorf("publish to %s: %w", sink.Name, publishErr))
+					continue
+				}
+				sentSinks = append(sentSinks, sink.Name)
+			}
+
+			if r.sentToAll(sentSinks) {
+				sentIDs = append(sentIDs, event.ID)
+				continue
+			}
+			if len(sentSinks) > len(event.SentSinks) {
+				err = r.outboxRepo.MarkOutboxEventSinksSent(ctx, event.ID, sentSinks)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if len(sentIDs) == 0 {
+			return nil
+		}
+
+		err = r.outboxRepo.MarkOutboxEventsSent(ctx, sentIDs)
+		if err != nil {
+			return err
+		}
+
+		sent = len(sentIDs)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, errors.Join(publishErrs...)
+}
+
+func (r *outboxRelay) sentToAll(sentSinks []string) bool {
+	for _, sink := range r.sinks {
+		if !slices.Contains(sentSinks, sink.Name) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/internal/repository/models"
+	"CoolUrlShortener/pkg/shortener"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
+)
+
+type fakeOutboxTxKey struct{}
+
+type fakeOutboxTx struct {
+	saved       []models.URLEvent
+	marked      []int64
+	markedSinks map[int64][]string
+}
+
+// fakeOutboxStore keeps the outbox in memory, changes made within a transaction
+// are applied only when it commits
+type fakeOutboxStore struct {
+	mu        sync.Mutex
+	nextID    int64
+	events    map[int64]models.URLEvent
+	sent      map[int64]bool
+	sentSinks map[int64][]string
+	// failMark simulates a crash between publishing events and marking them sent
+	failMark error
+}
+
+func newFakeOutboxStore() *fakeOutboxStore {
+	return &fakeOutboxStore{
+		events:    make(map[int64]models.URLEvent),
+		sent:      make(map[int64]bool),
+		sentSinks: make(map[int64][]string),
+	}
+}
+
+func (f *fakeOutboxStore) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(fakeOutboxTxKey{}).(*fakeOutboxTx); ok {
+		return fn(ctx)
+	}
+
+	tx := &fakeOutboxTx{markedSinks: make(map[int64][]string)}
+	err := fn(context.WithValue(ctx, fakeOutboxTxKey{}, tx))
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, event := range tx.saved {
+		f.nextID++
+		f.events[f.nextID] = event
+	}
+	for _, id := range tx.marked {
+		f.sent[id] = true
+	}
+	for id, sinks := range tx.markedSinks {
+		f.sentSinks[id] = sinks
+	}
+
+	return nil
+}
+
+func (f *fakeOutboxStore) SaveOutboxEvent(ctx context.Context, event models.URLEvent) error {
+	tx := ctx.Value(fakeOutboxTxKey{}).(*fakeOutboxTx)
+	tx.saved = append(tx.saved, event)
+
+	return nil
+}
+
+func (f *fakeOutboxStore) ListPendingOutboxEvents(_ context.Context, limit int) ([]models.OutboxEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]int64, 0, len(f.events))
+	for id := range f.events {
+		if !f.sent[id] {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	events := make([]models.OutboxEvent, len(ids))
+	for i, id := range ids {
+		events[i] = models.OutboxEvent{ID: id, Event: f.events[id], SentSinks: f.sentSinks[id]}
+	}
+
+	return events, nil
+}
+
+func (f *fakeOutboxStore) MarkOutboxEventsSent(ctx context.Context, ids []int64) error {
+	if f.failMark != nil {
+		return f.failMark
+	}
+
+	tx := ctx.Value(fakeOutboxTxKey{}).(*fakeOutboxTx)
+	tx.marked = append(tx.marked, ids...)
+
+	return nil
+}
+
+func (f *fakeOutboxStore) MarkOutboxEventSinksSent(ctx context.Context, id int64, sinks []string) error {
+	tx := ctx.Value(fakeOutboxTxKey{}).(*fakeOutboxTx)
+	tx.markedSinks[id] = sinks
+
+	return nil
+}
+
+func (f *fakeOutboxStore) DeleteSentOutboxEvents(_ context.Context, _ time.Time) (int, error) {
+	return 0, nil
+}
+
+func (f *fakeOutboxStore) DeleteURLOutboxEvents(_ context.Context, _ []domain.ErasedURL) (int, error) {
+	return 0, nil
+}
+
+func (f *fakeOutboxStore) pending() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.events) - len(f.sent)
+}
+
+// fakeEventsProducer collects published events, while down it fails every publish
+type fakeEventsProducer struct {
+	mu        sync.Mutex
+	published []models.URLEvent
+	down      bool
+	// failAfter makes the producer go down after publishing that many more events
+	failAfter int
+}
+
+func (p *fakeEventsProducer) ProduceEvent(event models.URLEvent) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.down {
+		return errors.New("kafka is unavailable")
+	}
+	p.published = append(p.published, event)
+	if p.failAfter > 0 {
+		p.failAfter--
+		if p.failAfter == 0 {
+			p.down = true
+		}
+	}
+
+	return nil
+}
+
+func (p *fakeEventsProducer) setDown(down bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.down = down
+}
+
+func (p *fakeEventsProducer) shortURLs() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	shortURLs := make([]string, len(p.published))
+	for i, event := range p.published {
+		shortURLs[i] = event.ShortURL
+	}
+
+	return shortURLs
+}
+
+func kafkaOutboxSinks(producer *fakeEventsProducer) []OutboxSink {
+	return []OutboxSink{{Name: "kafka", Producer: producer}}
+}
+
+func saveTestOutboxEvents(t *testing.T, store *fakeOutboxStore, shortURLs ...string) {
+	for _, shortURL := range shortURLs {
+		err := store.WithinTx(context.Background(), func(ctx context.Context) error {
+			return store.SaveOutboxEvent(ctx, models.URLEvent{ShortURL: shortURL, EventType: models.EventTypeCreate})
+		})
+		assert.NoError(t, err)
+	}
+}
+
+func TestOutboxRelayRelayBatch(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	t.Run("Kafka is down. Events stay pending and are published once it is back", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		producer := &fakeEventsProducer{down: true}
+		saveTestOutboxEvents(t, store, "a", "b", "c")
+
+		relay := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Second, 10).(*outboxRelay)
+		sent, err := relay.relayBatch(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 0, sent)
+		assert.Equal(t, 3, store.pending())
+
+		producer.setDown(false)
+		sent, err = relay.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, sent)
+		assert.Equal(t, 0, store.pending())
+		assert.Equal(t, []string{"a", "b", "c"}, producer.shortURLs())
+	})
+
+	t.Run("Kafka fails mid batch. Published events are marked, the rest are retried in order", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		producer := &fakeEventsProducer{failAfter: 2}
+		saveTestOutboxEvents(t, store, "a", "b", "c", "d")
+
+		relay := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Second, 10).(*outboxRelay)
+		sent, err := relay.relayBatch(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, 2, store.pending())
+
+		producer.setDown(false)
+		sent, err = relay.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, producer.shortURLs())
+	})
+
+	t.Run("Crash before events are marked sent. They are published again after restart", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		producer := &fakeEventsProducer{}
+		saveTestOutboxEvents(t, store, "a", "b")
+
+		store.failMark = errors.New("connection reset")
+		crashed := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Second, 10).(*outboxRelay)
+		_, err := crashed.relayBatch(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 2, store.pending())
+
+		store.failMark = nil
+		restarted := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Second, 10).(*outboxRelay)
+		sent, err := restarted.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, 0, store.pending())
+		// Delivery is at least once, no event is lost
+		assert.Equal(t, []string{"a", "b", "a", "b"}, producer.shortURLs())
+	})
+}
+
+func TestOutboxRelayRelayBatchSinks(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	t.Run("One sink is down. The other gets every event once", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		kafkaProducer := &fakeEventsProducer{}
+		natsProducer := &fakeEventsProducer{down: true}
+		saveTestOutboxEvents(t, store, "a", "b", "c")
+
+		relay := NewOutboxRelay(logger, store, store, []OutboxSink{
+			{Name: "kafka", Producer: kafkaProducer},
+			{Name: "nats", Producer: natsProducer},
+		}, time.Second, 10).(*outboxRelay)
+		for i := 0; i < 2; i++ {
+			sent, err := relay.relayBatch(context.Background())
+			assert.Error(t, err)
+			assert.Equal(t, 0, sent)
+			assert.Equal(t, 3, store.pending())
+		}
+
+		natsProducer.setDown(false)
+		sent, err := relay.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, sent)
+		assert.Equal(t, 0, store.pending())
+		assert.Equal(t, []string{"a", "b", "c"}, kafkaProducer.shortURLs())
+		assert.Equal(t, []string{"a", "b", "c"}, natsProducer.shortURLs())
+	})
+
+	t.Run("One sink fails mid batch. It gets the rest in order on the next try", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		kafkaProducer := &fakeEventsProducer{}
+		natsProducer := &fakeEventsProducer{failAfter: 1}
+		saveTestOutboxEvents(t, store, "a", "b", "c")
+
+		relay := NewOutboxRelay(logger, store, store, []OutboxSink{
+			{Name: "kafka", Producer: kafkaProducer},
+			{Name: "nats", Producer: natsProducer},
+		}, time.Second, 10).(*outboxRelay)
+		sent, err := relay.relayBatch(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, 2, store.pending())
+
+		natsProducer.setDown(false)
+		sent, err = relay.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Equal(t, []string{"a", "b", "c"}, kafkaProducer.shortURLs())
+		assert.Equal(t, []string{"a", "b", "c"}, natsProducer.shortURLs())
+	})
+}
+
+func TestOutboxRelayRun(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	store := newFakeOutboxStore()
+	producer := &fakeEventsProducer{}
+	saveTestOutboxEvents(t, store, "a", "b", "c", "d", "e")
+
+	// A full batch is followed by the next one right away
+	relay := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Hour, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		relay.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return store.pending() == 0
+	}, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, producer.shortURLs())
+}
+
+func TestSaveURLOutbox(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	req := domain.SaveURLRequest{LongURL: testLongURL, Labels: domain.URLLabels{Tags: []string{"sale"}}}
+
+	t.Run("Create event is saved with the link and published by the relay", func(t *testing.T) {
+		store := newFakeOutboxStore()
+		producer := &fakeEventsProducer{down: true}
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil)
+		mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+			Return(nil)
+
+		mockCache := mocks.NewURLCache(t)
+		mockCache.On("SetURLData", mock.Anything, mock.Anything).
+			Return(nil)
+
+		urlService := NewURLService(
+			logger,
+			mockRepo,
+			mockCache,
+			producer,
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			newTestAuditRepo(t),
+			mocks.NewIdempotencyRepo(t),
+			store,
+			testDeleteGracePeriod,
+		)
+
+		// Kafka being down does not fail the save
+		shortURL, err := urlService.SaveURL(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, store.pending())
+
+		producer.setDown(false)
+		relay := NewOutboxRelay(logger, store, store, kafkaOutboxSinks(producer), time.Second, 10).(*outboxRelay)
+		sent, err := relay.relayBatch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, []string{shortURL}, producer.shortURLs())
+	})
+
+	t.Run("Save fails. No event is left in the outbox", func(t *testing.T) {
+		store := newFakeOutboxStore()
+
+		mockRepo := mocks.NewUrlRepo(t)
+		mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil)
+		mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+			Return(nil)
+
+		mockAuditRepo := mocks.NewAuditRepo(t)
+		mockAuditRepo.On("SaveAuditRecord", mock.Anything, mock.Anything).
+			Return(errs.ErrNoURL)
+
+		urlService := NewURLService(
+			logger,
+			mockRepo,
+			mocks.NewURLCache(t),
+			&fakeEventsProducer{},
+			shortener.NewBase62UrlShortener(),
+			shortenermocks.NewCodeFilter(t),
+			newTestMetadataFetcher(t),
+			store,
+			mockAuditRepo,
+			mocks.NewIdempotencyRepo(t),
+			store,
+			testDeleteGracePeriod,
+		)
+
+		_, err := urlService.SaveURL(context.Background(), req)
+		assert.Equal(t, errs.ErrNoURL, err)
+		assert.Equal(t, 0, store.pending())
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"net/url"
+	"strings"
 	"time"
 
 	"CoolUrlShortener/internal/domain"
@@ -14,18 +16,42 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	maxSaveAttempts = 3
+)
+
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name URLService
 type URLService interface {
-	GetLongURL(ctx context.Context, shortUrl string) (string, error)
-	SaveURL(ctx context.Context, longURL string) (string, error)
+	FollowURL(ctx context.Context, urlDomain string, shortUrl string, click domain.ClickContext) (domain.URLData, error)
+	FollowLandingItem(
+		ctx context.Context, urlDomain string, shortUrl string, position int, click domain.ClickContext,
+	) (string, error)
+	PreviewURL(ctx context.Context, urlDomain string, shortUrl string, click domain.ClickContext) (domain.URLData, error)
+	UpdatePreview(ctx context.Context, urlDomain string, shortUrl string, preview domain.URLPreview) (domain.URLPreview, error)
+	SaveURL(ctx context.Context, req domain.SaveURLRequest) (string, error)
+	ListURLs(ctx context.Context, params domain.ListURLsParams) (domain.URLList, error)
+	GetURLInfo(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error)
+	GetURLInfos(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error)
+	GetURLHealth(ctx context.Context, urlDomain string, shortUrl string) (domain.URLHealth, error)
+	GetURLAuditLog(ctx context.Context, urlDomain string, shortUrl string) ([]domain.AuditRecord, error)
+	DeleteURL(ctx context.Context, urlDomain string, shortUrl string) (time.Time, error)
+	RestoreURL(ctx context.Context, urlDomain string, shortUrl string) (domain.URLData, error)
+	EraseURLData(ctx context.Context, req domain.ErasureRequest) (domain.ErasureReport, error)
 }
 
 type urlService struct {
-	logger         *slog.Logger
-	urlRepo        repository.UrlRepo
-	urlCache       repository.URLCache
-	eventsProducer repository.EventsProducer
-	urlShortener   shortener.URLShortener
+	logger            *slog.Logger
+	urlRepo           repository.UrlRepo
+	urlCache          repository.URLCache
+	eventsProducer    repository.EventsProducer
+	urlShortener      shortener.URLShortener
+	codeFilter        shortener.CodeFilter
+	metadataFetcher   MetadataFetcher
+	transactor        repository.Transactor
+	auditRepo         repository.AuditRepo
+	idempotencyRepo   repository.IdempotencyRepo
+	outboxRepo        repository.OutboxRepo
+	deleteGracePeriod time.Duration
 }
 
 func NewURLService(
@@ -34,92 +60,578 @@ func NewURLService(
 	urlCache repository.URLCache,
 	eventsProducer repository.EventsProducer,
 	urlShortener shortener.URLShortener,
+	codeFilter shortener.CodeFilter,
+	metadataFetcher MetadataFetcher,
+	transactor repository.Transactor,
+	auditRepo repository.AuditRepo,
+	idempotencyRepo repository.IdempotencyRepo,
+	outboxRepo repository.OutboxRepo,
+	deleteGracePeriod time.Duration,
 ) URLService {
 	return &urlService{
-		logger:         logger,
-		urlRepo:        repo,
-		urlCache:       urlCache,
-		eventsProducer: eventsProducer,
-		urlShortener:   urlShortener,
+		logger:            logger,
+		urlRepo:           repo,
+		urlCache:          urlCache,
+		eventsProducer:    eventsProducer,
+		urlShortener:      urlShortener,
+		codeFilter:        codeFilter,
+		metadataFetcher:   metadataFetcher,
+		transactor:        transactor,
+		auditRepo:         auditRepo,
+		idempotencyRepo:   idempotencyRepo,
+		outboxRepo:        outboxRepo,
+		deleteGracePeriod: deleteGracePeriod,
 	}
 }
 
-func (s *urlService) GetLongURL(ctx context.Context, shortURL string) (string, error) {
-	longURLCache, err := s.urlCache.GetLongURL(ctx, shortURL)
-	if err == nil {
-		s.eventsProducer.ProduceEvent(
-			models.URLEvent{
-				LongURL:   longURLCache,
-				ShortURL:  shortURL,
-				EventTime: time.Now().Unix(),
-				EventType: models.EventTypeFollow,
-			},
-		)
-		return longURLCache, nil
+// FollowURL counts a follow for redirects only, landing pages count follows per item
+func (s *urlService) FollowURL(
+	ctx context.Context,
+	urlDomain string,
+	shortURL string,
+	click domain.ClickContext,
+) (domain.URLData, error) {
+	urlData, err := s.getURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLData{}, err
 	}
 
-	longURL, err := s.urlRepo.GetLongURL(ctx, shortURL)
+	if urlData.Landing == nil {
+		s.produceEvent(urlData, models.EventTypeFollow, click)
+	}
+	return urlData, nil
+}
+
+func (s *urlService) FollowLandingItem(
+	ctx context.Context,
+	urlDomain string,
+	shortURL string,
+	position int,
+	click domain.ClickContext,
+) (string, error) {
+	urlData, err := s.getURLData(ctx, urlDomain, shortURL)
 	if err != nil {
 		return "", err
 	}
-	err = s.urlCache.SetLongURL(ctx, shortURL, longURL)
+	if urlData.Landing == nil || position < 0 || position >= len(urlData.Landing.Items) {
+		return "", errs.ErrNoURL
+	}
+
+	// The item url stands for the long url, so analytics keeps a counter per item
+	item := urlData.Landing.Items[position]
+	urlData.LongUrl = item.URL
+	s.produceEvent(urlData, models.EventTypeFollow, click)
+
+	return item.URL, nil
+}
+
+// PreviewURL serves social crawlers, their hits are counted apart from follows
+func (s *urlService) PreviewURL(
+	ctx context.Context,
+	urlDomain string,
+	shortURL string,
+	click domain.ClickContext,
+) (domain.URLData, error) {
+	urlData, err := s.getURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	s.produceEvent(urlData, models.EventTypePreview, click)
+	return urlData, nil
+}
+
+func (s *urlService) UpdatePreview(
+	ctx context.Context,
+	urlDomain string,
+	shortURL string,
+	preview domain.URLPreview,
+) (domain.URLPreview, error) {
+	preview.Title = strings.TrimSpace(preview.Title)
+	preview.Description = strings.TrimSpace(preview.Description)
+	preview.ImageURL = strings.TrimSpace(preview.ImageURL)
+	if preview.ImageURL != "" && !isHTTPURL(preview.ImageURL) {
+		return domain.URLPreview{}, errs.ErrInvalidPreview
+	}
+
+	shortURL = s.urlShortener.NormalizeCode(shortURL)
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		oldURLData, err := s.urlRepo.GetURLData(ctx, urlDomain, shortURL)
+		if err != nil {
+			return err
+		}
+
+		err = s.urlRepo.SetURLPreview(ctx, urlDomain, shortURL, preview)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(
+			ctx, oldURLData, domain.AuditActionUpdate,
+			auditPreviewValue(oldURLData.Preview), auditPreviewValue(&preview),
+		)
+	})
+	if err != nil {
+		return domain.URLPreview{}, err
+	}
+
+	// Refresh the cached url, otherwise crawlers keep getting the old preview until it expires
+	urlData, err := s.urlRepo.GetURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLPreview{}, err
+	}
+	err = s.urlCache.SetURLData(ctx, urlData)
 	if err != nil {
 		s.logger.Error(err.Error())
 	}
 
-	s.eventsProducer.ProduceEvent(
-		models.URLEvent{
-			LongURL:   longURL,
-			ShortURL:  shortURL,
-			EventTime: time.Now().Unix(),
-			EventType: models.EventTypeFollow,
-		},
-	)
-	return longURL, nil
+	return preview, nil
 }
 
-func (s *urlService) SaveURL(ctx context.Context, longURL string) (string, error) {
-	gotShortURL, err := s.urlRepo.GetShortURLByLongURL(ctx, longURL)
+func (s *urlService) getURLData(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error) {
+	shortURL = s.urlShortener.NormalizeCode(shortURL)
+
+	urlDataCache, err := s.urlCache.GetURLData(ctx, urlDomain, shortURL)
 	if err == nil {
-		s.eventsProducer.ProduceEvent(
-			models.URLEvent{
-				LongURL:   longURL,
-				ShortURL:  gotShortURL,
-				EventTime: time.Now().Unix(),
-				EventType: models.EventTypeCreate,
-			},
-		)
-		return gotShortURL, nil
+		return urlDataCache, nil
+	}
+
+	urlData, err := s.urlRepo.GetURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLData{}, err
+	}
+	err = s.urlCache.SetURLData(ctx, urlData)
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+
+	return urlData, nil
+}
+
+func (s *urlService) SaveURL(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	if req.IdempotencyKey != "" {
+		return s.saveURLOnce(ctx, req)
+	}
+
+	return s.saveURL(ctx, req)
+}
+
+func (s *urlService) saveURL(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	req.Domain = strings.ToLower(strings.TrimSpace(req.Domain))
+	req.Labels.Tags = normalizeTags(req.Labels.Tags)
+	req.Labels.CampaignID = strings.TrimSpace(req.Labels.CampaignID)
+	req.BackupURL = strings.TrimSpace(req.BackupURL)
+	if req.BackupURL != "" && !isHTTPURL(req.BackupURL) {
+		return "", errs.ErrInvalidBackup
+	}
+
+	if req.Landing != nil {
+		landing, err := normalizeLandingPage(*req.Landing)
+		if err != nil {
+			return "", err
+		}
+		req.Landing = &landing
+		req.LongURL = ""
+		req.BackupURL = ""
+	}
+
+	if req.Alias != "" {
+		return s.saveAlias(ctx, req)
+	}
+
+	// Landing pages have no long url to derive a code from
+	if s.urlShortener.Deterministic() && req.Landing == nil {
+		return s.saveDeterministic(ctx, req)
+	}
+
+	// Labeled links are never shared, otherwise campaigns would count each other's follows.
+	// A link with a backup url is not shared either, the existing one may fall back elsewhere
+	if req.Labels.IsEmpty() && req.Landing == nil && req.BackupURL == "" {
+		gotShortURL, err := s.urlRepo.GetShortURLByLongURL(ctx, req.Domain, req.LongURL)
+		if err == nil {
+			err = s.saveEvent(ctx, domain.URLData{Domain: req.Domain, ShortUrl: gotShortURL, LongUrl: req.LongURL}, models.EventTypeCreate)
+			if err != nil {
+				return "", err
+			}
+			return gotShortURL, nil
+		}
+		if !errors.Is(err, errs.ErrNoURL) {
+			return "", err
+		}
+	}
+
+	// A generated code may clash with an alias that was saved earlier
+	for attempt := 1; ; attempt++ {
+		id := uuid.New().ID()
+		shortURL, err := s.urlShortener.ShortenURL(shortener.ShortenParams{ID: id, LongURL: req.LongURL})
+		if err != nil {
+			return "", err
+		}
+
+		urlData := domain.URLData{
+			ID:        int64(id),
+			Domain:    req.Domain,
+			ShortUrl:  shortURL,
+			LongUrl:   req.LongURL,
+			BackupURL: req.BackupURL,
+			CreatedAt: time.Now(),
+			Labels:    req.Labels,
+			Landing:   req.Landing,
+		}
+
+		err = s.storeURL(ctx, urlData)
+		if (errors.Is(err, errs.ErrAliasTaken) || errors.Is(err, errs.ErrCodeRetired)) && attempt < maxSaveAttempts {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return urlData.ShortUrl, nil
+	}
+}
+
+// ListURLs asks the repo for one extra link to know whether there is a next page
+func (s *urlService) ListURLs(ctx context.Context, params domain.ListURLsParams) (domain.URLList, error) {
+	params.Domain = strings.ToLower(strings.TrimSpace(params.Domain))
+	params.Tag = strings.TrimSpace(params.Tag)
+	params.CampaignID = strings.TrimSpace(params.CampaignID)
+	params.DestinationHost = strings.ToLower(strings.TrimSpace(params.DestinationHost))
+	params.Search = strings.TrimSpace(params.Search)
+	if params.Order == "" {
+		params.Order = domain.SortNewestFirst
+	}
+	if params.Order != domain.SortNewestFirst && params.Order != domain.SortOldestFirst {
+		return domain.URLList{}, errs.ErrInvalidSort
+	}
+	if params.Page < 1 || params.Cursor != nil {
+		params.Page = 1
+	}
+
+	limit := params.Limit
+	params.Limit++
+	urls, err := s.urlRepo.ListURLs(ctx, params)
+	if err != nil {
+		return domain.URLList{}, err
+	}
+
+	if len(urls) <= limit {
+		return domain.URLList{URLs: urls}, nil
+	}
+
+	urls = urls[:limit]
+	last := urls[len(urls)-1]
+	return domain.URLList{
+		URLs:       urls,
+		NextCursor: &domain.URLCursor{CreatedAt: last.CreatedAt, ID: last.ID},
+	}, nil
+}
+
+// GetURLInfo does not count a follow. Metadata is nil until the destination page is fetched
+func (s *urlService) GetURLInfo(ctx context.Context, urlDomain string, shortURL string) (domain.URLData, error) {
+	urlData, err := s.getURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	metadata, err := s.urlRepo.GetURLMetadata(ctx, urlData.ID)
+	if errors.Is(err, errs.ErrNoMetadata) {
+		return urlData, nil
 	}
-	if err != nil && !errors.Is(err, errs.ErrNoURL) {
+	if err != nil {
+		return domain.URLData{}, err
+	}
+
+	urlData.Metadata = &metadata
+	return urlData, nil
+}
+
+// GetURLInfos looks the links up in two queries, bypassing the cache. Links that are not found are left out
+func (s *urlService) GetURLInfos(ctx context.Context, keys []domain.URLKey) ([]domain.URLData, error) {
+	urls, err := s.urlRepo.ListURLData(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	if len(urls) == 0 {
+		return urls, nil
+	}
+
+	urlIDs := make([]int64, len(urls))
+	for i, urlData := range urls {
+		urlIDs[i] = urlData.ID
+	}
+	metadata, err := s.urlRepo.ListURLMetadata(ctx, urlIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range urls {
+		urlMetadata, ok := metadata[urls[i].ID]
+		if ok {
+			urls[i].Metadata = &urlMetadata
+		}
+	}
+
+	return urls, nil
+}
+
+func (s *urlService) GetURLHealth(ctx context.Context, urlDomain string, shortURL string) (domain.URLHealth, error) {
+	urlData, err := s.getURLData(ctx, urlDomain, shortURL)
+	if err != nil {
+		return domain.URLHealth{}, err
+	}
+
+	return s.urlRepo.GetURLHealth(ctx, urlData.ID)
+}
+
+// saveDeterministic skips the lookup by long url: the code is derived from the url,
+// so an existing record is found by the unique short url on insert
+func (s *urlService) saveDeterministic(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	for attempt := 0; ; attempt++ {
+		id := uuid.New().ID()
+		params := shortener.ShortenParams{ID: id, LongURL: req.LongURL, Attempt: attempt}
+		shortURL, err := s.urlShortener.ShortenURL(params)
+		if err != nil {
+			return "", err
+		}
+
+		urlData := domain.URLData{
+			ID:        int64(id),
+			Domain:    req.Domain,
+			ShortUrl:  shortURL,
+			LongUrl:   req.LongURL,
+			BackupURL: req.BackupURL,
+			CreatedAt: time.Now(),
+			Labels:    req.Labels,
+		}
+
+		err = s.storeURL(ctx, urlData)
+		if err == nil {
+			return urlData.ShortUrl, nil
+		}
+		// A retired code belongs to nobody, the next attempt derives another one
+		if errors.Is(err, errs.ErrCodeRetired) {
+			if attempt+1 >= maxSaveAttempts {
+				return "", errs.ErrAliasTaken
+			}
+			continue
+		}
+		if !errors.Is(err, errs.ErrAliasTaken) {
+			return "", err
+		}
+
+		gotURLData, err := s.urlRepo.GetURLData(ctx, req.Domain, urlData.ShortUrl)
+		// The code is taken by a deleted link, it is as good as retired
+		if errors.Is(err, errs.ErrNoURL) {
+			if attempt+1 >= maxSaveAttempts {
+				return "", errs.ErrAliasTaken
+			}
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		// Urls with the same canonical form and backup share a code. Labeled links are never shared,
+		// a labeled request, a labeled holder of the code or another backup moves on to the next code
+		params.LongURL = gotURLData.LongUrl
+		gotShortURL, err := s.urlShortener.ShortenURL(params)
+		if err != nil {
+			return "", err
+		}
+		if gotShortURL == urlData.ShortUrl && req.BackupURL == gotURLData.BackupURL &&
+			req.Labels.IsEmpty() && gotURLData.Labels.IsEmpty() {
+			err = s.saveEvent(ctx, gotURLData, models.EventTypeCreate)
+			if err != nil {
+				return "", err
+			}
+			return urlData.ShortUrl, nil
+		}
+		if attempt+1 >= maxSaveAttempts {
+			return "", errs.ErrAliasTaken
+		}
+	}
+}
+
+func (s *urlService) saveAlias(ctx context.Context, req domain.SaveURLRequest) (string, error) {
+	// The alias is filtered in the form it is stored and resolved in
+	alias := s.urlShortener.NormalizeCode(req.Alias)
+	if !s.codeFilter.IsAllowed(alias) {
+		return "", errs.ErrReservedCode
+	}
+
+	gotURLData, err := s.urlRepo.GetURLData(ctx, req.Domain, alias)
+	if err == nil {
+		// Saving the same alias again is idempotent, unless the link is labeled, labeled links are never shared
+		if gotURLData.LongUrl != req.LongURL || gotURLData.BackupURL != req.BackupURL ||
+			gotURLData.Landing != nil || req.Landing != nil ||
+			!gotURLData.Labels.IsEmpty() || !req.Labels.IsEmpty() {
+			return "", errs.ErrAliasTaken
+		}
+		err = s.saveEvent(ctx, gotURLData, models.EventTypeCreate)
+		if err != nil {
+			return "", err
+		}
+		return alias, nil
+	}
+	if !errors.Is(err, errs.ErrNoURL) {
 		return "", err
 	}
 
-	id := uuid.New().ID()
-	shortUrl := s.urlShortener.ShortenURL(id)
 	urlData := domain.URLData{
-		ID:        int64(id),
-		ShortUrl:  shortUrl,
-		LongUrl:   longURL,
+		ID:        int64(uuid.New().ID()),
+		Domain:    req.Domain,
+		ShortUrl:  alias,
+		LongUrl:   req.LongURL,
+		BackupURL: req.BackupURL,
 		CreatedAt: time.Now(),
+		Labels:    req.Labels,
+		Landing:   req.Landing,
 	}
 
-	err = s.urlRepo.SaveURL(ctx, urlData)
+	err = s.storeURL(ctx, urlData)
 	if err != nil {
 		return "", err
 	}
-	err = s.urlCache.SetLongURL(ctx, shortUrl, longURL)
+
+	return alias, nil
+}
+
+// storeURL never reissues a purged code to a different destination,
+// otherwise the new link would inherit the traffic of the retired one
+func (s *urlService) storeURL(ctx context.Context, urlData domain.URLData) error {
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		retired, err := s.urlRepo.IsCodeRetired(ctx, urlData.Domain, urlData.ShortUrl, urlData.LongUrl)
+		if err != nil {
+			return err
+		}
+		if retired {
+			return errs.ErrCodeRetired
+		}
+
+		err = s.urlRepo.SaveURL(ctx, urlData)
+		if err != nil {
+			return err
+		}
+
+		err = s.saveEvent(ctx, urlData, models.EventTypeCreate)
+		if err != nil {
+			return err
+		}
+
+		return s.audit(ctx, urlData, domain.AuditActionCreate, nil, auditURLValue(urlData))
+	})
+	if err != nil {
+		return err
+	}
+	err = s.urlCache.SetURLData(ctx, urlData)
 	if err != nil {
 		s.logger.Error(err.Error())
 	}
 
-	s.eventsProducer.ProduceEvent(
-		models.URLEvent{
-			LongURL:   longURL,
-			ShortURL:  shortUrl,
-			EventTime: time.Now().Unix(),
-			EventType: models.EventTypeCreate,
-		},
-	)
-	return shortUrl, nil
+	// Landing pages have no destination page of their own
+	if urlData.Landing == nil {
+		s.metadataFetcher.Enqueue(urlData.ID, urlData.LongUrl)
+	}
+
+	return nil
+}
+
+// produceEvent hands follow and preview events to the events producer, which queues them
+// without waiting for kafka. Losing one is cheaper than a database write on every redirect
+func (s *urlService) produceEvent(urlData domain.URLData, eventType int8, click domain.ClickContext) {
+	event := newURLEvent(urlData, eventType)
+	event.Referrer = click.Referrer
+	event.UserAgent = click.UserAgent
+	event.IP = click.IP
+	event.AcceptLanguage = click.AcceptLanguage
+	event.Host = click.Host
+	event.DeviceType = click.DeviceType
+	event.OSFamily = click.OSFamily
+	event.BrowserFamily = click.BrowserFamily
+	event.Bot = click.Bot
+	event.BotScore = click.BotScore
+
+	err := s.eventsProducer.ProduceEvent(event)
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+}
+
+// saveEvent writes create events to the outbox, the relay publishes them.
+// Within a transaction the event is saved only together with the link
+func (s *urlService) saveEvent(ctx context.Context, urlData domain.URLData, eventType int8) error {
+	return s.outboxRepo.SaveOutboxEvent(ctx, newURLEvent(urlData, eventType))
+}
+
+func newURLEvent(urlData domain.URLData, eventType int8) models.URLEvent {
+	// ClickHouse expects an array, not null
+	tags := urlData.Labels.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return models.URLEvent{
+		EventID:    uuid.NewString(),
+		LongURL:    urlData.LongUrl,
+		ShortURL:   urlData.ShortUrl,
+		Domain:     urlData.Domain,
+		EventTime:  time.Now().UnixMilli(),
+		EventType:  eventType,
+		Tags:       tags,
+		CampaignID: urlData.Labels.CampaignID,
+	}
+}
+
+// normalizeTags trims tags and drops empty and repeated ones
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+// normalizeLandingPage trims titles and accepts only absolute http and https item urls,
+// anything else could run a script from the rendered page
+func normalizeLandingPage(landing domain.LandingPage) (domain.LandingPage, error) {
+	normalized := domain.LandingPage{
+		Title: strings.TrimSpace(landing.Title),
+		Items: make([]domain.LandingItem, len(landing.Items)),
+	}
+	if normalized.Title == "" || len(landing.Items) == 0 {
+		return domain.LandingPage{}, errs.ErrInvalidLanding
+	}
+
+	for i, item := range landing.Items {
+		item.Title = strings.TrimSpace(item.Title)
+		item.URL = strings.TrimSpace(item.URL)
+		if item.Title == "" || !isHTTPURL(item.URL) {
+			return domain.LandingPage{}, errs.ErrInvalidLanding
+		}
+
+		normalized.Items[i] = item
+	}
+
+	return normalized, nil
+}
+
+func isHTTPURL(rawURL string) bool {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	return (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") && parsedURL.Host != ""
 }
@@ -5,27 +5,36 @@ import (
 	"errors"
 	"log/slog"
 	"os"
+	"slices"
 	"testing"
+	"time"
 
+	"CoolUrlShortener/internal/domain"
 	"CoolUrlShortener/internal/errs"
 	"CoolUrlShortener/internal/repository"
 	"CoolUrlShortener/internal/repository/mocks"
+	"CoolUrlShortener/internal/repository/models"
 	"CoolUrlShortener/pkg/shortener"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	servicemocks "CoolUrlShortener/internal/service/mocks"
 	shortenermocks "CoolUrlShortener/pkg/shortener/mocks"
 )
 
-func TestGetLongURL(t *testing.T) {
+func TestFollowURL(t *testing.T) {
 	logger := slog.New(
 		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
 	)
-	urlShortener := shortenermocks.NewURLShortener(t)
+	codeFilter := shortenermocks.NewCodeFilter(t)
 
 	testLongURL := "https://test.longurl"
 	testShortURL := "short"
 
+	urlShortener := shortenermocks.NewURLShortener(t)
+	urlShortener.On("NormalizeCode", testShortURL).
+		Return(testShortURL)
+
 	testCases := []struct {
 		name                string
 		buildURLRepo        func() repository.UrlRepo
@@ -43,8 +52,8 @@ func TestGetLongURL(t *testing.T) {
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("GetLongURL", mock.Anything, mock.Anything).
-					Return(testLongURL, nil).
+				mockCache.On("GetURLData", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL}, nil).
 					Once()
 
 				return mockCache
@@ -52,6 +61,7 @@ func TestGetLongURL(t *testing.T) {
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
 				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
+					Return(nil).
 					Once()
 
 				return mockEventsServiceProducer
@@ -63,19 +73,19 @@ func TestGetLongURL(t *testing.T) {
 			name: "Get long url from database",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetLongURL", mock.Anything, testShortURL).
-					Return(testLongURL, nil).
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL}, nil).
 					Once()
 
 				return mockRepo
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("GetLongURL", mock.Anything, mock.Anything).
-					Return("", errors.New("no long url in cache")).
+				mockCache.On("GetURLData", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errors.New("no long url in cache")).
 					Once()
 
-				mockCache.On("SetLongURL", mock.Anything, testShortURL, testLongURL).
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
 					Return(nil).
 					Once()
 
@@ -84,6 +94,7 @@ func TestGetLongURL(t *testing.T) {
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
 				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
+					Return(nil).
 					Once()
 
 				return mockEventsServiceProducer
@@ -95,16 +106,16 @@ func TestGetLongURL(t *testing.T) {
 			name: "long url not found in db. Should be error",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetLongURL", mock.Anything, testShortURL).
-					Return("", errs.ErrNoURL).
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{}, errs.ErrNoURL).
 					Once()
 
 				return mockRepo
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("GetLongURL", mock.Anything, mock.Anything).
-					Return("", errors.New("no long url in cache")).
+				mockCache.On("GetURLData", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errors.New("no long url in cache")).
 					Once()
 
 				return mockCache
@@ -121,19 +132,19 @@ func TestGetLongURL(t *testing.T) {
 			name: "could not write to cache. Should not be error",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetLongURL", mock.Anything, testShortURL).
-					Return(testLongURL, nil).
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL}, nil).
 					Once()
 
 				return mockRepo
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("GetLongURL", mock.Anything, mock.Anything).
-					Return("", errors.New("no long url in cache")).
+				mockCache.On("GetURLData", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errors.New("no long url in cache")).
 					Once()
 
-				mockCache.On("SetLongURL", mock.Anything, testShortURL, testLongURL).
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
 					Return(errors.New("unexpected error"))
 
 				return mockCache
@@ -141,6 +152,7 @@ func TestGetLongURL(t *testing.T) {
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
 				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
+					Return(nil).
 					Once()
 
 				return mockEventsServiceProducer
@@ -158,15 +170,64 @@ func TestGetLongURL(t *testing.T) {
 				tc.buildURLCache(),
 				tc.buildEventsProducer(),
 				urlShortener,
+				codeFilter,
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
 			)
 
-			longURL, err := urlService.GetLongURL(context.Background(), testShortURL)
-			assert.Equal(t, tc.expectedLongURL, longURL)
+			urlData, err := urlService.FollowURL(context.Background(), "", testShortURL, domain.ClickContext{})
+			assert.Equal(t, tc.expectedLongURL, urlData.LongUrl)
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
 }
 
+const testDeleteGracePeriod = 24 * time.Hour
+
+func newTestMetadataFetcher(t *testing.T) *servicemocks.MetadataFetcher {
+	metadataFetcher := servicemocks.NewMetadataFetcher(t)
+	metadataFetcher.On("Enqueue", mock.Anything, mock.Anything).Maybe()
+
+	return metadataFetcher
+}
+
+// newTestTransactor runs the transaction body right away
+func newTestTransactor(t *testing.T) *mocks.Transactor {
+	transactor := mocks.NewTransactor(t)
+	transactor.On("WithinTx", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return transactor
+}
+
+// newTestOutboxRepo accepts any create event
+func newTestOutboxRepo(t *testing.T) *mocks.OutboxRepo {
+	outboxRepo := mocks.NewOutboxRepo(t)
+	outboxRepo.On("SaveOutboxEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return outboxRepo
+}
+
+func newTestAuditRepo(t *testing.T) *mocks.AuditRepo {
+	auditRepo := mocks.NewAuditRepo(t)
+	auditRepo.On("SaveAuditRecord", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return auditRepo
+}
+
+func matchURLData(shortURL string, longURL string) interface{} {
+	return mock.MatchedBy(func(urlData domain.URLData) bool {
+		return urlData.ShortUrl == shortURL && urlData.LongUrl == longURL
+	})
+}
+
 func TestSaveURL(t *testing.T) {
 	logger := slog.New(
 		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
@@ -189,7 +250,7 @@ func TestSaveURL(t *testing.T) {
 			name: "Short url exists. Should return existing short url",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetShortURLByLongURL", mock.Anything, testLongURL).
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
 					Return(testShortURL, nil)
 
 				return mockRepo
@@ -201,13 +262,13 @@ func TestSaveURL(t *testing.T) {
 			},
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
-				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
-					Once()
 
 				return mockEventsServiceProducer
 			},
 			buildURLShortener: func() shortener.URLShortener {
 				mockURLShortener := shortenermocks.NewURLShortener(t)
+				mockURLShortener.On("Deterministic").
+					Return(false)
 				return mockURLShortener
 			},
 			expectedShortURL: testShortURL,
@@ -217,7 +278,7 @@ func TestSaveURL(t *testing.T) {
 			name: "unexpected error when reading db",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetShortURLByLongURL", mock.Anything, testLongURL).
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
 					Return("", unexpectedErr)
 
 				return mockRepo
@@ -234,6 +295,8 @@ func TestSaveURL(t *testing.T) {
 			},
 			buildURLShortener: func() shortener.URLShortener {
 				mockURLShortener := shortenermocks.NewURLShortener(t)
+				mockURLShortener.On("Deterministic").
+					Return(false)
 				return mockURLShortener
 			},
 			expectedShortURL: "",
@@ -243,9 +306,11 @@ func TestSaveURL(t *testing.T) {
 			name: "create new short url without error",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetShortURLByLongURL", mock.Anything, testLongURL).
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
 					Return("", errs.ErrNoURL)
 
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
 				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
 					Return(nil)
 
@@ -253,22 +318,23 @@ func TestSaveURL(t *testing.T) {
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("SetLongURL", mock.Anything, mock.Anything, mock.Anything).
+				mockCache.On("SetURLData", mock.Anything, mock.Anything).
 					Return(nil)
 
 				return mockCache
 			},
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
-				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
-					Once()
 
 				return mockEventsServiceProducer
 			},
 			buildURLShortener: func() shortener.URLShortener {
 				mockURLShortener := shortenermocks.NewURLShortener(t)
-				mockURLShortener.On("ShortenURL", mock.AnythingOfType("uint32")).
-					Return(testShortURL)
+				mockURLShortener.On("Deterministic").
+					Return(false)
+
+				mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+					Return(testShortURL, nil)
 
 				return mockURLShortener
 			},
@@ -279,9 +345,11 @@ func TestSaveURL(t *testing.T) {
 			name: "error while saving url to db. Should return error",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetShortURLByLongURL", mock.Anything, testLongURL).
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
 					Return("", errs.ErrNoURL)
 
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
 				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
 					Return(unexpectedErr)
 
@@ -299,8 +367,11 @@ func TestSaveURL(t *testing.T) {
 			},
 			buildURLShortener: func() shortener.URLShortener {
 				mockURLShortener := shortenermocks.NewURLShortener(t)
-				mockURLShortener.On("ShortenURL", mock.AnythingOfType("uint32")).
-					Return(testShortURL)
+				mockURLShortener.On("Deterministic").
+					Return(false)
+
+				mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+					Return(testShortURL, nil)
 
 				return mockURLShortener
 			},
@@ -311,9 +382,11 @@ func TestSaveURL(t *testing.T) {
 			name: "error while saving url to cache. Should not return error",
 			buildURLRepo: func() repository.UrlRepo {
 				mockRepo := mocks.NewUrlRepo(t)
-				mockRepo.On("GetShortURLByLongURL", mock.Anything, testLongURL).
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
 					Return("", errs.ErrNoURL)
 
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
 				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
 					Return(nil)
 
@@ -321,22 +394,67 @@ func TestSaveURL(t *testing.T) {
 			},
 			buildURLCache: func() repository.URLCache {
 				mockCache := mocks.NewURLCache(t)
-				mockCache.On("SetLongURL", mock.Anything, testShortURL, testLongURL).
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
 					Return(unexpectedErr)
 
 				return mockCache
 			},
 			buildEventsProducer: func() repository.EventsProducer {
 				mockEventsServiceProducer := mocks.NewEventsProducer(t)
-				mockEventsServiceProducer.On("ProduceEvent", mock.Anything).
+
+				return mockEventsServiceProducer
+			},
+			buildURLShortener: func() shortener.URLShortener {
+				mockURLShortener := shortenermocks.NewURLShortener(t)
+				mockURLShortener.On("Deterministic").
+					Return(false)
+
+				mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+					Return(testShortURL, nil)
+
+				return mockURLShortener
+			},
+			expectedShortURL: testShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "generated short url is taken by alias. Should generate another one",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
+					Return("", errs.ErrNoURL)
+
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil).
 					Once()
 
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
 				return mockEventsServiceProducer
 			},
 			buildURLShortener: func() shortener.URLShortener {
 				mockURLShortener := shortenermocks.NewURLShortener(t)
-				mockURLShortener.On("ShortenURL", mock.AnythingOfType("uint32")).
-					Return(testShortURL)
+				mockURLShortener.On("Deterministic").
+					Return(false)
+
+				mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+					Return(testShortURL, nil).
+					Twice()
 
 				return mockURLShortener
 			},
@@ -353,11 +471,1593 @@ func TestSaveURL(t *testing.T) {
 				tc.buildURLCache(),
 				tc.buildEventsProducer(),
 				tc.buildURLShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
 			)
 
-			shortURL, err := urlService.SaveURL(context.Background(), testLongURL)
+			shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL})
 			assert.Equal(t, tc.expectedShortURL, shortURL)
 			assert.Equal(t, tc.expectedErr, err)
 		})
 	}
 }
+
+func TestSaveURLDeterministic(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	hashUrlShortener := shortener.NewHashUrlShortener()
+	testShortURL, err := hashUrlShortener.ShortenURL(shortener.ShortenParams{LongURL: testLongURL})
+	assert.NoError(t, err)
+	testRetryShortURL, err := hashUrlShortener.ShortenURL(shortener.ShortenParams{LongURL: testLongURL, Attempt: 1})
+	assert.NoError(t, err)
+
+	testBackupURL := "https://backup.longurl"
+	unexpectedErr := errors.New("unexpected error")
+
+	testCases := []struct {
+		name                string
+		buildURLRepo        func() repository.UrlRepo
+		buildURLCache       func() repository.URLCache
+		buildEventsProducer func() repository.EventsProducer
+		labels              domain.URLLabels
+		backupURL           string
+		expectedShortURL    string
+		expectedErr         error
+	}{
+		{
+			name: "new url. Should save without reading db",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: testShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "url already saved. Should return existing short url",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken)
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: "HTTPS://test.longurl/"}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: testShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "short url is taken by another url. Should move on to the next code",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: "https://another.longurl"}, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testRetryShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: testRetryShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "short url is taken by a deleted url. Should move on to the next code",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{}, errs.ErrNoURL)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testRetryShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: testRetryShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "url already saved with labels. Should move on to the next code instead of sharing",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL, Labels: domain.URLLabels{CampaignID: "spring"}}, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testRetryShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: testRetryShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "labeled url already saved without labels. Should move on to the next code instead of sharing",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL}, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil).
+					Once()
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testRetryShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			labels:           domain.URLLabels{Tags: []string{"promo"}},
+			expectedShortURL: testRetryShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "url already saved with the same backup. Should return existing short url",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken)
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL, BackupURL: testBackupURL}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				return mocks.NewEventsProducer(t)
+			},
+			backupURL:        testBackupURL,
+			expectedShortURL: testShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "url already saved with another backup. Should move on to the next code instead of sharing",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(errs.ErrAliasTaken).
+					Once()
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, LongUrl: testLongURL, BackupURL: "https://other.backup"}, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+					return urlData.ShortUrl == testRetryShortURL && urlData.BackupURL == testBackupURL
+				})).
+					Return(nil).
+					Once()
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testRetryShortURL, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				return mocks.NewEventsProducer(t)
+			},
+			backupURL:        testBackupURL,
+			expectedShortURL: testRetryShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "error while saving url to db. Should return error",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(unexpectedErr)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			expectedShortURL: "",
+			expectedErr:      unexpectedErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				tc.buildEventsProducer(),
+				hashUrlShortener,
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{
+				LongURL:   testLongURL,
+				BackupURL: tc.backupURL,
+				Labels:    tc.labels,
+			})
+			assert.Equal(t, tc.expectedShortURL, shortURL)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestSaveURLWithAlias(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testAlias := "myalias"
+
+	unexpectedErr := errors.New("unexpected error")
+
+	testCases := []struct {
+		name                string
+		buildURLRepo        func() repository.UrlRepo
+		buildURLCache       func() repository.URLCache
+		buildEventsProducer func() repository.EventsProducer
+		buildCodeFilter     func() shortener.CodeFilter
+		labels              domain.URLLabels
+		expectedShortURL    string
+		expectedErr         error
+	}{
+		{
+			name: "alias is free. Should save alias",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, matchURLData(testAlias, testLongURL)).
+					Return(nil)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: testAlias,
+			expectedErr:      nil,
+		},
+		{
+			name: "alias is reserved. Should return error",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(false)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrReservedCode,
+		},
+		{
+			name: "alias points to another url. Should return error",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{ShortUrl: testAlias, LongUrl: "https://another.longurl"}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrAliasTaken,
+		},
+		{
+			name: "alias already points to the same url. Should return alias",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{ShortUrl: testAlias, LongUrl: testLongURL}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: testAlias,
+			expectedErr:      nil,
+		},
+		{
+			name: "alias points to the same url with labels. Should return error",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{ShortUrl: testAlias, LongUrl: testLongURL, Labels: domain.URLLabels{CampaignID: "spring"}}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrAliasTaken,
+		},
+		{
+			name: "labeled alias already points to the same url. Should return error",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{ShortUrl: testAlias, LongUrl: testLongURL}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			labels:           domain.URLLabels{Tags: []string{"promo"}},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrAliasTaken,
+		},
+		{
+			name: "unexpected error when reading db",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testAlias).
+					Return(domain.URLData{}, unexpectedErr)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+
+				return mockCache
+			},
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+				return mockEventsServiceProducer
+			},
+			buildCodeFilter: func() shortener.CodeFilter {
+				mockCodeFilter := shortenermocks.NewCodeFilter(t)
+				mockCodeFilter.On("IsAllowed", testAlias).
+					Return(true)
+
+				return mockCodeFilter
+			},
+			expectedShortURL: "",
+			expectedErr:      unexpectedErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				tc.buildEventsProducer(),
+				shortener.NewBase62UrlShortener(),
+				tc.buildCodeFilter(),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL, Alias: testAlias, Labels: tc.labels})
+			assert.Equal(t, tc.expectedShortURL, shortURL)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestSaveURLWithAliasNormalizesCode(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testAlias := "MyAlias"
+	normalizedAlias := "myalias"
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetURLData", mock.Anything, "", normalizedAlias).
+		Return(domain.URLData{}, errs.ErrNoURL)
+	mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+	mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+		return urlData.ShortUrl == normalizedAlias
+	})).
+		Return(nil)
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, matchURLData(normalizedAlias, testLongURL)).
+		Return(nil)
+
+	mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+	mockCodeFilter := shortenermocks.NewCodeFilter(t)
+	mockCodeFilter.On("IsAllowed", normalizedAlias).
+		Return(true)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mockEventsServiceProducer,
+		shortener.NewBase36UrlShortener(),
+		mockCodeFilter,
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL, Alias: testAlias})
+	assert.Equal(t, normalizedAlias, shortURL)
+	assert.NoError(t, err)
+}
+
+func TestSaveURLWithAliasFiltersNormalizedCode(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	crockford32UrlShortener := shortener.NewCrockford32UrlShortener()
+
+	for _, alias := range []string{"Admin", "ADM1N", "admln"} {
+		t.Run(alias, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				mocks.NewUrlRepo(t),
+				mocks.NewURLCache(t),
+				mocks.NewEventsProducer(t),
+				crockford32UrlShortener,
+				shortener.NewWordListCodeFilter(nil, []string{"admin"}, crockford32UrlShortener),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: "https://test.longurl", Alias: alias})
+			assert.Empty(t, shortURL)
+			assert.ErrorIs(t, err, errs.ErrReservedCode)
+		})
+	}
+}
+
+func TestSaveURLWithLabels(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testShortURL := "short"
+	testCampaignID := "spring"
+	labels := domain.URLLabels{
+		Tags:       []string{" sale ", "", "promo", "sale"},
+		CampaignID: testCampaignID,
+	}
+	expectedTags := []string{"sale", "promo"}
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+	mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+		return assert.ObjectsAreEqual(expectedTags, urlData.Labels.Tags) && urlData.Labels.CampaignID == testCampaignID
+	})).
+		Return(nil)
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
+		Return(nil)
+
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.On("SaveOutboxEvent", mock.Anything, mock.MatchedBy(func(event models.URLEvent) bool {
+		return assert.ObjectsAreEqual(expectedTags, event.Tags) && event.CampaignID == testCampaignID
+	})).
+		Return(nil).
+		Once()
+
+	mockURLShortener := shortenermocks.NewURLShortener(t)
+	mockURLShortener.On("Deterministic").
+		Return(false)
+	mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+		Return(testShortURL, nil)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mocks.NewEventsProducer(t),
+		mockURLShortener,
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		mockOutboxRepo,
+		testDeleteGracePeriod,
+	)
+
+	shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL, Labels: labels})
+	assert.Equal(t, testShortURL, shortURL)
+	assert.NoError(t, err)
+}
+
+func TestSaveURLOnDomain(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+	testShortURL := "short"
+	testDomain := "go.brand.com"
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetShortURLByLongURL", mock.Anything, testDomain, testLongURL).
+		Return("", errs.ErrNoURL)
+	mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+	mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+		return urlData.Domain == testDomain && urlData.ShortUrl == testShortURL
+	})).
+		Return(nil)
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, matchURLData(testShortURL, testLongURL)).
+		Return(nil)
+
+	mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+	// The event carries the domain, the same code on another domain is another link
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.On("SaveOutboxEvent", mock.Anything, mock.MatchedBy(func(event models.URLEvent) bool {
+		return event.Domain == testDomain && event.ShortURL == testShortURL
+	})).
+		Return(nil)
+
+	mockURLShortener := shortenermocks.NewURLShortener(t)
+	mockURLShortener.On("Deterministic").
+		Return(false)
+	mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+		Return(testShortURL, nil)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mockEventsServiceProducer,
+		mockURLShortener,
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		mockOutboxRepo,
+		testDeleteGracePeriod,
+	)
+
+	shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{Domain: " Go.Brand.com ", LongURL: testLongURL})
+	assert.Equal(t, testShortURL, shortURL)
+	assert.NoError(t, err)
+}
+
+func TestFollowLandingItem(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testItemURL := "https://test.item"
+	landingURLData := domain.URLData{
+		ShortUrl: testShortURL,
+		Landing: &domain.LandingPage{
+			Title: "bio",
+			Items: []domain.LandingItem{{Title: "shop", URL: testItemURL}},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		urlData             domain.URLData
+		position            int
+		buildEventsProducer func() repository.EventsProducer
+		expectedURL         string
+		expectedErr         error
+	}{
+		{
+			name:     "Follow landing item. Should count follow for item url",
+			urlData:  landingURLData,
+			position: 0,
+			buildEventsProducer: func() repository.EventsProducer {
+				mockEventsServiceProducer := mocks.NewEventsProducer(t)
+				mockEventsServiceProducer.On("ProduceEvent", mock.MatchedBy(func(event models.URLEvent) bool {
+					return event.LongURL == testItemURL && event.ShortURL == testShortURL && event.EventType == models.EventTypeFollow
+				})).
+					Return(nil).
+					Once()
+
+				return mockEventsServiceProducer
+			},
+			expectedURL: testItemURL,
+			expectedErr: nil,
+		},
+		{
+			name:     "Position out of range. Should return ErrNoURL",
+			urlData:  landingURLData,
+			position: 1,
+			buildEventsProducer: func() repository.EventsProducer {
+				return mocks.NewEventsProducer(t)
+			},
+			expectedURL: "",
+			expectedErr: errs.ErrNoURL,
+		},
+		{
+			name:     "Short url is not a landing page. Should return ErrNoURL",
+			urlData:  domain.URLData{ShortUrl: testShortURL, LongUrl: "https://test.longurl"},
+			position: 0,
+			buildEventsProducer: func() repository.EventsProducer {
+				return mocks.NewEventsProducer(t)
+			},
+			expectedURL: "",
+			expectedErr: errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCache := mocks.NewURLCache(t)
+			mockCache.On("GetURLData", mock.Anything, "", testShortURL).
+				Return(tc.urlData, nil)
+
+			urlService := NewURLService(
+				logger,
+				mocks.NewUrlRepo(t),
+				mockCache,
+				tc.buildEventsProducer(),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			itemURL, err := urlService.FollowLandingItem(
+				context.Background(), "", testShortURL, tc.position, domain.ClickContext{},
+			)
+			assert.Equal(t, tc.expectedURL, itemURL)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestSaveLandingPage(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+
+	testCases := []struct {
+		name              string
+		landing           domain.LandingPage
+		buildURLRepo      func() repository.UrlRepo
+		buildURLCache     func() repository.URLCache
+		buildURLShortener func() shortener.URLShortener
+		expectedShortURL  string
+		expectedErr       error
+	}{
+		{
+			name: "Create landing page. Should skip lookup by long url",
+			landing: domain.LandingPage{
+				Title: " bio ",
+				Items: []domain.LandingItem{{Title: " shop ", URL: "https://test.shop"}},
+			},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+					expectedLanding := &domain.LandingPage{
+						Title: "bio",
+						Items: []domain.LandingItem{{Title: "shop", URL: "https://test.shop"}},
+					}
+					return urlData.LongUrl == "" && assert.ObjectsAreEqual(expectedLanding, urlData.Landing)
+				})).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return mockCache
+			},
+			buildURLShortener: func() shortener.URLShortener {
+				mockURLShortener := shortenermocks.NewURLShortener(t)
+				mockURLShortener.On("Deterministic").
+					Return(true)
+				mockURLShortener.On("ShortenURL", mock.AnythingOfType("shortener.ShortenParams")).
+					Return(testShortURL, nil)
+
+				return mockURLShortener
+			},
+			expectedShortURL: testShortURL,
+			expectedErr:      nil,
+		},
+		{
+			name: "Item url is not http. Should return ErrInvalidLanding",
+			landing: domain.LandingPage{
+				Title: "bio",
+				Items: []domain.LandingItem{{Title: "shop", URL: "javascript:alert(1)"}},
+			},
+			buildURLRepo: func() repository.UrlRepo {
+				return mocks.NewUrlRepo(t)
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildURLShortener: func() shortener.URLShortener {
+				return shortenermocks.NewURLShortener(t)
+			},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrInvalidLanding,
+		},
+		{
+			name: "Item title is blank. Should return ErrInvalidLanding",
+			landing: domain.LandingPage{
+				Title: "bio",
+				Items: []domain.LandingItem{{Title: " ", URL: "https://test.shop"}},
+			},
+			buildURLRepo: func() repository.UrlRepo {
+				return mocks.NewUrlRepo(t)
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			buildURLShortener: func() shortener.URLShortener {
+				return shortenermocks.NewURLShortener(t)
+			},
+			expectedShortURL: "",
+			expectedErr:      errs.ErrInvalidLanding,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				mockEventsServiceProducer,
+				tc.buildURLShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			landing := tc.landing
+			shortURL, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{Landing: &landing})
+			assert.Equal(t, tc.expectedShortURL, shortURL)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestPreviewURL(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testURLData := domain.URLData{
+		ShortUrl: testShortURL,
+		LongUrl:  "https://test.longurl",
+		Preview:  &domain.URLPreview{Title: "title"},
+	}
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("GetURLData", mock.Anything, "", testShortURL).
+		Return(testURLData, nil)
+
+	click := domain.ClickContext{
+		Referrer:       "https://t.co/",
+		UserAgent:      "Twitterbot/1.0",
+		IP:             "203.0.113.0",
+		AcceptLanguage: "en-US",
+		Host:           "sho.rt",
+		DeviceType:     "bot",
+		OSFamily:       "Other",
+		BrowserFamily:  "Other",
+		Bot:            true,
+		BotScore:       100,
+	}
+
+	mockEventsServiceProducer := mocks.NewEventsProducer(t)
+	mockEventsServiceProducer.On("ProduceEvent", mock.MatchedBy(func(event models.URLEvent) bool {
+		return event.EventType == models.EventTypePreview &&
+			event.Referrer == click.Referrer &&
+			event.UserAgent == click.UserAgent &&
+			event.IP == click.IP &&
+			event.AcceptLanguage == click.AcceptLanguage &&
+			event.Host == click.Host &&
+			event.DeviceType == click.DeviceType &&
+			event.OSFamily == click.OSFamily &&
+			event.BrowserFamily == click.BrowserFamily &&
+			event.Bot == click.Bot &&
+			event.BotScore == click.BotScore
+	})).
+		Return(nil).
+		Once()
+
+	urlService := NewURLService(
+		logger,
+		mocks.NewUrlRepo(t),
+		mockCache,
+		mockEventsServiceProducer,
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	urlData, err := urlService.PreviewURL(context.Background(), "", testShortURL, click)
+	assert.Equal(t, testURLData, urlData)
+	assert.NoError(t, err)
+}
+
+func TestUpdatePreview(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testPreview := domain.URLPreview{
+		Title:       "title",
+		Description: "description",
+		ImageURL:    "https://test.image/og.png",
+	}
+
+	testCases := []struct {
+		name            string
+		preview         domain.URLPreview
+		buildURLRepo    func() repository.UrlRepo
+		buildURLCache   func() repository.URLCache
+		expectedPreview domain.URLPreview
+		expectedErr     error
+	}{
+		{
+			name: "Update preview. Should refresh cached url",
+			preview: domain.URLPreview{
+				Title:       " title ",
+				Description: "description",
+				ImageURL:    "https://test.image/og.png",
+			},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("SetURLPreview", mock.Anything, "", testShortURL, testPreview).
+					Return(nil)
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{ShortUrl: testShortURL, Preview: &testPreview}, nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+					return urlData.Preview != nil && *urlData.Preview == testPreview
+				})).
+					Return(nil)
+
+				return mockCache
+			},
+			expectedPreview: testPreview,
+			expectedErr:     nil,
+		},
+		{
+			name:    "Image is not http. Should return ErrInvalidPreview",
+			preview: domain.URLPreview{Title: "title", ImageURL: "data:image/png;base64,AAAA"},
+			buildURLRepo: func() repository.UrlRepo {
+				return mocks.NewUrlRepo(t)
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			expectedPreview: domain.URLPreview{},
+			expectedErr:     errs.ErrInvalidPreview,
+		},
+		{
+			name:    "Short url not found. Should return ErrNoURL",
+			preview: domain.URLPreview{Title: "title"},
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("GetURLData", mock.Anything, "", testShortURL).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			expectedPreview: domain.URLPreview{},
+			expectedErr:     errs.ErrNoURL,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			preview, err := urlService.UpdatePreview(context.Background(), "", testShortURL, tc.preview)
+			assert.Equal(t, tc.expectedPreview, preview)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestListURLs(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testDay := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	testURLs := []domain.URLData{
+		{ID: 3, ShortUrl: "third", LongUrl: "https://test.longurl/3", CreatedAt: testDay},
+		{ID: 2, ShortUrl: "second", LongUrl: "https://test.longurl/2", CreatedAt: testDay},
+		{ID: 1, ShortUrl: "first", LongUrl: "https://test.longurl/1", CreatedAt: testDay},
+	}
+	testCursor := &domain.URLCursor{CreatedAt: testDay, ID: 3}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name           string
+		params         domain.ListURLsParams
+		buildRepo      func() repository.UrlRepo
+		expectedResult domain.URLList
+		expectedErr    error
+	}{
+		{
+			name:   "Trimmed filters, last page",
+			params: domain.ListURLsParams{Tag: " sale ", DestinationHost: " Example.COM ", Search: " promo ", Page: 1, Limit: 10},
+			buildRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLs", mock.Anything, domain.ListURLsParams{
+					Tag:             "sale",
+					DestinationHost: "example.com",
+					Search:          "promo",
+					Order:           domain.SortNewestFirst,
+					Page:            1,
+					Limit:           11,
+				}).Return(testURLs, nil)
+
+				return mockRepo
+			},
+			expectedResult: domain.URLList{URLs: testURLs},
+		},
+		{
+			name:   "More urls than limit, next cursor points at the last one",
+			params: domain.ListURLsParams{Order: domain.SortOldestFirst, Limit: 1},
+			buildRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLs", mock.Anything, domain.ListURLsParams{
+					Order: domain.SortOldestFirst,
+					Page:  1,
+					Limit: 2,
+				}).Return(testURLs[:2], nil)
+
+				return mockRepo
+			},
+			expectedResult: domain.URLList{URLs: testURLs[:1], NextCursor: testCursor},
+		},
+		{
+			name:   "Cursor overrides page",
+			params: domain.ListURLsParams{Cursor: testCursor, Page: 5, Limit: 2},
+			buildRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLs", mock.Anything, domain.ListURLsParams{
+					Order:  domain.SortNewestFirst,
+					Cursor: testCursor,
+					Page:   1,
+					Limit:  3,
+				}).Return(testURLs[1:], nil)
+
+				return mockRepo
+			},
+			expectedResult: domain.URLList{URLs: testURLs[1:]},
+		},
+		{
+			name:   "Unknown sort order",
+			params: domain.ListURLsParams{Order: "random", Page: 1, Limit: 10},
+			buildRepo: func() repository.UrlRepo {
+				return mocks.NewUrlRepo(t)
+			},
+			expectedErr: errs.ErrInvalidSort,
+		},
+		{
+			name:   "Repo error",
+			params: domain.ListURLsParams{Page: 1, Limit: 10},
+			buildRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLs", mock.Anything, mock.Anything).
+					Return(nil, testErr)
+
+				return mockRepo
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildRepo(),
+				mocks.NewURLCache(t),
+				mocks.NewEventsProducer(t),
+				shortenermocks.NewURLShortener(t),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			urlList, err := urlService.ListURLs(context.Background(), tc.params)
+			assert.Equal(t, tc.expectedResult, urlList)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestGetURLInfo(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testURLData := domain.URLData{ID: 1, ShortUrl: testShortURL, LongUrl: "https://test.longurl"}
+	testMetadata := domain.URLMetadata{Title: "title", FinalURL: "https://test.longurl/"}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name             string
+		metadataErr      error
+		expectedMetadata *domain.URLMetadata
+		expectedErr      error
+	}{
+		{
+			name:             "metadata is fetched",
+			metadataErr:      nil,
+			expectedMetadata: &testMetadata,
+			expectedErr:      nil,
+		},
+		{
+			name:             "metadata is not fetched yet",
+			metadataErr:      errs.ErrNoMetadata,
+			expectedMetadata: nil,
+			expectedErr:      nil,
+		},
+		{
+			name:             "metadata repo error",
+			metadataErr:      testErr,
+			expectedMetadata: nil,
+			expectedErr:      testErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCache := mocks.NewURLCache(t)
+			mockCache.On("GetURLData", mock.Anything, "", testShortURL).
+				Return(testURLData, nil)
+
+			mockRepo := mocks.NewUrlRepo(t)
+			mockRepo.On("GetURLMetadata", mock.Anything, testURLData.ID).
+				Return(testMetadata, tc.metadataErr)
+
+			urlService := NewURLService(
+				logger,
+				mockRepo,
+				mockCache,
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			urlData, err := urlService.GetURLInfo(context.Background(), "", testShortURL)
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr == nil {
+				assert.Equal(t, testURLData.LongUrl, urlData.LongUrl)
+			}
+			assert.Equal(t, tc.expectedMetadata, urlData.Metadata)
+		})
+	}
+}
+
+func TestGetURLInfos(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testKeys := []domain.URLKey{
+		{ShortURL: "short"},
+		{Domain: "go.brand.com", ShortURL: "short"},
+		{ShortURL: "gone"},
+	}
+	testURLs := []domain.URLData{
+		{ID: 1, ShortUrl: "short", LongUrl: "https://test.longurl"},
+		{ID: 2, Domain: "go.brand.com", ShortUrl: "short", LongUrl: "https://brand.longurl"},
+	}
+	testMetadata := domain.URLMetadata{Title: "title", FinalURL: "https://brand.longurl/"}
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		name             string
+		buildRepo        func() *mocks.UrlRepo
+		expectedMetadata []*domain.URLMetadata
+		expectedErr      error
+	}{
+		{
+			name: "metadata is added to the links that have it",
+			buildRepo: func() *mocks.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLData", mock.Anything, testKeys).
+					Return(slices.Clone(testURLs), nil)
+				mockRepo.On("ListURLMetadata", mock.Anything, []int64{1, 2}).
+					Return(map[int64]domain.URLMetadata{2: testMetadata}, nil)
+
+				return mockRepo
+			},
+			expectedMetadata: []*domain.URLMetadata{nil, &testMetadata},
+			expectedErr:      nil,
+		},
+		{
+			name: "no link is found",
+			buildRepo: func() *mocks.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLData", mock.Anything, testKeys).
+					Return([]domain.URLData{}, nil)
+
+				return mockRepo
+			},
+			expectedMetadata: []*domain.URLMetadata{},
+			expectedErr:      nil,
+		},
+		{
+			name: "metadata repo error",
+			buildRepo: func() *mocks.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("ListURLData", mock.Anything, testKeys).
+					Return(slices.Clone(testURLs), nil)
+				mockRepo.On("ListURLMetadata", mock.Anything, []int64{1, 2}).
+					Return(nil, testErr)
+
+				return mockRepo
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			urlService := NewURLService(
+				logger,
+				tc.buildRepo(),
+				mocks.NewURLCache(t),
+				mocks.NewEventsProducer(t),
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			urls, err := urlService.GetURLInfos(context.Background(), testKeys)
+			assert.Equal(t, tc.expectedErr, err)
+			if tc.expectedErr != nil {
+				return
+			}
+
+			metadata := make([]*domain.URLMetadata, len(urls))
+			for i, urlData := range urls {
+				metadata[i] = urlData.Metadata
+			}
+			assert.Equal(t, tc.expectedMetadata, metadata)
+		})
+	}
+}
+
+func TestSaveURLEnqueuesMetadata(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetShortURLByLongURL", mock.Anything, "", testLongURL).
+		Return("", errs.ErrNoURL)
+	mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(false, nil)
+	mockRepo.On("SaveURL", mock.Anything, mock.Anything).
+		Return(nil)
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("SetURLData", mock.Anything, mock.Anything).
+		Return(nil)
+
+	mockEventsServiceProducer := mocks.NewEventsProducer(t)
+
+	mockMetadataFetcher := servicemocks.NewMetadataFetcher(t)
+	mockMetadataFetcher.On("Enqueue", mock.Anything, testLongURL).
+		Once()
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mockEventsServiceProducer,
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		mockMetadataFetcher,
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	_, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{LongURL: testLongURL})
+	assert.NoError(t, err)
+}
+
+func TestSaveURLWithBackup(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testLongURL := "https://test.longurl"
+
+	testCases := []struct {
+		name          string
+		backupURL     string
+		buildURLRepo  func() repository.UrlRepo
+		buildURLCache func() repository.URLCache
+		expectedErr   error
+	}{
+		{
+			name:      "backup url is stored and the link is not shared",
+			backupURL: " https://test.backup ",
+			buildURLRepo: func() repository.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("IsCodeRetired", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(false, nil)
+				mockRepo.On("SaveURL", mock.Anything, mock.MatchedBy(func(urlData domain.URLData) bool {
+					return urlData.LongUrl == testLongURL && urlData.BackupURL == "https://test.backup"
+				})).
+					Return(nil)
+
+				return mockRepo
+			},
+			buildURLCache: func() repository.URLCache {
+				mockCache := mocks.NewURLCache(t)
+				mockCache.On("SetURLData", mock.Anything, mock.Anything).
+					Return(nil)
+
+				return mockCache
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "backup url is not http",
+			backupURL: "javascript:alert(1)",
+			buildURLRepo: func() repository.UrlRepo {
+				return mocks.NewUrlRepo(t)
+			},
+			buildURLCache: func() repository.URLCache {
+				return mocks.NewURLCache(t)
+			},
+			expectedErr: errs.ErrInvalidBackup,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockEventsServiceProducer := mocks.NewEventsProducer(t)
+			mockEventsServiceProducer.On("ProduceEvent", mock.Anything).Return(nil).Maybe()
+
+			urlService := NewURLService(
+				logger,
+				tc.buildURLRepo(),
+				tc.buildURLCache(),
+				mockEventsServiceProducer,
+				shortener.NewBase62UrlShortener(),
+				shortenermocks.NewCodeFilter(t),
+				newTestMetadataFetcher(t),
+				newTestTransactor(t),
+				newTestAuditRepo(t),
+				mocks.NewIdempotencyRepo(t),
+				newTestOutboxRepo(t),
+				testDeleteGracePeriod,
+			)
+
+			_, err := urlService.SaveURL(context.Background(), domain.SaveURLRequest{
+				LongURL:   testLongURL,
+				BackupURL: tc.backupURL,
+			})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestGetURLHealth(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testShortURL := "short"
+	testHealth := domain.URLHealth{StatusCode: 404, ConsecutiveFailures: 3, Flagged: true}
+
+	mockCache := mocks.NewURLCache(t)
+	mockCache.On("GetURLData", mock.Anything, "", testShortURL).
+		Return(domain.URLData{ID: 1, ShortUrl: testShortURL}, nil)
+
+	mockRepo := mocks.NewUrlRepo(t)
+	mockRepo.On("GetURLHealth", mock.Anything, int64(1)).
+		Return(testHealth, nil)
+
+	urlService := NewURLService(
+		logger,
+		mockRepo,
+		mockCache,
+		mocks.NewEventsProducer(t),
+		shortener.NewBase62UrlShortener(),
+		shortenermocks.NewCodeFilter(t),
+		newTestMetadataFetcher(t),
+		newTestTransactor(t),
+		newTestAuditRepo(t),
+		mocks.NewIdempotencyRepo(t),
+		newTestOutboxRepo(t),
+		testDeleteGracePeriod,
+	)
+
+	health, err := urlService.GetURLHealth(context.Background(), "", testShortURL)
+	assert.NoError(t, err)
+	assert.Equal(t, testHealth, health)
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"CoolUrlShortener/internal/repository"
+)
+
+const (
+	purgeBatchSize = 500
+)
+
+type URLCleaner interface {
+	// Run purges links deleted longer than the grace period ago, expired idempotency keys
+	// and published outbox events, right away and then once per interval until ctx is done
+	Run(ctx context.Context)
+}
+
+type urlCleaner struct {
+	logger          *slog.Logger
+	urlRepo         repository.UrlRepo
+	idempotencyRepo repository.IdempotencyRepo
+	outboxRepo      repository.OutboxRepo
+	interval        time.Duration
+	gracePeriod     time.Duration
+}
+
+func NewURLCleaner(
+	logger *slog.Logger,
+	urlRepo repository.UrlRepo,
+	idempotencyRepo repository.IdempotencyRepo,
+	outboxRepo repository.OutboxRepo,
+	interval time.Duration,
+	gracePeriod time.Duration,
+) URLCleaner {
+	return &urlCleaner{
+		logger:          logger,
+		urlRepo:         urlRepo,
+		idempotencyRepo: idempotencyRepo,
+		outboxRepo:      outboxRepo,
+		interval:        interval,
+		gracePeriod:     gracePeriod,
+	}
+}
+
+func (c *urlCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.purgeExpired(ctx)
+		c.deleteExpiredKeys(ctx)
+		c.deleteSentEvents(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purgeExpired deletes in batches, so a single statement does not lock many rows at once
+func (c *urlCleaner) purgeExpired(ctx context.Context) {
+	deletedBefore := time.Now().Add(-c.gracePeriod)
+
+	var total int
+	for ctx.Err() == nil {
+		purged, err := c.urlRepo.PurgeDeletedURLs(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			c.logger.Error(err.Error())
+			return
+		}
+
+		total += purged
+		if purged < purgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		c.logger.Info(fmt.Sprintf("Purged %d deleted urls", total))
+	}
+}
+
+func (c *urlCleaner) deleteExpiredKeys(ctx context.Context) {
+	deleted, err := c.idempotencyRepo.DeleteExpiredIdempotencyKeys(ctx, time.Now().Add(-idempotencyKeyTTL))
+	if err != nil {
+		c.logger.Error(err.Error())
+		return
+	}
+
+	if deleted > 0 {
+		c.logger.Info(fmt.Sprintf("Deleted %d expired idempotency keys", deleted))
+	}
+}
+
+func (c *urlCleaner) deleteSentEvents(ctx context.Context) {
+	deleted, err := c.outboxRepo.DeleteSentOutboxEvents(ctx, time.Now().Add(-sentEventsRetention))
+	if err != nil {
+		c.logger.Error(err.Error())
+		return
+	}
+
+	if deleted > 0 {
+		c.logger.Info(fmt.Sprintf("Deleted %d published outbox events", deleted))
+	}
+}
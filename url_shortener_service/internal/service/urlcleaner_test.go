@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"CoolUrlShortener/internal/repository/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestURLCleanerPurgeExpired(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	gracePeriod := 24 * time.Hour
+	deletedBefore := mock.MatchedBy(func(deletedBefore time.Time) bool {
+		return deletedBefore.Before(time.Now().Add(-gracePeriod + time.Minute))
+	})
+
+	testCases := []struct {
+		name         string
+		buildURLRepo func() *mocks.UrlRepo
+	}{
+		{
+			name: "Full batch. Should purge the next one until a partial batch",
+			buildURLRepo: func() *mocks.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("PurgeDeletedURLs", mock.Anything, deletedBefore, purgeBatchSize).
+					Return(purgeBatchSize, nil).
+					Twice()
+				mockRepo.On("PurgeDeletedURLs", mock.Anything, deletedBefore, purgeBatchSize).
+					Return(3, nil).
+					Once()
+
+				return mockRepo
+			},
+		},
+		{
+			name: "Purge fails. Should stop until the next run",
+			buildURLRepo: func() *mocks.UrlRepo {
+				mockRepo := mocks.NewUrlRepo(t)
+				mockRepo.On("PurgeDeletedURLs", mock.Anything, deletedBefore, purgeBatchSize).
+					Return(0, errors.New("test error")).
+					Once()
+
+				return mockRepo
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cleaner := NewURLCleaner(logger, tc.buildURLRepo(), mocks.NewIdempotencyRepo(t), mocks.NewOutboxRepo(t), time.Hour, gracePeriod)
+			cleaner.(*urlCleaner).purgeExpired(context.Background())
+		})
+	}
+}
+
+func TestURLCleanerDeleteExpiredKeys(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	mockIdempotencyRepo := mocks.NewIdempotencyRepo(t)
+	mockIdempotencyRepo.On("DeleteExpiredIdempotencyKeys", mock.Anything, mock.MatchedBy(func(expiredBefore time.Time) bool {
+		return expiredBefore.Before(time.Now().Add(-idempotencyKeyTTL + time.Minute))
+	})).
+		Return(2, nil).
+		Once()
+
+	cleaner := NewURLCleaner(logger, mocks.NewUrlRepo(t), mockIdempotencyRepo, mocks.NewOutboxRepo(t), time.Hour, 24*time.Hour)
+	cleaner.(*urlCleaner).deleteExpiredKeys(context.Background())
+}
+
+func TestURLCleanerDeleteSentEvents(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+
+	mockOutboxRepo := mocks.NewOutboxRepo(t)
+	mockOutboxRepo.On("DeleteSentOutboxEvents", mock.Anything, mock.MatchedBy(func(sentBefore time.Time) bool {
+		return sentBefore.Before(time.Now().Add(-sentEventsRetention + time.Minute))
+	})).
+		Return(3, nil).
+		Once()
+
+	cleaner := NewURLCleaner(logger, mocks.NewUrlRepo(t), mocks.NewIdempotencyRepo(t), mockOutboxRepo, time.Hour, 24*time.Hour)
+	cleaner.(*urlCleaner).deleteSentEvents(context.Background())
+}
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Metadata keys set by the api gateway for the user behind a request
+const (
+	actorMetadataKey    = "x-actor"
+	sourceIPMetadataKey = "x-source-ip"
+)
+
+// ActorInterceptor puts the actor of a request in the context for the audit log.
+// Without the gateway metadata the peer address is used as the source ip
+func ActorInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	return handler(service.WithActor(ctx, actorFromMetadata(ctx)), req)
+}
+
+func actorFromMetadata(ctx context.Context) domain.Actor {
+	var actor domain.Actor
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(actorMetadataKey); len(values) > 0 {
+		actor.Name = values[0]
+	}
+	if values := md.Get(sourceIPMetadataKey); len(values) > 0 {
+		actor.IP = values[0]
+	}
+
+	if actor.IP == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			actor.IP = p.Addr.String()
+			if host, _, err := net.SplitHostPort(actor.IP); err == nil {
+				actor.IP = host
+			}
+		}
+	}
+
+	return actor
+}
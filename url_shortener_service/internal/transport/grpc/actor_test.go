@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/service"
+	"CoolUrlShortener/internal/service/mocks"
+	url "CoolUrlShortener/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestActorInterceptor(t *testing.T) {
+	logger := slog.New(
+		slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+	)
+	testActor := domain.Actor{Name: "alice", IP: "203.0.113.7"}
+
+	mockService := mocks.NewURLService(t)
+	mockService.On("SaveURL", mock.MatchedBy(func(ctx context.Context) bool {
+		return service.ActorFromContext(ctx) == testActor
+	}), mock.Anything).
+		Return("short", nil)
+
+	urlClient, cancel := initUrlClient(logger, mockService)
+	defer cancel()
+
+	ctx := metadata.AppendToOutgoingContext(
+		context.Background(),
+		actorMetadataKey, testActor.Name,
+		sourceIPMetadataKey, testActor.IP,
+	)
+	_, err := urlClient.ShortenUrl(ctx, &url.LongUrlRequest{LongUrl: "https://test.long"})
+	assert.NoError(t, err)
+}
+
+func TestActorFromMetadata(t *testing.T) {
+	testPeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 50051}}
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		expectedActor domain.Actor
+	}{
+		{
+			name: "Actor and ip from gateway metadata",
+			ctx: peer.NewContext(
+				metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+					actorMetadataKey, "alice",
+					sourceIPMetadataKey, "203.0.113.7",
+				)),
+				testPeer,
+			),
+			expectedActor: domain.Actor{Name: "alice", IP: "203.0.113.7"},
+		},
+		{
+			name:          "Anonymous request falls back to the peer address",
+			ctx:           peer.NewContext(context.Background(), testPeer),
+			expectedActor: domain.Actor{IP: "10.0.0.2"},
+		},
+		{
+			name:          "No metadata and no peer",
+			ctx:           context.Background(),
+			expectedActor: domain.Actor{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedActor, actorFromMetadata(tc.ctx))
+		})
+	}
+}
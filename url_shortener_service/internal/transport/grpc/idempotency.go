@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// idempotencyKeyMetadataKey is set by the api gateway from the Idempotency-Key header
+	idempotencyKeyMetadataKey = "idempotency-key"
+	maxIdempotencyKeyLen      = 255
+)
+
+func idempotencyKeyFromMetadata(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(idempotencyKeyMetadataKey); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+
+	"CoolUrlShortener/internal/domain"
+	"CoolUrlShortener/internal/errs"
+	"CoolUrlShortener/internal/service/mocks"
+	url "CoolUrlShortener/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestShortenUrlIdempotencyKey(t *testing.T) {
+	testLongUrl := "https://test.long"
+
+	testCases := []struct {
+		name           string
+		idempotencyKey string
+		buildService   func() *mocks.URLService
+		expectedCode   codes.Code
+	}{
+		{
+			name:           "key is passed to the service. 0 OK",
+			idempotencyKey: "retry-1",
+			buildService: func() *mocks.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, mock.MatchedBy(func(req domain.SaveURLRequest) bool {
+					return req.IdempotencyKey == "retry-1"
+				})).
+					Return("short", nil)
+
+				return mockService
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:           "key reused with another request. 9 FailedPrecondition",
+			idempotencyKey: "retry-1",
+			buildService: func() *mocks.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, mock.Anything).
+					Return("", errs.ErrKeyReused)
+
+				return mockService
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:           "key is too long. 3 InvalidArgument",
+			idempotencyKey: strings.Repeat("k", maxIdempotencyKeyLen+1),
+			buildService: func() *mocks.URLService {
+				return mocks.NewURLService(t)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildService())
+			defer cancel()
+
+			ctx := metadata.AppendToOutgoingContext(context.Background(), idempotencyKeyMetadataKey, tc.idempotencyKey)
+			_, err := urlClient.ShortenUrl(ctx, &url.LongUrlRequest{LongUrl: testLongUrl})
+			assert.Equal(t, tc.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -2,9 +2,16 @@ package grpc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
+	"CoolUrlShortener/internal/domain"
 	"CoolUrlShortener/internal/errs"
 	"CoolUrlShortener/internal/service"
 	url "CoolUrlShortener/pkg/proto"
@@ -12,6 +19,14 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	redirectTypeRedirect    = "redirect"
+	redirectTypeLandingPage = "landing_page"
+
+	urlStatusActive  = "active"
+	urlStatusFlagged = "flagged"
+)
+
 type UrlServer struct {
 	logger     *slog.Logger
 	urlService service.URLService
@@ -34,9 +49,33 @@ func (s *UrlServer) ShortenUrl(ctx context.Context, req *url.LongUrlRequest) (*u
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	shortURL, err := s.urlService.SaveURL(ctx, req.LongUrl)
+	idempotencyKey := idempotencyKeyFromMetadata(ctx)
+	if len(idempotencyKey) > maxIdempotencyKeyLen {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key is too long")
+	}
+
+	shortURL, err := s.urlService.SaveURL(ctx, domain.SaveURLRequest{
+		Domain:    req.Domain,
+		LongURL:   req.LongUrl,
+		BackupURL: req.BackupUrl,
+		Alias:     req.Alias,
+		Labels: domain.URLLabels{
+			Tags:       req.Tags,
+			CampaignID: req.CampaignId,
+		},
+		IdempotencyKey: idempotencyKey,
+	})
 	if err != nil {
 		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrReservedCode) || errors.Is(err, errs.ErrInvalidBackup) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, errs.ErrAliasTaken) || errors.Is(err, errs.ErrCodeRetired) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, errs.ErrKeyReused) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -52,7 +91,12 @@ func (s *UrlServer) FollowUrl(ctx context.Context, req *url.ShortUrlRequest) (*u
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	longUrl, err := s.urlService.GetLongURL(ctx, req.ShortUrl)
+	followURL := s.urlService.FollowURL
+	if req.Preview {
+		followURL = s.urlService.PreviewURL
+	}
+
+	urlData, err := followURL(ctx, req.Domain, req.ShortUrl, mapClickContext(req.Metadata))
 	if err != nil {
 		s.logger.Error(err.Error())
 		if errors.Is(err, errs.ErrNoURL) {
@@ -61,7 +105,506 @@ func (s *UrlServer) FollowUrl(ctx context.Context, req *url.ShortUrlRequest) (*u
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	return &url.LongUrlResponse{
+		LongUrl:     urlData.LongUrl,
+		LandingPage: mapLandingPage(urlData.Landing),
+		Preview:     mapPreview(urlData.Preview),
+		BackupUrl:   urlData.BackupURL,
+		Flagged:     urlData.Flagged,
+	}, nil
+}
+
+func (s *UrlServer) ListUrls(ctx context.Context, req *url.ListUrlsRequest) (*url.ListUrlsResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := domain.ListURLsParams{
+		Domain:          req.Domain,
+		Tag:             req.Tag,
+		CampaignID:      req.CampaignId,
+		DestinationHost: req.DestinationDomain,
+		Search:          req.Search,
+		CreatedFrom:     unixDay(req.CreatedFrom),
+		CreatedTo:       unixDay(req.CreatedTo),
+		Order:           domain.SortOrder(req.Order),
+		Page:            int(req.Page),
+		Limit:           int(req.Limit),
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		params.Cursor = &cursor
+	}
+
+	urlList, err := s.urlService.ListURLs(ctx, params)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrInvalidSort) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	urlInfos := make([]*url.UrlInfo, len(urlList.URLs))
+	for i, urlData := range urlList.URLs {
+		urlInfos[i] = mapURLInfo(urlData)
+	}
+
+	resp := &url.ListUrlsResponse{
+		Urls: urlInfos,
+	}
+	if urlList.NextCursor != nil {
+		resp.NextCursor = encodeCursor(*urlList.NextCursor)
+	}
+
+	return resp, nil
+}
+
+func (s *UrlServer) CreateLandingPage(ctx context.Context, req *url.LandingPageRequest) (*url.UrlDataResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items := make([]domain.LandingItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = domain.LandingItem{
+			Title: item.Title,
+			URL:   item.Url,
+		}
+	}
+
+	shortURL, err := s.urlService.SaveURL(ctx, domain.SaveURLRequest{
+		Domain: req.Domain,
+		Alias:  req.Alias,
+		Landing: &domain.LandingPage{
+			Title: req.Title,
+			Items: items,
+		},
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrReservedCode) || errors.Is(err, errs.ErrInvalidLanding) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, errs.ErrAliasTaken) || errors.Is(err, errs.ErrCodeRetired) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &url.UrlDataResponse{
+		ShortUrl: shortURL,
+	}, nil
+}
+
+func (s *UrlServer) FollowLandingItem(ctx context.Context, req *url.LandingItemRequest) (*url.LongUrlResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	longUrl, err := s.urlService.FollowLandingItem(
+		ctx, req.Domain, req.ShortUrl, int(req.Position), mapClickContext(req.Metadata),
+	)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "landing item not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &url.LongUrlResponse{
 		LongUrl: longUrl,
 	}, nil
 }
+
+func mapClickContext(metadata *url.ClickMetadata) domain.ClickContext {
+	return domain.ClickContext{
+		Referrer:       metadata.GetReferrer(),
+		UserAgent:      metadata.GetUserAgent(),
+		IP:             metadata.GetIp(),
+		AcceptLanguage: metadata.GetAcceptLanguage(),
+		Host:           metadata.GetHost(),
+		DeviceType:     metadata.GetDeviceType(),
+		OSFamily:       metadata.GetOsFamily(),
+		BrowserFamily:  metadata.GetBrowserFamily(),
+		Bot:            metadata.GetBot(),
+		BotScore:       int(metadata.GetBotScore()),
+	}
+}
+
+func mapLandingPage(landing *domain.LandingPage) *url.LandingPage {
+	if landing == nil {
+		return nil
+	}
+
+	items := make([]*url.LandingItem, len(landing.Items))
+	for i, item := range landing.Items {
+		items[i] = &url.LandingItem{
+			Title: item.Title,
+			Url:   item.URL,
+		}
+	}
+
+	return &url.LandingPage{
+		Title: landing.Title,
+		Items: items,
+	}
+}
+
+func (s *UrlServer) UpdateUrlPreview(ctx context.Context, req *url.UrlPreviewRequest) (*url.UrlPreview, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	preview := domain.URLPreview{
+		Title:       req.Title,
+		Description: req.Description,
+		ImageURL:    req.ImageUrl,
+	}
+	preview, err = s.urlService.UpdatePreview(ctx, req.Domain, req.ShortUrl, preview)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrInvalidPreview) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return mapPreview(&preview), nil
+}
+
+func mapPreview(preview *domain.URLPreview) *url.UrlPreview {
+	if preview == nil {
+		return nil
+	}
+
+	return &url.UrlPreview{
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+	}
+}
+
+func (s *UrlServer) GetUrlInfo(ctx context.Context, req *url.UrlInfoRequest) (*url.UrlInfoResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	urlData, err := s.urlService.GetURLInfo(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &url.UrlInfoResponse{
+		Url:      mapURLInfo(urlData),
+		Metadata: mapMetadata(urlData.Metadata),
+	}, nil
+}
+
+func (s *UrlServer) GetUrlInfos(ctx context.Context, req *url.UrlInfosRequest) (*url.UrlInfosResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	keys := make([]domain.URLKey, len(req.Urls))
+	for i, urlReq := range req.Urls {
+		keys[i] = domain.URLKey{Domain: urlReq.Domain, ShortURL: urlReq.ShortUrl}
+	}
+
+	urls, err := s.urlService.GetURLInfos(ctx, keys)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	urlInfos := make([]*url.UrlInfoResponse, len(urls))
+	for i, urlData := range urls {
+		urlInfos[i] = &url.UrlInfoResponse{
+			Url:      mapURLInfo(urlData),
+			Metadata: mapMetadata(urlData.Metadata),
+		}
+	}
+
+	return &url.UrlInfosResponse{Urls: urlInfos}, nil
+}
+
+// ExpandUrl never counts a follow, so link scanners do not inflate the stats
+func (s *UrlServer) ExpandUrl(ctx context.Context, req *url.UrlInfoRequest) (*url.ExpandUrlResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	urlData, err := s.urlService.GetURLInfo(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	redirectType := redirectTypeRedirect
+	if urlData.Landing != nil {
+		redirectType = redirectTypeLandingPage
+	}
+	urlStatus := urlStatusActive
+	if urlData.Flagged {
+		urlStatus = urlStatusFlagged
+	}
+
+	return &url.ExpandUrlResponse{
+		Url:          mapURLInfo(urlData),
+		Metadata:     mapMetadata(urlData.Metadata),
+		RedirectType: redirectType,
+		Status:       urlStatus,
+		LandingPage:  mapLandingPage(urlData.Landing),
+	}, nil
+}
+
+func mapURLInfo(urlData domain.URLData) *url.UrlInfo {
+	return &url.UrlInfo{
+		LongUrl:    urlData.LongUrl,
+		ShortUrl:   urlData.ShortUrl,
+		Tags:       urlData.Labels.Tags,
+		CampaignId: urlData.Labels.CampaignID,
+		CreatedAt:  urlData.CreatedAt.Unix(),
+		Domain:     urlData.Domain,
+	}
+}
+
+func mapMetadata(metadata *domain.URLMetadata) *url.UrlMetadata {
+	if metadata == nil {
+		return nil
+	}
+
+	return &url.UrlMetadata{
+		Title:       metadata.Title,
+		Description: metadata.Description,
+		FaviconUrl:  metadata.FaviconURL,
+		FinalUrl:    metadata.FinalURL,
+		FetchedAt:   metadata.FetchedAt.Unix(),
+	}
+}
+
+func (s *UrlServer) GetUrlHealth(ctx context.Context, req *url.UrlHealthRequest) (*url.UrlHealth, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	health, err := s.urlService.GetURLHealth(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Zero times stay zero instead of turning into a negative unix time
+	var checkedAt, lastSuccessAt int64
+	if !health.CheckedAt.IsZero() {
+		checkedAt = health.CheckedAt.Unix()
+	}
+	if !health.LastSuccessAt.IsZero() {
+		lastSuccessAt = health.LastSuccessAt.Unix()
+	}
+
+	return &url.UrlHealth{
+		StatusCode:          int32(health.StatusCode),
+		LatencyMs:           health.Latency.Milliseconds(),
+		CheckedAt:           checkedAt,
+		LastSuccessAt:       lastSuccessAt,
+		ConsecutiveFailures: int32(health.ConsecutiveFailures),
+		Flagged:             health.Flagged,
+	}, nil
+}
+
+func (s *UrlServer) GetUrlAuditLog(ctx context.Context, req *url.UrlAuditLogRequest) (*url.UrlAuditLogResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	records, err := s.urlService.GetURLAuditLog(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pbRecords := make([]*url.AuditRecord, len(records))
+	for i, record := range records {
+		pbRecords[i], err = mapAuditRecord(record)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &url.UrlAuditLogResponse{
+		Records: pbRecords,
+	}, nil
+}
+
+func (s *UrlServer) DeleteUrl(ctx context.Context, req *url.UrlInfoRequest) (*url.DeleteUrlResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	restorableUntil, err := s.urlService.DeleteURL(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &url.DeleteUrlResponse{
+		RestorableUntil: restorableUntil.Unix(),
+	}, nil
+}
+
+func (s *UrlServer) RestoreUrl(ctx context.Context, req *url.UrlInfoRequest) (*url.UrlInfo, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	urlData, err := s.urlService.RestoreURL(ctx, req.Domain, req.ShortUrl)
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "deleted short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return mapURLInfo(urlData), nil
+}
+
+func (s *UrlServer) EraseUrlData(ctx context.Context, req *url.EraseUrlDataRequest) (*url.EraseUrlDataResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := s.urlService.EraseURLData(ctx, domain.ErasureRequest{
+		Domain:   req.Domain,
+		ShortURL: req.GetShortUrl(),
+		Owner:    req.GetOwner(),
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		if errors.Is(err, errs.ErrNoURL) {
+			return nil, status.Error(codes.NotFound, "short url not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	erasedURLs := make([]*url.ErasedUrl, len(report.URLs))
+	for i, erasedURL := range report.URLs {
+		erasedURLs[i] = &url.ErasedUrl{
+			Domain:   erasedURL.Domain,
+			ShortUrl: erasedURL.ShortURL,
+		}
+	}
+
+	return &url.EraseUrlDataResponse{
+		Urls:                 erasedURLs,
+		LinksDeleted:         int64(report.LinksDeleted),
+		AuditRecordsRedacted: int64(report.AuditRecordsRedacted),
+		OutboxEventsDeleted:  int64(report.OutboxEventsDeleted),
+	}, nil
+}
+
+func mapAuditRecord(record domain.AuditRecord) (*url.AuditRecord, error) {
+	oldValue, err := marshalAuditValue(record.OldValue)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := marshalAuditValue(record.NewValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &url.AuditRecord{
+		Action:    string(record.Action),
+		Actor:     record.Actor.Name,
+		SourceIp:  record.Actor.IP,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+		CreatedAt: record.CreatedAt.Unix(),
+	}, nil
+}
+
+// marshalAuditValue leaves a missing value empty instead of "null"
+func marshalAuditValue(value map[string]any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+
+	raw, err := json.Marshal(value)
+	return string(raw), err
+}
+
+// unixDay treats zero as an unset bound
+func unixDay(seconds int64) *time.Time {
+	if seconds == 0 {
+		return nil
+	}
+
+	day := time.Unix(seconds, 0).UTC()
+	return &day
+}
+
+// encodeCursor keeps the cursor opaque for clients, they only pass it back.
+// created_at is kept in microseconds, the precision postgres stores it with
+func encodeCursor(cursor domain.URLCursor) string {
+	raw := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(encoded string) (domain.URLCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return domain.URLCursor{}, errs.ErrInvalidCursor
+	}
+
+	createdAtRaw, idRaw, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return domain.URLCursor{}, errs.ErrInvalidCursor
+	}
+	createdAt, err := strconv.ParseInt(createdAtRaw, 10, 64)
+	if err != nil {
+		return domain.URLCursor{}, errs.ErrInvalidCursor
+	}
+	id, err := strconv.ParseInt(idRaw, 10, 64)
+	if err != nil {
+		return domain.URLCursor{}, errs.ErrInvalidCursor
+	}
+
+	return domain.URLCursor{CreatedAt: time.UnixMicro(createdAt).UTC(), ID: id}, nil
+}
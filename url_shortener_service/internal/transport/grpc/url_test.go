@@ -8,7 +8,9 @@ import (
 	"net"
 	"os"
 	"testing"
+	"time"
 
+	"CoolUrlShortener/internal/domain"
 	"CoolUrlShortener/internal/errs"
 	"CoolUrlShortener/internal/service"
 	"CoolUrlShortener/internal/service/mocks"
@@ -33,7 +35,7 @@ func initUrlClient(
 		logger, urlService,
 	)
 
-	baseServer := grpc.NewServer()
+	baseServer := grpc.NewServer(grpc.UnaryInterceptor(ActorInterceptor))
 
 	url.RegisterUrlServer(baseServer, urlServer)
 	go func() {
@@ -131,6 +133,129 @@ func TestShortenUrl(t *testing.T) {
 			isErrExpected: true,
 			expectedCode:  codes.Internal,
 		},
+		{
+			name: "alias is reserved. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, domain.SaveURLRequest{LongURL: testLongUrl, Alias: "api"}).
+					Return("", errs.ErrReservedCode)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Alias:   "api",
+			},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "alias is taken. 6 AlreadyExists",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, domain.SaveURLRequest{LongURL: testLongUrl, Alias: "taken"}).
+					Return("", errs.ErrAliasTaken)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Alias:   "taken",
+			},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.AlreadyExists,
+		},
+		{
+			name: "alias was retired. 6 AlreadyExists",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, domain.SaveURLRequest{LongURL: testLongUrl, Alias: "retired"}).
+					Return("", errs.ErrCodeRetired)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Alias:   "retired",
+			},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.AlreadyExists,
+		},
+		{
+			name: "alias has forbidden characters. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Alias:   "a/b/c",
+			},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "shorten url with labels. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				labels := domain.URLLabels{Tags: []string{"sale"}, CampaignID: "spring"}
+				mockService.On("SaveURL", mock.Anything, domain.SaveURLRequest{LongURL: testLongUrl, Labels: labels}).
+					Return(testShortUrl, nil)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl:    testLongUrl,
+				Tags:       []string{"sale"},
+				CampaignId: "spring",
+			},
+			expectedResp: &url.UrlDataResponse{
+				LongUrl:  testLongUrl,
+				ShortUrl: testShortUrl,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "shorten url on branded domain. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, domain.SaveURLRequest{Domain: "go.brand.com", LongURL: testLongUrl}).
+					Return(testShortUrl, nil)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Domain:  "go.brand.com",
+			},
+			expectedResp: &url.UrlDataResponse{
+				LongUrl:  testLongUrl,
+				ShortUrl: testShortUrl,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "tag is empty. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request: &url.LongUrlRequest{
+				LongUrl: testLongUrl,
+				Tags:    []string{""},
+			},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -178,8 +303,8 @@ func TestFollowUrl(t *testing.T) {
 			name: "get long url without error. 0 OK",
 			buildUrlService: func() service.URLService {
 				mockService := mocks.NewURLService(t)
-				mockService.On("GetLongURL", mock.Anything, mock.Anything).
-					Return(testLongUrl, nil)
+				mockService.On("FollowURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{ShortUrl: testShortUrl, LongUrl: testLongUrl}, nil)
 
 				return mockService
 			},
@@ -188,12 +313,58 @@ func TestFollowUrl(t *testing.T) {
 			isErrExpected: false,
 			expectedCode:  codes.OK,
 		},
+		{
+			name: "crawler gets preview without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				preview := &domain.URLPreview{Title: "title", Description: "description"}
+				click := domain.ClickContext{UserAgent: "Twitterbot/1.0", IP: "203.0.113.0", Host: "sho.rt"}
+				mockService.On("PreviewURL", mock.Anything, mock.Anything, testShortUrl, click).
+					Return(domain.URLData{ShortUrl: testShortUrl, LongUrl: testLongUrl, Preview: preview}, nil)
+
+				return mockService
+			},
+			request: &url.ShortUrlRequest{
+				ShortUrl: testShortUrl,
+				Preview:  true,
+				Metadata: &url.ClickMetadata{UserAgent: "Twitterbot/1.0", Ip: "203.0.113.0", Host: "sho.rt"},
+			},
+			expectedResp: &url.LongUrlResponse{
+				LongUrl: testLongUrl,
+				Preview: &url.UrlPreview{Title: "title", Description: "description"},
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "get landing page without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				landing := &domain.LandingPage{
+					Title: "bio",
+					Items: []domain.LandingItem{{Title: "shop", URL: testLongUrl}},
+				}
+				mockService.On("FollowURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{ShortUrl: testShortUrl, Landing: landing}, nil)
+
+				return mockService
+			},
+			request: &url.ShortUrlRequest{ShortUrl: testShortUrl},
+			expectedResp: &url.LongUrlResponse{
+				LandingPage: &url.LandingPage{
+					Title: "bio",
+					Items: []*url.LandingItem{{Title: "shop", Url: testLongUrl}},
+				},
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
 		{
 			name: "url not found . 5 Not found",
 			buildUrlService: func() service.URLService {
 				mockService := mocks.NewURLService(t)
-				mockService.On("GetLongURL", mock.Anything, mock.Anything).
-					Return("", errs.ErrNoURL)
+				mockService.On("FollowURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errs.ErrNoURL)
 
 				return mockService
 			},
@@ -206,8 +377,8 @@ func TestFollowUrl(t *testing.T) {
 			name: "get long url while internal error. 13 Internal",
 			buildUrlService: func() service.URLService {
 				mockService := mocks.NewURLService(t)
-				mockService.On("GetLongURL", mock.Anything, mock.Anything).
-					Return("", testErr)
+				mockService.On("FollowURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, testErr)
 
 				return mockService
 			},
@@ -252,6 +423,1218 @@ func TestFollowUrl(t *testing.T) {
 			}
 
 			assert.Equal(t, tc.expectedResp.LongUrl, resp.LongUrl)
+			assert.Equal(t, tc.expectedResp.Preview.GetTitle(), resp.Preview.GetTitle())
+			assert.Equal(t, tc.expectedResp.Preview.GetDescription(), resp.Preview.GetDescription())
+			assert.Equal(t, tc.expectedResp.LandingPage.GetTitle(), resp.LandingPage.GetTitle())
+			assert.Equal(t, len(tc.expectedResp.LandingPage.GetItems()), len(resp.LandingPage.GetItems()))
+			for i := range tc.expectedResp.LandingPage.GetItems() {
+				assert.Equal(t, tc.expectedResp.LandingPage.Items[i].Title, resp.LandingPage.Items[i].Title)
+				assert.Equal(t, tc.expectedResp.LandingPage.Items[i].Url, resp.LandingPage.Items[i].Url)
+			}
+		})
+	}
+}
+
+func TestListUrls(t *testing.T) {
+	testErr := errors.New("test error")
+	testURLs := []domain.URLData{
+		{
+			ShortUrl:  "short",
+			LongUrl:   "http://test.long",
+			CreatedAt: time.Unix(1700000000, 0),
+			Labels:    domain.URLLabels{Tags: []string{"sale"}, CampaignID: "spring"},
+		},
+	}
+
+	testCursor := domain.URLCursor{CreatedAt: time.UnixMicro(1700000000123456).UTC(), ID: 42}
+	testEncodedCursor := encodeCursor(testCursor)
+	testDay := time.Unix(1709251200, 0).UTC()
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.ListUrlsRequest
+		expectedResp    *url.ListUrlsResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "list urls by tag without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("ListURLs", mock.Anything, domain.ListURLsParams{Tag: "sale", Page: 1, Limit: 10}).
+					Return(domain.URLList{URLs: testURLs}, nil)
+
+				return mockService
+			},
+			request: &url.ListUrlsRequest{Tag: "sale", Page: 1, Limit: 10},
+			expectedResp: &url.ListUrlsResponse{
+				Urls: []*url.UrlInfo{
+					{
+						LongUrl:    "http://test.long",
+						ShortUrl:   "short",
+						Tags:       []string{"sale"},
+						CampaignId: "spring",
+						CreatedAt:  1700000000,
+					},
+				},
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "search by cursor and filters with next page. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("ListURLs", mock.Anything, domain.ListURLsParams{
+					DestinationHost: "example.com",
+					Search:          "promo",
+					CreatedFrom:     &testDay,
+					Order:           domain.SortOldestFirst,
+					Cursor:          &testCursor,
+					Limit:           1,
+				}).Return(domain.URLList{URLs: testURLs, NextCursor: &testCursor}, nil)
+
+				return mockService
+			},
+			request: &url.ListUrlsRequest{
+				DestinationDomain: "example.com",
+				Search:            "promo",
+				CreatedFrom:       1709251200,
+				Order:             "asc",
+				Cursor:            testEncodedCursor,
+				Limit:             1,
+			},
+			expectedResp: &url.ListUrlsResponse{
+				Urls: []*url.UrlInfo{
+					{
+						LongUrl:    "http://test.long",
+						ShortUrl:   "short",
+						Tags:       []string{"sale"},
+						CampaignId: "spring",
+						CreatedAt:  1700000000,
+					},
+				},
+				NextCursor: testEncodedCursor,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "broken cursor. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request:       &url.ListUrlsRequest{Cursor: "not a cursor", Limit: 10},
+			expectedResp:  &url.ListUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "unknown sort order. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("ListURLs", mock.Anything, mock.Anything).
+					Return(domain.URLList{}, errs.ErrInvalidSort)
+
+				return mockService
+			},
+			request:       &url.ListUrlsRequest{Order: "up", Limit: 10},
+			expectedResp:  &url.ListUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "limit is too big. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request:       &url.ListUrlsRequest{Page: 1, Limit: 1000},
+			expectedResp:  &url.ListUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "list urls while internal error. 13 Internal",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("ListURLs", mock.Anything, mock.Anything).
+					Return(domain.URLList{}, testErr)
+
+				return mockService
+			},
+			request:       &url.ListUrlsRequest{Page: 1, Limit: 10},
+			expectedResp:  &url.ListUrlsResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.ListUrls(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.Urls), len(resp.Urls))
+			for i := range tc.expectedResp.Urls {
+				assert.Equal(t, tc.expectedResp.Urls[i].ShortUrl, resp.Urls[i].ShortUrl)
+				assert.Equal(t, tc.expectedResp.Urls[i].LongUrl, resp.Urls[i].LongUrl)
+				assert.Equal(t, tc.expectedResp.Urls[i].Tags, resp.Urls[i].Tags)
+				assert.Equal(t, tc.expectedResp.Urls[i].CampaignId, resp.Urls[i].CampaignId)
+				assert.Equal(t, tc.expectedResp.Urls[i].CreatedAt, resp.Urls[i].CreatedAt)
+			}
+			assert.Equal(t, tc.expectedResp.NextCursor, resp.NextCursor)
+		})
+	}
+}
+
+func TestCreateLandingPage(t *testing.T) {
+	testShortUrl := "short"
+	testItems := []*url.LandingItem{{Title: "shop", Url: "http://test.shop"}}
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.LandingPageRequest
+		expectedResp    *url.UrlDataResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "create landing page without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				req := domain.SaveURLRequest{
+					Landing: &domain.LandingPage{
+						Title: "bio",
+						Items: []domain.LandingItem{{Title: "shop", URL: "http://test.shop"}},
+					},
+				}
+				mockService.On("SaveURL", mock.Anything, req).
+					Return(testShortUrl, nil)
+
+				return mockService
+			},
+			request:       &url.LandingPageRequest{Title: "bio", Items: testItems},
+			expectedResp:  &url.UrlDataResponse{ShortUrl: testShortUrl},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "landing page without items. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request:       &url.LandingPageRequest{Title: "bio"},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "item url is rejected by service. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, mock.Anything).
+					Return("", errs.ErrInvalidLanding)
+
+				return mockService
+			},
+			request:       &url.LandingPageRequest{Title: "bio", Items: []*url.LandingItem{{Title: "shop", Url: "ftp://test.shop"}}},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "alias is already taken. 6 AlreadyExists",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("SaveURL", mock.Anything, mock.Anything).
+					Return("", errs.ErrAliasTaken)
+
+				return mockService
+			},
+			request:       &url.LandingPageRequest{Title: "bio", Items: testItems, Alias: "taken"},
+			expectedResp:  &url.UrlDataResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.AlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.CreateLandingPage(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.ShortUrl, resp.ShortUrl)
+		})
+	}
+}
+
+func TestFollowLandingItem(t *testing.T) {
+	testItemUrl := "http://test.shop"
+	testShortUrl := "short"
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.LandingItemRequest
+		expectedResp    *url.LongUrlResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "follow landing item without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("FollowLandingItem", mock.Anything, "", testShortUrl, 1, domain.ClickContext{}).
+					Return(testItemUrl, nil)
+
+				return mockService
+			},
+			request:       &url.LandingItemRequest{ShortUrl: testShortUrl, Position: 1},
+			expectedResp:  &url.LongUrlResponse{LongUrl: testItemUrl},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "landing item not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("FollowLandingItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return("", errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.LandingItemRequest{ShortUrl: testShortUrl, Position: 5},
+			expectedResp:  &url.LongUrlResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name: "negative position. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+
+				return mockService
+			},
+			request:       &url.LandingItemRequest{ShortUrl: testShortUrl, Position: -1},
+			expectedResp:  &url.LongUrlResponse{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.FollowLandingItem(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.LongUrl, resp.LongUrl)
+		})
+	}
+}
+
+func TestUpdateUrlPreview(t *testing.T) {
+	testShortUrl := "short"
+	testPreview := domain.URLPreview{Title: "title", Description: "description", ImageURL: "http://test.image"}
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlPreviewRequest
+		expectedResp    *url.UrlPreview
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "update preview without error. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("UpdatePreview", mock.Anything, "", testShortUrl, testPreview).
+					Return(testPreview, nil)
+
+				return mockService
+			},
+			request: &url.UrlPreviewRequest{
+				ShortUrl:    testShortUrl,
+				Title:       "title",
+				Description: "description",
+				ImageUrl:    "http://test.image",
+			},
+			expectedResp:  &url.UrlPreview{Title: "title", Description: "description", ImageUrl: "http://test.image"},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "image is rejected by service. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("UpdatePreview", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLPreview{}, errs.ErrInvalidPreview)
+
+				return mockService
+			},
+			request:       &url.UrlPreviewRequest{ShortUrl: testShortUrl, ImageUrl: "ftp://test.image"},
+			expectedResp:  &url.UrlPreview{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("UpdatePreview", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLPreview{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlPreviewRequest{ShortUrl: testShortUrl, Title: "title"},
+			expectedResp:  &url.UrlPreview{},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.UpdateUrlPreview(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.Title, resp.Title)
+			assert.Equal(t, tc.expectedResp.Description, resp.Description)
+			assert.Equal(t, tc.expectedResp.ImageUrl, resp.ImageUrl)
+		})
+	}
+}
+
+func TestGetUrlInfo(t *testing.T) {
+	testShortUrl := "short"
+	testLongUrl := "http://test.url"
+	testFetchedAt := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name             string
+		buildUrlService  func() service.URLService
+		request          *url.UrlInfoRequest
+		expectedMetadata *url.UrlMetadata
+		isErrExpected    bool
+		expectedCode     codes.Code
+	}{
+		{
+			name: "url with metadata. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, "", testShortUrl).
+					Return(domain.URLData{
+						ShortUrl: testShortUrl,
+						LongUrl:  testLongUrl,
+						Metadata: &domain.URLMetadata{
+							Title:      "title",
+							FaviconURL: "http://test.url/favicon.ico",
+							FinalURL:   "https://test.url/",
+							FetchedAt:  testFetchedAt,
+						},
+					}, nil)
+
+				return mockService
+			},
+			request: &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedMetadata: &url.UrlMetadata{
+				Title:      "title",
+				FaviconUrl: "http://test.url/favicon.ico",
+				FinalUrl:   "https://test.url/",
+				FetchedAt:  testFetchedAt.Unix(),
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "metadata is not fetched yet. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, "", testShortUrl).
+					Return(domain.URLData{ShortUrl: testShortUrl, LongUrl: testLongUrl}, nil)
+
+				return mockService
+			},
+			request:          &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedMetadata: nil,
+			isErrExpected:    false,
+			expectedCode:     codes.OK,
+		},
+		{
+			name: "empty short url. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlInfoRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.GetUrlInfo(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, testLongUrl, resp.Url.LongUrl)
+			assert.Equal(t, testShortUrl, resp.Url.ShortUrl)
+			if tc.expectedMetadata == nil {
+				assert.Nil(t, resp.Metadata)
+				return
+			}
+			assert.Equal(t, tc.expectedMetadata.Title, resp.Metadata.Title)
+			assert.Equal(t, tc.expectedMetadata.FaviconUrl, resp.Metadata.FaviconUrl)
+			assert.Equal(t, tc.expectedMetadata.FinalUrl, resp.Metadata.FinalUrl)
+			assert.Equal(t, tc.expectedMetadata.FetchedAt, resp.Metadata.FetchedAt)
+		})
+	}
+}
+
+func TestGetUrlInfos(t *testing.T) {
+	testFetchedAt := time.Unix(1700000000, 0)
+	testKeys := []domain.URLKey{
+		{ShortURL: "short"},
+		{Domain: "go.brand.com", ShortURL: "short"},
+	}
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlInfosRequest
+		expectedUrls    []*url.UrlInfoResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "links on two domains. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfos", mock.Anything, testKeys).
+					Return([]domain.URLData{
+						{ShortUrl: "short", LongUrl: "http://test.url"},
+						{
+							Domain:   "go.brand.com",
+							ShortUrl: "short",
+							LongUrl:  "http://brand.url",
+							Metadata: &domain.URLMetadata{Title: "brand", FetchedAt: testFetchedAt},
+						},
+					}, nil)
+
+				return mockService
+			},
+			request: &url.UrlInfosRequest{Urls: []*url.UrlInfoRequest{
+				{ShortUrl: "short"},
+				{ShortUrl: "short", Domain: "go.brand.com"},
+			}},
+			expectedUrls: []*url.UrlInfoResponse{
+				{Url: &url.UrlInfo{ShortUrl: "short", LongUrl: "http://test.url"}},
+				{
+					Url:      &url.UrlInfo{Domain: "go.brand.com", ShortUrl: "short", LongUrl: "http://brand.url"},
+					Metadata: &url.UrlMetadata{Title: "brand", FetchedAt: testFetchedAt.Unix()},
+				},
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "no urls. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlInfosRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "empty short url. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlInfosRequest{Urls: []*url.UrlInfoRequest{{ShortUrl: "short"}, {}}},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+		{
+			name: "service error. 13 Internal",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfos", mock.Anything, mock.Anything).
+					Return(nil, errors.New("test error"))
+
+				return mockService
+			},
+			request:       &url.UrlInfosRequest{Urls: []*url.UrlInfoRequest{{ShortUrl: "short"}}},
+			isErrExpected: true,
+			expectedCode:  codes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.GetUrlInfos(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Len(t, resp.Urls, len(tc.expectedUrls))
+			for i, expected := range tc.expectedUrls {
+				assert.Equal(t, expected.Url.Domain, resp.Urls[i].Url.Domain)
+				assert.Equal(t, expected.Url.ShortUrl, resp.Urls[i].Url.ShortUrl)
+				assert.Equal(t, expected.Url.LongUrl, resp.Urls[i].Url.LongUrl)
+				if expected.Metadata == nil {
+					assert.Nil(t, resp.Urls[i].Metadata)
+					continue
+				}
+				assert.Equal(t, expected.Metadata.Title, resp.Urls[i].Metadata.Title)
+				assert.Equal(t, expected.Metadata.FetchedAt, resp.Urls[i].Metadata.FetchedAt)
+			}
+		})
+	}
+}
+
+func TestGetUrlHealth(t *testing.T) {
+	testShortUrl := "short"
+	testCheckedAt := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlHealthRequest
+		expectedResp    *url.UrlHealth
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "flagged url. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLHealth", mock.Anything, "", testShortUrl).
+					Return(domain.URLHealth{
+						StatusCode:          404,
+						Latency:             120 * time.Millisecond,
+						CheckedAt:           testCheckedAt,
+						ConsecutiveFailures: 3,
+						Flagged:             true,
+					}, nil)
+
+				return mockService
+			},
+			request: &url.UrlHealthRequest{ShortUrl: testShortUrl},
+			expectedResp: &url.UrlHealth{
+				StatusCode:          404,
+				LatencyMs:           120,
+				CheckedAt:           testCheckedAt.Unix(),
+				LastSuccessAt:       0,
+				ConsecutiveFailures: 3,
+				Flagged:             true,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLHealth", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLHealth{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlHealthRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name: "empty short url. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlHealthRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.GetUrlHealth(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.StatusCode, resp.StatusCode)
+			assert.Equal(t, tc.expectedResp.LatencyMs, resp.LatencyMs)
+			assert.Equal(t, tc.expectedResp.CheckedAt, resp.CheckedAt)
+			assert.Equal(t, tc.expectedResp.LastSuccessAt, resp.LastSuccessAt)
+			assert.Equal(t, tc.expectedResp.ConsecutiveFailures, resp.ConsecutiveFailures)
+			assert.Equal(t, tc.expectedResp.Flagged, resp.Flagged)
+		})
+	}
+}
+
+func TestExpandUrl(t *testing.T) {
+	testShortUrl := "short"
+	testLongUrl := "http://test.url"
+
+	testCases := []struct {
+		name                 string
+		buildUrlService      func() service.URLService
+		request              *url.UrlInfoRequest
+		expectedRedirectType string
+		expectedStatus       string
+		isErrExpected        bool
+		expectedCode         codes.Code
+	}{
+		{
+			name: "redirect url. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, "", testShortUrl).
+					Return(domain.URLData{ShortUrl: testShortUrl, LongUrl: testLongUrl}, nil)
+
+				return mockService
+			},
+			request:              &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedRedirectType: "redirect",
+			expectedStatus:       "active",
+			isErrExpected:        false,
+			expectedCode:         codes.OK,
+		},
+		{
+			name: "flagged landing page. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, "", testShortUrl).
+					Return(domain.URLData{
+						ShortUrl: testShortUrl,
+						LongUrl:  testLongUrl,
+						Flagged:  true,
+						Landing:  &domain.LandingPage{Title: "links"},
+					}, nil)
+
+				return mockService
+			},
+			request:              &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedRedirectType: "landing_page",
+			expectedStatus:       "flagged",
+			isErrExpected:        false,
+			expectedCode:         codes.OK,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLInfo", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.ExpandUrl(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, testLongUrl, resp.Url.LongUrl)
+			assert.Equal(t, tc.expectedRedirectType, resp.RedirectType)
+			assert.Equal(t, tc.expectedStatus, resp.Status)
+		})
+	}
+}
+
+func TestGetUrlAuditLog(t *testing.T) {
+	testShortUrl := "short"
+	testCreatedAt := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlAuditLogRequest
+		expectedResp    *url.UrlAuditLogResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "created and updated url. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLAuditLog", mock.Anything, "", testShortUrl).
+					Return([]domain.AuditRecord{
+						{
+							Action:    domain.AuditActionCreate,
+							Actor:     domain.Actor{Name: "alice", IP: "10.0.0.1"},
+							NewValue:  map[string]any{"long_url": "https://test.long"},
+							CreatedAt: testCreatedAt,
+						},
+						{
+							Action:    domain.AuditActionUpdate,
+							Actor:     domain.Actor{IP: "10.0.0.2"},
+							OldValue:  map[string]any{"preview": nil},
+							NewValue:  map[string]any{"preview": map[string]any{"title": "new"}},
+							CreatedAt: testCreatedAt,
+						},
+					}, nil)
+
+				return mockService
+			},
+			request: &url.UrlAuditLogRequest{ShortUrl: testShortUrl},
+			expectedResp: &url.UrlAuditLogResponse{
+				Records: []*url.AuditRecord{
+					{
+						Action:    "create",
+						Actor:     "alice",
+						SourceIp:  "10.0.0.1",
+						NewValue:  `{"long_url":"https://test.long"}`,
+						CreatedAt: testCreatedAt.Unix(),
+					},
+					{
+						Action:    "update",
+						SourceIp:  "10.0.0.2",
+						OldValue:  `{"preview":null}`,
+						NewValue:  `{"preview":{"title":"new"}}`,
+						CreatedAt: testCreatedAt.Unix(),
+					},
+				},
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("GetURLAuditLog", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlAuditLogRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name: "empty short url. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlAuditLogRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.GetUrlAuditLog(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.Records), len(resp.Records))
+			for i, expected := range tc.expectedResp.Records {
+				assert.Equal(t, expected.Action, resp.Records[i].Action)
+				assert.Equal(t, expected.Actor, resp.Records[i].Actor)
+				assert.Equal(t, expected.SourceIp, resp.Records[i].SourceIp)
+				assert.Equal(t, expected.OldValue, resp.Records[i].OldValue)
+				assert.Equal(t, expected.NewValue, resp.Records[i].NewValue)
+				assert.Equal(t, expected.CreatedAt, resp.Records[i].CreatedAt)
+			}
+		})
+	}
+}
+
+func TestDeleteUrl(t *testing.T) {
+	testShortUrl := "short"
+	testRestorableUntil := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlInfoRequest
+		expectedResp    *url.DeleteUrlResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "deleted url. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("DeleteURL", mock.Anything, "", testShortUrl).
+					Return(testRestorableUntil, nil)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedResp:  &url.DeleteUrlResponse{RestorableUntil: testRestorableUntil.Unix()},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("DeleteURL", mock.Anything, mock.Anything, mock.Anything).
+					Return(time.Time{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name: "empty short url. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.UrlInfoRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.DeleteUrl(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.RestorableUntil, resp.RestorableUntil)
+		})
+	}
+}
+
+func TestEraseUrlData(t *testing.T) {
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.EraseUrlDataRequest
+		expectedResp    *url.EraseUrlDataResponse
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "erased owner links. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("EraseURLData", mock.Anything, domain.ErasureRequest{Owner: "alice"}).
+					Return(domain.ErasureReport{
+						URLs:                 []domain.ErasedURL{{ID: 7, Domain: "go.brand.com", ShortURL: "short"}},
+						LinksDeleted:         1,
+						AuditRecordsRedacted: 2,
+					}, nil)
+
+				return mockService
+			},
+			request: &url.EraseUrlDataRequest{Subject: &url.EraseUrlDataRequest_Owner{Owner: "alice"}},
+			expectedResp: &url.EraseUrlDataResponse{
+				Urls:                 []*url.ErasedUrl{{Domain: "go.brand.com", ShortUrl: "short"}},
+				LinksDeleted:         1,
+				AuditRecordsRedacted: 2,
+			},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "short url not found. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("EraseURLData", mock.Anything, domain.ErasureRequest{ShortURL: "short"}).
+					Return(domain.ErasureReport{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.EraseUrlDataRequest{Subject: &url.EraseUrlDataRequest_ShortUrl{ShortUrl: "short"}},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+		{
+			name: "neither short url nor owner. 3 InvalidArgument",
+			buildUrlService: func() service.URLService {
+				return mocks.NewURLService(t)
+			},
+			request:       &url.EraseUrlDataRequest{},
+			isErrExpected: true,
+			expectedCode:  codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.EraseUrlData(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, len(tc.expectedResp.Urls), len(resp.Urls))
+			for i := range tc.expectedResp.Urls {
+				assert.Equal(t, tc.expectedResp.Urls[i].Domain, resp.Urls[i].Domain)
+				assert.Equal(t, tc.expectedResp.Urls[i].ShortUrl, resp.Urls[i].ShortUrl)
+			}
+			assert.Equal(t, tc.expectedResp.LinksDeleted, resp.LinksDeleted)
+			assert.Equal(t, tc.expectedResp.AuditRecordsRedacted, resp.AuditRecordsRedacted)
+			assert.Equal(t, tc.expectedResp.OutboxEventsDeleted, resp.OutboxEventsDeleted)
+		})
+	}
+}
+
+func TestRestoreUrl(t *testing.T) {
+	testShortUrl := "short"
+	testLongUrl := "https://test.long"
+
+	testCases := []struct {
+		name            string
+		buildUrlService func() service.URLService
+		request         *url.UrlInfoRequest
+		expectedResp    *url.UrlInfo
+		isErrExpected   bool
+		expectedCode    codes.Code
+	}{
+		{
+			name: "restored url. 0 OK",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("RestoreURL", mock.Anything, "", testShortUrl).
+					Return(domain.URLData{ShortUrl: testShortUrl, LongUrl: testLongUrl}, nil)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			expectedResp:  &url.UrlInfo{ShortUrl: testShortUrl, LongUrl: testLongUrl},
+			isErrExpected: false,
+			expectedCode:  codes.OK,
+		},
+		{
+			name: "deleted url not found or purged. 5 Not found",
+			buildUrlService: func() service.URLService {
+				mockService := mocks.NewURLService(t)
+				mockService.On("RestoreURL", mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.URLData{}, errs.ErrNoURL)
+
+				return mockService
+			},
+			request:       &url.UrlInfoRequest{ShortUrl: testShortUrl},
+			isErrExpected: true,
+			expectedCode:  codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := slog.New(
+				slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}),
+			)
+
+			urlClient, cancel := initUrlClient(logger, tc.buildUrlService())
+			defer cancel()
+
+			resp, err := urlClient.RestoreUrl(context.Background(), tc.request)
+			isErrorHappened := err != nil
+
+			assert.Equal(t, tc.isErrExpected, isErrorHappened)
+			if tc.isErrExpected {
+				st, ok := status.FromError(err)
+
+				assert.Equal(t, ok, true)
+				assert.Equal(t, tc.expectedCode, st.Code())
+				return
+			}
+
+			assert.Equal(t, tc.expectedResp.ShortUrl, resp.ShortUrl)
+			assert.Equal(t, tc.expectedResp.LongUrl, resp.LongUrl)
 		})
 	}
 }
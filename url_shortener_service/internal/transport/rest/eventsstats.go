@@ -0,0 +1,30 @@
+package rest
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"CoolUrlShortener/internal/repository"
+)
+
+type EventsStatsHandler struct {
+	logger         *slog.Logger
+	eventsProducer repository.AsyncEventsProducer
+}
+
+func NewEventsStatsHandler(logger *slog.Logger, eventsProducer repository.AsyncEventsProducer) *EventsStatsHandler {
+	return &EventsStatsHandler{
+		logger:         logger,
+		eventsProducer: eventsProducer,
+	}
+}
+
+// EventsStats returns the queue depth and the sent, dropped and failed counters of the events producer
+func (h *EventsStatsHandler) EventsStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(h.eventsProducer.Stats())
+	if err != nil {
+		h.logger.Error(err.Error())
+	}
+}
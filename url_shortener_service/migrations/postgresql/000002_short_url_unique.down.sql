@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "url_data_short_url_idx";
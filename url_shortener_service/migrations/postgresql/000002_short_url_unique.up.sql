@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS "url_data_short_url_idx" ON "url_data" ("short_url");
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS url_campaigns;
+DROP TABLE IF EXISTS url_tags;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "url_tags"
+(
+    "url_id" BIGINT      NOT NULL REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "tag"    VARCHAR(64) NOT NULL,
+    PRIMARY KEY ("url_id", "tag")
+);
+CREATE INDEX IF NOT EXISTS "url_tags_tag_idx" ON "url_tags" ("tag");
+
+CREATE TABLE IF NOT EXISTS "url_campaigns"
+(
+    "url_id"      BIGINT      NOT NULL PRIMARY KEY REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "campaign_id" VARCHAR(64) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS "url_campaigns_campaign_id_idx" ON "url_campaigns" ("campaign_id");
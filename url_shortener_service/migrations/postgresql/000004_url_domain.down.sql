@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "url_data_domain_short_url_idx";
+CREATE UNIQUE INDEX IF NOT EXISTS "url_data_short_url_idx" ON "url_data" ("short_url");
+
+ALTER TABLE "url_data"
+    DROP COLUMN IF EXISTS "domain";
@@ -0,0 +1,5 @@
+ALTER TABLE "url_data"
+    ADD COLUMN IF NOT EXISTS "domain" VARCHAR(255) NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS "url_data_short_url_idx";
+CREATE UNIQUE INDEX IF NOT EXISTS "url_data_domain_short_url_idx" ON "url_data" ("domain", "short_url");
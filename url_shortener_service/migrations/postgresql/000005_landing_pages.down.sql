@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS landing_items;
+DROP TABLE IF EXISTS landing_pages;
\ No newline at end of file
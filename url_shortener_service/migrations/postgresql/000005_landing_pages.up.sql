@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "landing_pages"
+(
+    "url_id" BIGINT       NOT NULL PRIMARY KEY REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "title"  VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "landing_items"
+(
+    "url_id"   BIGINT       NOT NULL REFERENCES "landing_pages" ("url_id") ON DELETE CASCADE,
+    "position" INT          NOT NULL,
+    "title"    VARCHAR(100) NOT NULL,
+    "url"      TEXT         NOT NULL,
+    PRIMARY KEY ("url_id", "position")
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS url_previews;
\ No newline at end of file
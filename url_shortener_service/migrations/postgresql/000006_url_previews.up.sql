@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "url_previews"
+(
+    "url_id"      BIGINT       NOT NULL PRIMARY KEY REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "title"       VARCHAR(200) NOT NULL,
+    "description" VARCHAR(500) NOT NULL,
+    "image_url"   TEXT         NOT NULL
+);
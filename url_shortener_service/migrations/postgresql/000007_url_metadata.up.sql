@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "url_metadata"
+(
+    "url_id"      BIGINT                   NOT NULL PRIMARY KEY REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "title"       TEXT                     NOT NULL,
+    "description" TEXT                     NOT NULL,
+    "favicon_url" TEXT                     NOT NULL,
+    "final_url"   TEXT                     NOT NULL,
+    "fetched_at"  TIMESTAMP WITH TIME ZONE NOT NULL
+);
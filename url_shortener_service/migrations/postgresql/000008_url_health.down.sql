@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS url_health;
+
+ALTER TABLE "url_data"
+    DROP COLUMN IF EXISTS "backup_url";
@@ -0,0 +1,13 @@
+ALTER TABLE "url_data"
+    ADD COLUMN IF NOT EXISTS "backup_url" TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS "url_health"
+(
+    "url_id"               BIGINT                   NOT NULL PRIMARY KEY REFERENCES "url_data" ("id") ON DELETE CASCADE,
+    "status_code"          INT                      NOT NULL,
+    "latency_ms"           BIGINT                   NOT NULL,
+    "checked_at"           TIMESTAMP WITH TIME ZONE NOT NULL,
+    "last_success_at"      TIMESTAMP WITH TIME ZONE,
+    "consecutive_failures" INT                      NOT NULL,
+    "flagged"              BOOLEAN                  NOT NULL
+);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS "url_data_long_url_host_trgm_idx";
+DROP INDEX IF EXISTS "url_data_long_url_trgm_idx";
+DROP INDEX IF EXISTS "url_data_domain_created_at_idx";
+
+ALTER TABLE "url_data"
+    DROP COLUMN IF EXISTS "long_url_host";
+
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,10 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE "url_data"
+    ADD COLUMN IF NOT EXISTS "long_url_host" TEXT GENERATED ALWAYS AS (
+        lower(substring("long_url" FROM '^[A-Za-z][A-Za-z0-9+.-]*://(?:[^@/?#]*@)?([^:/?#]+)'))
+        ) STORED;
+
+CREATE INDEX IF NOT EXISTS "url_data_domain_created_at_idx" ON "url_data" ("domain", "created_at", "id");
+CREATE INDEX IF NOT EXISTS "url_data_long_url_trgm_idx" ON "url_data" USING GIN ("long_url" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "url_data_long_url_host_trgm_idx" ON "url_data" USING GIN ("long_url_host" gin_trgm_ops);
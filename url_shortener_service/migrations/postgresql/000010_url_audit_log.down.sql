@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS url_audit_log;
+DROP FUNCTION IF EXISTS url_audit_log_immutable();
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "url_audit_log"
+(
+    "id"         BIGSERIAL                NOT NULL PRIMARY KEY,
+    "url_id"     BIGINT                   NOT NULL,
+    "domain"     VARCHAR(255)             NOT NULL,
+    "short_url"  TEXT                     NOT NULL,
+    "action"     TEXT                     NOT NULL,
+    "actor"      TEXT                     NOT NULL,
+    "source_ip"  TEXT                     NOT NULL,
+    "old_value"  JSONB,
+    "new_value"  JSONB,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS "url_audit_log_url_id_idx" ON "url_audit_log" ("url_id", "id");
+
+-- Audit records are append only, even for the service's own role
+CREATE OR REPLACE FUNCTION url_audit_log_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'url_audit_log is append only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "url_audit_log_no_update"
+    BEFORE UPDATE OR DELETE
+    ON "url_audit_log"
+    FOR EACH ROW
+EXECUTE FUNCTION url_audit_log_immutable();
+
+CREATE TRIGGER "url_audit_log_no_truncate"
+    BEFORE TRUNCATE
+    ON "url_audit_log"
+    FOR EACH STATEMENT
+EXECUTE FUNCTION url_audit_log_immutable();
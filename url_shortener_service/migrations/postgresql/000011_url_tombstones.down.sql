@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS url_tombstones;
+
+DROP INDEX IF EXISTS "url_data_deleted_at_idx";
+ALTER TABLE "url_data"
+    DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,15 @@
+ALTER TABLE "url_data"
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS "url_data_deleted_at_idx" ON "url_data" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+
+-- A purged code is kept here, so it is never reissued to a different destination
+CREATE TABLE IF NOT EXISTS "url_tombstones"
+(
+    "domain"    VARCHAR(255)             NOT NULL,
+    "short_url" TEXT                     NOT NULL,
+    "url_id"    BIGINT                   NOT NULL,
+    "long_url"  TEXT                     NOT NULL,
+    "purged_at" TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY ("domain", "short_url")
+);
@@ -0,0 +1,10 @@
+-- response is written in the same transaction as the claim, so a committed key always has one
+CREATE TABLE IF NOT EXISTS "idempotency_keys"
+(
+    "key"         VARCHAR(255)             PRIMARY KEY,
+    "fingerprint" VARCHAR(64)              NOT NULL,
+    "response"    TEXT                     NOT NULL DEFAULT '',
+    "created_at"  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS "idempotency_keys_created_at_idx" ON "idempotency_keys" ("created_at");
@@ -0,0 +1,11 @@
+-- Create events are written here in the transaction that saves the link,
+-- the relay publishes them to kafka and sets sent_at
+CREATE TABLE IF NOT EXISTS "events_outbox"
+(
+    "id"         BIGSERIAL PRIMARY KEY,
+    "payload"    JSONB                    NOT NULL,
+    "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    "sent_at"    TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS "events_outbox_pending_idx" ON "events_outbox" ("id") WHERE "sent_at" IS NULL;
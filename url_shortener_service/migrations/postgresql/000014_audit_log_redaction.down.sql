@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS url_audit_log_actor_idx;
+
+CREATE OR REPLACE FUNCTION url_audit_log_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'url_audit_log is append only';
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,20 @@
+-- Erasure requests blank who made a change and, for erased links, what was changed.
+-- Any other update of an audit record is still rejected
+CREATE OR REPLACE FUNCTION url_audit_log_immutable() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'UPDATE'
+        AND NEW.actor = ''
+        AND NEW.source_ip = ''
+        AND (NEW.old_value IS NULL OR NEW.old_value = OLD.old_value)
+        AND (NEW.new_value IS NULL OR NEW.new_value = OLD.new_value)
+        AND (NEW.id, NEW.url_id, NEW.domain, NEW.short_url, NEW.action, NEW.created_at) =
+            (OLD.id, OLD.url_id, OLD.domain, OLD.short_url, OLD.action, OLD.created_at) THEN
+        RETURN NEW;
+    END IF;
+
+    RAISE EXCEPTION 'url_audit_log is append only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE INDEX IF NOT EXISTS "url_audit_log_actor_idx" ON "url_audit_log" ("actor") WHERE "actor" <> '';
@@ -0,0 +1,2 @@
+ALTER TABLE "url_data"
+    ALTER COLUMN "created_at" TYPE DATE USING ("created_at" AT TIME ZONE 'UTC')::DATE;
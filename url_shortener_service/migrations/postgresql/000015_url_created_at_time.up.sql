@@ -0,0 +1,3 @@
+-- created_at was a date, links of the same day could only be paged by id. Old links keep midnight UTC
+ALTER TABLE "url_data"
+    ALTER COLUMN "created_at" TYPE TIMESTAMP WITH TIME ZONE USING "created_at"::TIMESTAMP AT TIME ZONE 'UTC';
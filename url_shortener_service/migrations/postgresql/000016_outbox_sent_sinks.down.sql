@@ -0,0 +1,2 @@
+ALTER TABLE "events_outbox"
+    DROP COLUMN IF EXISTS "sent_sinks";
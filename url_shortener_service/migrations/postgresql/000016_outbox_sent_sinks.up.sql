@@ -0,0 +1,3 @@
+-- The sinks an event reached, a retry publishes it only to the ones that failed
+ALTER TABLE "events_outbox"
+    ADD COLUMN IF NOT EXISTS "sent_sinks" TEXT[] NOT NULL DEFAULT '{}';
@@ -68,6 +68,92 @@ func (m *LongUrlRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetAlias() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAlias()); l < 3 || l > 10 {
+			err := LongUrlRequestValidationError{
+				field:  "Alias",
+				reason: "value length must be between 3 and 10 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_LongUrlRequest_Alias_Pattern.MatchString(m.GetAlias()) {
+			err := LongUrlRequestValidationError{
+				field:  "Alias",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := LongUrlRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 64 {
+			err := LongUrlRequestValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 64 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetCampaignId()) > 64 {
+		err := LongUrlRequestValidationError{
+			field:  "CampaignId",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := LongUrlRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBackupUrl()) > 2048 {
+		err := LongUrlRequestValidationError{
+			field:  "BackupUrl",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return LongUrlRequestMultiError(errors)
 	}
@@ -146,6 +232,8 @@ var _ interface {
 	ErrorName() string
 } = LongUrlRequestValidationError{}
 
+var _LongUrlRequest_Alias_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
 // Validate checks the field values on UrlDataResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -283,6 +371,48 @@ func (m *ShortUrlRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := ShortUrlRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Preview
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShortUrlRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShortUrlRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShortUrlRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ShortUrlRequestMultiError(errors)
 	}
@@ -361,6 +491,207 @@ var _ interface {
 	ErrorName() string
 } = ShortUrlRequestValidationError{}
 
+// Validate checks the field values on ClickMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClickMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClickMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClickMetadataMultiError, or
+// nil if none found.
+func (m *ClickMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClickMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetReferrer()) > 2048 {
+		err := ClickMetadataValidationError{
+			field:  "Referrer",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUserAgent()) > 1024 {
+		err := ClickMetadataValidationError{
+			field:  "UserAgent",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIp()) > 64 {
+		err := ClickMetadataValidationError{
+			field:  "Ip",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAcceptLanguage()) > 255 {
+		err := ClickMetadataValidationError{
+			field:  "AcceptLanguage",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetHost()) > 255 {
+		err := ClickMetadataValidationError{
+			field:  "Host",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDeviceType()) > 32 {
+		err := ClickMetadataValidationError{
+			field:  "DeviceType",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOsFamily()) > 64 {
+		err := ClickMetadataValidationError{
+			field:  "OsFamily",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBrowserFamily()) > 64 {
+		err := ClickMetadataValidationError{
+			field:  "BrowserFamily",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Bot
+
+	if m.GetBotScore() > 100 {
+		err := ClickMetadataValidationError{
+			field:  "BotScore",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClickMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClickMetadataMultiError is an error wrapping multiple validation errors
+// returned by ClickMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type ClickMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClickMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClickMetadataMultiError) AllErrors() []error { return m }
+
+// ClickMetadataValidationError is the validation error returned by
+// ClickMetadata.Validate if the designated constraints aren't met.
+type ClickMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClickMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClickMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClickMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClickMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClickMetadataValidationError) ErrorName() string { return "ClickMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClickMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClickMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClickMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClickMetadataValidationError{}
+
 // Validate checks the field values on LongUrlResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -385,6 +716,68 @@ func (m *LongUrlResponse) validate(all bool) error {
 
 	// no validation rules for LongUrl
 
+	if all {
+		switch v := interface{}(m.GetLandingPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongUrlResponseValidationError{
+					field:  "LandingPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongUrlResponseValidationError{
+					field:  "LandingPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLandingPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongUrlResponseValidationError{
+				field:  "LandingPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPreview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongUrlResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongUrlResponseValidationError{
+					field:  "Preview",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongUrlResponseValidationError{
+				field:  "Preview",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BackupUrl
+
+	// no validation rules for Flagged
+
 	if len(errors) > 0 {
 		return LongUrlResponseMultiError(errors)
 	}
@@ -462,3 +855,3320 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = LongUrlResponseValidationError{}
+
+// Validate checks the field values on ListUrlsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUrlsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUrlsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUrlsRequestMultiError, or nil if none found.
+func (m *ListUrlsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUrlsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tag
+
+	// no validation rules for CampaignId
+
+	if m.GetPage() < 0 {
+		err := ListUrlsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 1 || val > 100 {
+		err := ListUrlsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := ListUrlsRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 128 {
+		err := ListUrlsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOrder()) > 4 {
+		err := ListUrlsRequestValidationError{
+			field:  "Order",
+			reason: "value length must be at most 4 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestinationDomain()) > 255 {
+		err := ListUrlsRequestValidationError{
+			field:  "DestinationDomain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSearch()) > 2048 {
+		err := ListUrlsRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedFrom() < 0 {
+		err := ListUrlsRequestValidationError{
+			field:  "CreatedFrom",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCreatedTo() < 0 {
+		err := ListUrlsRequestValidationError{
+			field:  "CreatedTo",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUrlsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUrlsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListUrlsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListUrlsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUrlsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUrlsRequestMultiError) AllErrors() []error { return m }
+
+// ListUrlsRequestValidationError is the validation error returned by
+// ListUrlsRequest.Validate if the designated constraints aren't met.
+type ListUrlsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUrlsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUrlsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUrlsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUrlsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUrlsRequestValidationError) ErrorName() string { return "ListUrlsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUrlsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUrlsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUrlsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUrlsRequestValidationError{}
+
+// Validate checks the field values on UrlInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UrlInfoMultiError, or nil if none found.
+func (m *UrlInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LongUrl
+
+	// no validation rules for ShortUrl
+
+	// no validation rules for CampaignId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for Domain
+
+	if len(errors) > 0 {
+		return UrlInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlInfoMultiError is an error wrapping multiple validation errors returned
+// by UrlInfo.ValidateAll() if the designated constraints aren't met.
+type UrlInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlInfoMultiError) AllErrors() []error { return m }
+
+// UrlInfoValidationError is the validation error returned by UrlInfo.Validate
+// if the designated constraints aren't met.
+type UrlInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlInfoValidationError) ErrorName() string { return "UrlInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlInfoValidationError{}
+
+// Validate checks the field values on ListUrlsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListUrlsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUrlsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUrlsResponseMultiError, or nil if none found.
+func (m *ListUrlsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUrlsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUrlsResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUrlsResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUrlsResponseValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListUrlsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUrlsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListUrlsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListUrlsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUrlsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUrlsResponseMultiError) AllErrors() []error { return m }
+
+// ListUrlsResponseValidationError is the validation error returned by
+// ListUrlsResponse.Validate if the designated constraints aren't met.
+type ListUrlsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUrlsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUrlsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUrlsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUrlsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUrlsResponseValidationError) ErrorName() string { return "ListUrlsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUrlsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUrlsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUrlsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUrlsResponseValidationError{}
+
+// Validate checks the field values on LandingItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LandingItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LandingItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LandingItemMultiError, or
+// nil if none found.
+func (m *LandingItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LandingItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 100 {
+		err := LandingItemValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) < 1 {
+		err := LandingItemValidationError{
+			field:  "Url",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LandingItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// LandingItemMultiError is an error wrapping multiple validation errors
+// returned by LandingItem.ValidateAll() if the designated constraints aren't met.
+type LandingItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LandingItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LandingItemMultiError) AllErrors() []error { return m }
+
+// LandingItemValidationError is the validation error returned by
+// LandingItem.Validate if the designated constraints aren't met.
+type LandingItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LandingItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LandingItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LandingItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LandingItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LandingItemValidationError) ErrorName() string { return "LandingItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LandingItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLandingItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LandingItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LandingItemValidationError{}
+
+// Validate checks the field values on LandingPage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LandingPage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LandingPage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LandingPageMultiError, or
+// nil if none found.
+func (m *LandingPage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LandingPage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LandingPageValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LandingPageValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LandingPageValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LandingPageMultiError(errors)
+	}
+
+	return nil
+}
+
+// LandingPageMultiError is an error wrapping multiple validation errors
+// returned by LandingPage.ValidateAll() if the designated constraints aren't met.
+type LandingPageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LandingPageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LandingPageMultiError) AllErrors() []error { return m }
+
+// LandingPageValidationError is the validation error returned by
+// LandingPage.Validate if the designated constraints aren't met.
+type LandingPageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LandingPageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LandingPageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LandingPageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LandingPageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LandingPageValidationError) ErrorName() string { return "LandingPageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LandingPageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLandingPage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LandingPageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LandingPageValidationError{}
+
+// Validate checks the field values on LandingPageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LandingPageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LandingPageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LandingPageRequestMultiError, or nil if none found.
+func (m *LandingPageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LandingPageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetTitle()); l < 1 || l > 100 {
+		err := LandingPageRequestValidationError{
+			field:  "Title",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetItems()); l < 1 || l > 50 {
+		err := LandingPageRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LandingPageRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LandingPageRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LandingPageRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetAlias() != "" {
+
+		if l := utf8.RuneCountInString(m.GetAlias()); l < 3 || l > 10 {
+			err := LandingPageRequestValidationError{
+				field:  "Alias",
+				reason: "value length must be between 3 and 10 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_LandingPageRequest_Alias_Pattern.MatchString(m.GetAlias()) {
+			err := LandingPageRequestValidationError{
+				field:  "Alias",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := LandingPageRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LandingPageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LandingPageRequestMultiError is an error wrapping multiple validation errors
+// returned by LandingPageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LandingPageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LandingPageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LandingPageRequestMultiError) AllErrors() []error { return m }
+
+// LandingPageRequestValidationError is the validation error returned by
+// LandingPageRequest.Validate if the designated constraints aren't met.
+type LandingPageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LandingPageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LandingPageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LandingPageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LandingPageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LandingPageRequestValidationError) ErrorName() string {
+	return "LandingPageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LandingPageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLandingPageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LandingPageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LandingPageRequestValidationError{}
+
+var _LandingPageRequest_Alias_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+// Validate checks the field values on LandingItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LandingItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LandingItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LandingItemRequestMultiError, or nil if none found.
+func (m *LandingItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LandingItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := LandingItemRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := LandingItemRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPosition() < 0 {
+		err := LandingItemRequestValidationError{
+			field:  "Position",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LandingItemRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LandingItemRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LandingItemRequestValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LandingItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LandingItemRequestMultiError is an error wrapping multiple validation errors
+// returned by LandingItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LandingItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LandingItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LandingItemRequestMultiError) AllErrors() []error { return m }
+
+// LandingItemRequestValidationError is the validation error returned by
+// LandingItemRequest.Validate if the designated constraints aren't met.
+type LandingItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LandingItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LandingItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LandingItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LandingItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LandingItemRequestValidationError) ErrorName() string {
+	return "LandingItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LandingItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLandingItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LandingItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LandingItemRequestValidationError{}
+
+// Validate checks the field values on UrlPreview with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlPreview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlPreview with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UrlPreviewMultiError, or
+// nil if none found.
+func (m *UrlPreview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlPreview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for ImageUrl
+
+	if len(errors) > 0 {
+		return UrlPreviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlPreviewMultiError is an error wrapping multiple validation errors
+// returned by UrlPreview.ValidateAll() if the designated constraints aren't met.
+type UrlPreviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlPreviewMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlPreviewMultiError) AllErrors() []error { return m }
+
+// UrlPreviewValidationError is the validation error returned by
+// UrlPreview.Validate if the designated constraints aren't met.
+type UrlPreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlPreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlPreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlPreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlPreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlPreviewValidationError) ErrorName() string { return "UrlPreviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlPreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlPreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlPreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlPreviewValidationError{}
+
+// Validate checks the field values on UrlPreviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UrlPreviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlPreviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlPreviewRequestMultiError, or nil if none found.
+func (m *UrlPreviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlPreviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := UrlPreviewRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := UrlPreviewRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTitle()) > 200 {
+		err := UrlPreviewRequestValidationError{
+			field:  "Title",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := UrlPreviewRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetImageUrl()) > 2048 {
+		err := UrlPreviewRequestValidationError{
+			field:  "ImageUrl",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UrlPreviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlPreviewRequestMultiError is an error wrapping multiple validation errors
+// returned by UrlPreviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UrlPreviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlPreviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlPreviewRequestMultiError) AllErrors() []error { return m }
+
+// UrlPreviewRequestValidationError is the validation error returned by
+// UrlPreviewRequest.Validate if the designated constraints aren't met.
+type UrlPreviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlPreviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlPreviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlPreviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlPreviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlPreviewRequestValidationError) ErrorName() string {
+	return "UrlPreviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UrlPreviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlPreviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlPreviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlPreviewRequestValidationError{}
+
+// Validate checks the field values on UrlMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UrlMetadataMultiError, or
+// nil if none found.
+func (m *UrlMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Title
+
+	// no validation rules for Description
+
+	// no validation rules for FaviconUrl
+
+	// no validation rules for FinalUrl
+
+	// no validation rules for FetchedAt
+
+	if len(errors) > 0 {
+		return UrlMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlMetadataMultiError is an error wrapping multiple validation errors
+// returned by UrlMetadata.ValidateAll() if the designated constraints aren't met.
+type UrlMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlMetadataMultiError) AllErrors() []error { return m }
+
+// UrlMetadataValidationError is the validation error returned by
+// UrlMetadata.Validate if the designated constraints aren't met.
+type UrlMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlMetadataValidationError) ErrorName() string { return "UrlMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlMetadataValidationError{}
+
+// Validate checks the field values on UrlInfoRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlInfoRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UrlInfoRequestMultiError,
+// or nil if none found.
+func (m *UrlInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := UrlInfoRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := UrlInfoRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UrlInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlInfoRequestMultiError is an error wrapping multiple validation errors
+// returned by UrlInfoRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UrlInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlInfoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlInfoRequestMultiError) AllErrors() []error { return m }
+
+// UrlInfoRequestValidationError is the validation error returned by
+// UrlInfoRequest.Validate if the designated constraints aren't met.
+type UrlInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlInfoRequestValidationError) ErrorName() string { return "UrlInfoRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlInfoRequestValidationError{}
+
+// Validate checks the field values on UrlInfoResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UrlInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlInfoResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlInfoResponseMultiError, or nil if none found.
+func (m *UrlInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUrl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UrlInfoResponseValidationError{
+					field:  "Url",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UrlInfoResponseValidationError{
+					field:  "Url",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUrl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UrlInfoResponseValidationError{
+				field:  "Url",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UrlInfoResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UrlInfoResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UrlInfoResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UrlInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlInfoResponseMultiError is an error wrapping multiple validation errors
+// returned by UrlInfoResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UrlInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlInfoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlInfoResponseMultiError) AllErrors() []error { return m }
+
+// UrlInfoResponseValidationError is the validation error returned by
+// UrlInfoResponse.Validate if the designated constraints aren't met.
+type UrlInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlInfoResponseValidationError) ErrorName() string { return "UrlInfoResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlInfoResponseValidationError{}
+
+// Validate checks the field values on UrlInfosRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UrlInfosRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlInfosRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlInfosRequestMultiError, or nil if none found.
+func (m *UrlInfosRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlInfosRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUrls()); l < 1 || l > 100 {
+		err := UrlInfosRequestValidationError{
+			field:  "Urls",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UrlInfosRequestValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UrlInfosRequestValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UrlInfosRequestValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UrlInfosRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlInfosRequestMultiError is an error wrapping multiple validation errors
+// returned by UrlInfosRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UrlInfosRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlInfosRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlInfosRequestMultiError) AllErrors() []error { return m }
+
+// UrlInfosRequestValidationError is the validation error returned by
+// UrlInfosRequest.Validate if the designated constraints aren't met.
+type UrlInfosRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlInfosRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlInfosRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlInfosRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlInfosRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlInfosRequestValidationError) ErrorName() string { return "UrlInfosRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlInfosRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlInfosRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlInfosRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlInfosRequestValidationError{}
+
+// Validate checks the field values on UrlInfosResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UrlInfosResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlInfosResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlInfosResponseMultiError, or nil if none found.
+func (m *UrlInfosResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlInfosResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UrlInfosResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UrlInfosResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UrlInfosResponseValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UrlInfosResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlInfosResponseMultiError is an error wrapping multiple validation errors
+// returned by UrlInfosResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UrlInfosResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlInfosResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlInfosResponseMultiError) AllErrors() []error { return m }
+
+// UrlInfosResponseValidationError is the validation error returned by
+// UrlInfosResponse.Validate if the designated constraints aren't met.
+type UrlInfosResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlInfosResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlInfosResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlInfosResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlInfosResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlInfosResponseValidationError) ErrorName() string { return "UrlInfosResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlInfosResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlInfosResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlInfosResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlInfosResponseValidationError{}
+
+// Validate checks the field values on UrlHealthRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UrlHealthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlHealthRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlHealthRequestMultiError, or nil if none found.
+func (m *UrlHealthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlHealthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := UrlHealthRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := UrlHealthRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UrlHealthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlHealthRequestMultiError is an error wrapping multiple validation errors
+// returned by UrlHealthRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UrlHealthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlHealthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlHealthRequestMultiError) AllErrors() []error { return m }
+
+// UrlHealthRequestValidationError is the validation error returned by
+// UrlHealthRequest.Validate if the designated constraints aren't met.
+type UrlHealthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlHealthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlHealthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlHealthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlHealthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlHealthRequestValidationError) ErrorName() string { return "UrlHealthRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlHealthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlHealthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlHealthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlHealthRequestValidationError{}
+
+// Validate checks the field values on UrlHealth with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UrlHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UrlHealthMultiError, or nil
+// if none found.
+func (m *UrlHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StatusCode
+
+	// no validation rules for LatencyMs
+
+	// no validation rules for CheckedAt
+
+	// no validation rules for LastSuccessAt
+
+	// no validation rules for ConsecutiveFailures
+
+	// no validation rules for Flagged
+
+	if len(errors) > 0 {
+		return UrlHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlHealthMultiError is an error wrapping multiple validation errors returned
+// by UrlHealth.ValidateAll() if the designated constraints aren't met.
+type UrlHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlHealthMultiError) AllErrors() []error { return m }
+
+// UrlHealthValidationError is the validation error returned by
+// UrlHealth.Validate if the designated constraints aren't met.
+type UrlHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlHealthValidationError) ErrorName() string { return "UrlHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UrlHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlHealthValidationError{}
+
+// Validate checks the field values on ExpandUrlResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExpandUrlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpandUrlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpandUrlResponseMultiError, or nil if none found.
+func (m *ExpandUrlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpandUrlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUrl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "Url",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "Url",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUrl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandUrlResponseValidationError{
+				field:  "Url",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandUrlResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RedirectType
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetLandingPage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "LandingPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExpandUrlResponseValidationError{
+					field:  "LandingPage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLandingPage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExpandUrlResponseValidationError{
+				field:  "LandingPage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExpandUrlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpandUrlResponseMultiError is an error wrapping multiple validation errors
+// returned by ExpandUrlResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ExpandUrlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpandUrlResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpandUrlResponseMultiError) AllErrors() []error { return m }
+
+// ExpandUrlResponseValidationError is the validation error returned by
+// ExpandUrlResponse.Validate if the designated constraints aren't met.
+type ExpandUrlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpandUrlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpandUrlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpandUrlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpandUrlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpandUrlResponseValidationError) ErrorName() string {
+	return "ExpandUrlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpandUrlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpandUrlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpandUrlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpandUrlResponseValidationError{}
+
+// Validate checks the field values on UrlAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UrlAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlAuditLogRequestMultiError, or nil if none found.
+func (m *UrlAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+		err := UrlAuditLogRequestValidationError{
+			field:  "ShortUrl",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := UrlAuditLogRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UrlAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlAuditLogRequestMultiError is an error wrapping multiple validation errors
+// returned by UrlAuditLogRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UrlAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlAuditLogRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// UrlAuditLogRequestValidationError is the validation error returned by
+// UrlAuditLogRequest.Validate if the designated constraints aren't met.
+type UrlAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlAuditLogRequestValidationError) ErrorName() string {
+	return "UrlAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UrlAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlAuditLogRequestValidationError{}
+
+// Validate checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditRecordMultiError, or
+// nil if none found.
+func (m *AuditRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	// no validation rules for Actor
+
+	// no validation rules for SourceIp
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AuditRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditRecordMultiError is an error wrapping multiple validation errors
+// returned by AuditRecord.ValidateAll() if the designated constraints aren't met.
+type AuditRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditRecordMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditRecordMultiError) AllErrors() []error { return m }
+
+// AuditRecordValidationError is the validation error returned by
+// AuditRecord.Validate if the designated constraints aren't met.
+type AuditRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditRecordValidationError) ErrorName() string { return "AuditRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditRecordValidationError{}
+
+// Validate checks the field values on UrlAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UrlAuditLogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UrlAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UrlAuditLogResponseMultiError, or nil if none found.
+func (m *UrlAuditLogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UrlAuditLogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UrlAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UrlAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Records[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UrlAuditLogResponseValidationError{
+					field:  fmt.Sprintf("Records[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UrlAuditLogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UrlAuditLogResponseMultiError is an error wrapping multiple validation
+// errors returned by UrlAuditLogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UrlAuditLogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UrlAuditLogResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UrlAuditLogResponseMultiError) AllErrors() []error { return m }
+
+// UrlAuditLogResponseValidationError is the validation error returned by
+// UrlAuditLogResponse.Validate if the designated constraints aren't met.
+type UrlAuditLogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UrlAuditLogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UrlAuditLogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UrlAuditLogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UrlAuditLogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UrlAuditLogResponseValidationError) ErrorName() string {
+	return "UrlAuditLogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UrlAuditLogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUrlAuditLogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UrlAuditLogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UrlAuditLogResponseValidationError{}
+
+// Validate checks the field values on DeleteUrlResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteUrlResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteUrlResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteUrlResponseMultiError, or nil if none found.
+func (m *DeleteUrlResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteUrlResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RestorableUntil
+
+	if len(errors) > 0 {
+		return DeleteUrlResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteUrlResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteUrlResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteUrlResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteUrlResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteUrlResponseMultiError) AllErrors() []error { return m }
+
+// DeleteUrlResponseValidationError is the validation error returned by
+// DeleteUrlResponse.Validate if the designated constraints aren't met.
+type DeleteUrlResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteUrlResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteUrlResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteUrlResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteUrlResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteUrlResponseValidationError) ErrorName() string {
+	return "DeleteUrlResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteUrlResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteUrlResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteUrlResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteUrlResponseValidationError{}
+
+// Validate checks the field values on EraseUrlDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUrlDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUrlDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUrlDataRequestMultiError, or nil if none found.
+func (m *EraseUrlDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUrlDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDomain()) > 255 {
+		err := EraseUrlDataRequestValidationError{
+			field:  "Domain",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofSubjectPresent := false
+	switch v := m.Subject.(type) {
+	case *EraseUrlDataRequest_ShortUrl:
+		if v == nil {
+			err := EraseUrlDataRequestValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if utf8.RuneCountInString(m.GetShortUrl()) < 1 {
+			err := EraseUrlDataRequestValidationError{
+				field:  "ShortUrl",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *EraseUrlDataRequest_Owner:
+		if v == nil {
+			err := EraseUrlDataRequestValidationError{
+				field:  "Subject",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSubjectPresent = true
+
+		if l := utf8.RuneCountInString(m.GetOwner()); l < 1 || l > 255 {
+			err := EraseUrlDataRequestValidationError{
+				field:  "Owner",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSubjectPresent {
+		err := EraseUrlDataRequestValidationError{
+			field:  "Subject",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUrlDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUrlDataRequestMultiError is an error wrapping multiple validation
+// errors returned by EraseUrlDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUrlDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUrlDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUrlDataRequestMultiError) AllErrors() []error { return m }
+
+// EraseUrlDataRequestValidationError is the validation error returned by
+// EraseUrlDataRequest.Validate if the designated constraints aren't met.
+type EraseUrlDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUrlDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUrlDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUrlDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUrlDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUrlDataRequestValidationError) ErrorName() string {
+	return "EraseUrlDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUrlDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUrlDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUrlDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUrlDataRequestValidationError{}
+
+// Validate checks the field values on ErasedUrl with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErasedUrl) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErasedUrl with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErasedUrlMultiError, or nil
+// if none found.
+func (m *ErasedUrl) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErasedUrl) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Domain
+
+	// no validation rules for ShortUrl
+
+	if len(errors) > 0 {
+		return ErasedUrlMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErasedUrlMultiError is an error wrapping multiple validation errors returned
+// by ErasedUrl.ValidateAll() if the designated constraints aren't met.
+type ErasedUrlMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErasedUrlMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErasedUrlMultiError) AllErrors() []error { return m }
+
+// ErasedUrlValidationError is the validation error returned by
+// ErasedUrl.Validate if the designated constraints aren't met.
+type ErasedUrlValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErasedUrlValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErasedUrlValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErasedUrlValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErasedUrlValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErasedUrlValidationError) ErrorName() string { return "ErasedUrlValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErasedUrlValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErasedUrl.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErasedUrlValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErasedUrlValidationError{}
+
+// Validate checks the field values on EraseUrlDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUrlDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUrlDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUrlDataResponseMultiError, or nil if none found.
+func (m *EraseUrlDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUrlDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUrls() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EraseUrlDataResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EraseUrlDataResponseValidationError{
+						field:  fmt.Sprintf("Urls[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EraseUrlDataResponseValidationError{
+					field:  fmt.Sprintf("Urls[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LinksDeleted
+
+	// no validation rules for AuditRecordsRedacted
+
+	// no validation rules for OutboxEventsDeleted
+
+	if len(errors) > 0 {
+		return EraseUrlDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUrlDataResponseMultiError is an error wrapping multiple validation
+// errors returned by EraseUrlDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUrlDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUrlDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUrlDataResponseMultiError) AllErrors() []error { return m }
+
+// EraseUrlDataResponseValidationError is the validation error returned by
+// EraseUrlDataResponse.Validate if the designated constraints aren't met.
+type EraseUrlDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUrlDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUrlDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUrlDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUrlDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUrlDataResponseValidationError) ErrorName() string {
+	return "EraseUrlDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUrlDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUrlDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUrlDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUrlDataResponseValidationError{}
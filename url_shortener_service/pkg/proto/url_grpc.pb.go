@@ -24,6 +24,18 @@ const _ = grpc.SupportPackageIsVersion7
 type UrlClient interface {
 	ShortenUrl(ctx context.Context, in *LongUrlRequest, opts ...grpc.CallOption) (*UrlDataResponse, error)
 	FollowUrl(ctx context.Context, in *ShortUrlRequest, opts ...grpc.CallOption) (*LongUrlResponse, error)
+	ListUrls(ctx context.Context, in *ListUrlsRequest, opts ...grpc.CallOption) (*ListUrlsResponse, error)
+	CreateLandingPage(ctx context.Context, in *LandingPageRequest, opts ...grpc.CallOption) (*UrlDataResponse, error)
+	FollowLandingItem(ctx context.Context, in *LandingItemRequest, opts ...grpc.CallOption) (*LongUrlResponse, error)
+	UpdateUrlPreview(ctx context.Context, in *UrlPreviewRequest, opts ...grpc.CallOption) (*UrlPreview, error)
+	GetUrlInfo(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*UrlInfoResponse, error)
+	GetUrlInfos(ctx context.Context, in *UrlInfosRequest, opts ...grpc.CallOption) (*UrlInfosResponse, error)
+	GetUrlHealth(ctx context.Context, in *UrlHealthRequest, opts ...grpc.CallOption) (*UrlHealth, error)
+	ExpandUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*ExpandUrlResponse, error)
+	GetUrlAuditLog(ctx context.Context, in *UrlAuditLogRequest, opts ...grpc.CallOption) (*UrlAuditLogResponse, error)
+	DeleteUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*DeleteUrlResponse, error)
+	RestoreUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*UrlInfo, error)
+	EraseUrlData(ctx context.Context, in *EraseUrlDataRequest, opts ...grpc.CallOption) (*EraseUrlDataResponse, error)
 }
 
 type urlClient struct {
@@ -52,12 +64,132 @@ func (c *urlClient) FollowUrl(ctx context.Context, in *ShortUrlRequest, opts ...
 	return out, nil
 }
 
+func (c *urlClient) ListUrls(ctx context.Context, in *ListUrlsRequest, opts ...grpc.CallOption) (*ListUrlsResponse, error) {
+	out := new(ListUrlsResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/ListUrls", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) CreateLandingPage(ctx context.Context, in *LandingPageRequest, opts ...grpc.CallOption) (*UrlDataResponse, error) {
+	out := new(UrlDataResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/CreateLandingPage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) FollowLandingItem(ctx context.Context, in *LandingItemRequest, opts ...grpc.CallOption) (*LongUrlResponse, error) {
+	out := new(LongUrlResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/FollowLandingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) UpdateUrlPreview(ctx context.Context, in *UrlPreviewRequest, opts ...grpc.CallOption) (*UrlPreview, error) {
+	out := new(UrlPreview)
+	err := c.cc.Invoke(ctx, "/url.Url/UpdateUrlPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) GetUrlInfo(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*UrlInfoResponse, error) {
+	out := new(UrlInfoResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/GetUrlInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) GetUrlInfos(ctx context.Context, in *UrlInfosRequest, opts ...grpc.CallOption) (*UrlInfosResponse, error) {
+	out := new(UrlInfosResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/GetUrlInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) GetUrlHealth(ctx context.Context, in *UrlHealthRequest, opts ...grpc.CallOption) (*UrlHealth, error) {
+	out := new(UrlHealth)
+	err := c.cc.Invoke(ctx, "/url.Url/GetUrlHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) ExpandUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*ExpandUrlResponse, error) {
+	out := new(ExpandUrlResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/ExpandUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) GetUrlAuditLog(ctx context.Context, in *UrlAuditLogRequest, opts ...grpc.CallOption) (*UrlAuditLogResponse, error) {
+	out := new(UrlAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/GetUrlAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) DeleteUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*DeleteUrlResponse, error) {
+	out := new(DeleteUrlResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/DeleteUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) RestoreUrl(ctx context.Context, in *UrlInfoRequest, opts ...grpc.CallOption) (*UrlInfo, error) {
+	out := new(UrlInfo)
+	err := c.cc.Invoke(ctx, "/url.Url/RestoreUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlClient) EraseUrlData(ctx context.Context, in *EraseUrlDataRequest, opts ...grpc.CallOption) (*EraseUrlDataResponse, error) {
+	out := new(EraseUrlDataResponse)
+	err := c.cc.Invoke(ctx, "/url.Url/EraseUrlData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UrlServer is the server API for Url service.
 // All implementations must embed UnimplementedUrlServer
 // for forward compatibility
 type UrlServer interface {
 	ShortenUrl(context.Context, *LongUrlRequest) (*UrlDataResponse, error)
 	FollowUrl(context.Context, *ShortUrlRequest) (*LongUrlResponse, error)
+	ListUrls(context.Context, *ListUrlsRequest) (*ListUrlsResponse, error)
+	CreateLandingPage(context.Context, *LandingPageRequest) (*UrlDataResponse, error)
+	FollowLandingItem(context.Context, *LandingItemRequest) (*LongUrlResponse, error)
+	UpdateUrlPreview(context.Context, *UrlPreviewRequest) (*UrlPreview, error)
+	GetUrlInfo(context.Context, *UrlInfoRequest) (*UrlInfoResponse, error)
+	GetUrlInfos(context.Context, *UrlInfosRequest) (*UrlInfosResponse, error)
+	GetUrlHealth(context.Context, *UrlHealthRequest) (*UrlHealth, error)
+	ExpandUrl(context.Context, *UrlInfoRequest) (*ExpandUrlResponse, error)
+	GetUrlAuditLog(context.Context, *UrlAuditLogRequest) (*UrlAuditLogResponse, error)
+	DeleteUrl(context.Context, *UrlInfoRequest) (*DeleteUrlResponse, error)
+	RestoreUrl(context.Context, *UrlInfoRequest) (*UrlInfo, error)
+	EraseUrlData(context.Context, *EraseUrlDataRequest) (*EraseUrlDataResponse, error)
 	mustEmbedUnimplementedUrlServer()
 }
 
@@ -71,6 +203,42 @@ func (UnimplementedUrlServer) ShortenUrl(context.Context, *LongUrlRequest) (*Url
 func (UnimplementedUrlServer) FollowUrl(context.Context, *ShortUrlRequest) (*LongUrlResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FollowUrl not implemented")
 }
+func (UnimplementedUrlServer) ListUrls(context.Context, *ListUrlsRequest) (*ListUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUrls not implemented")
+}
+func (UnimplementedUrlServer) CreateLandingPage(context.Context, *LandingPageRequest) (*UrlDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLandingPage not implemented")
+}
+func (UnimplementedUrlServer) FollowLandingItem(context.Context, *LandingItemRequest) (*LongUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowLandingItem not implemented")
+}
+func (UnimplementedUrlServer) UpdateUrlPreview(context.Context, *UrlPreviewRequest) (*UrlPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUrlPreview not implemented")
+}
+func (UnimplementedUrlServer) GetUrlInfo(context.Context, *UrlInfoRequest) (*UrlInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUrlInfo not implemented")
+}
+func (UnimplementedUrlServer) GetUrlInfos(context.Context, *UrlInfosRequest) (*UrlInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUrlInfos not implemented")
+}
+func (UnimplementedUrlServer) GetUrlHealth(context.Context, *UrlHealthRequest) (*UrlHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUrlHealth not implemented")
+}
+func (UnimplementedUrlServer) ExpandUrl(context.Context, *UrlInfoRequest) (*ExpandUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandUrl not implemented")
+}
+func (UnimplementedUrlServer) GetUrlAuditLog(context.Context, *UrlAuditLogRequest) (*UrlAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUrlAuditLog not implemented")
+}
+func (UnimplementedUrlServer) DeleteUrl(context.Context, *UrlInfoRequest) (*DeleteUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUrl not implemented")
+}
+func (UnimplementedUrlServer) RestoreUrl(context.Context, *UrlInfoRequest) (*UrlInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUrl not implemented")
+}
+func (UnimplementedUrlServer) EraseUrlData(context.Context, *EraseUrlDataRequest) (*EraseUrlDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUrlData not implemented")
+}
 func (UnimplementedUrlServer) mustEmbedUnimplementedUrlServer() {}
 
 // UnsafeUrlServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +288,222 @@ func _Url_FollowUrl_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Url_ListUrls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUrlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).ListUrls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/ListUrls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).ListUrls(ctx, req.(*ListUrlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_CreateLandingPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LandingPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).CreateLandingPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/CreateLandingPage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).CreateLandingPage(ctx, req.(*LandingPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_FollowLandingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LandingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).FollowLandingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/FollowLandingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).FollowLandingItem(ctx, req.(*LandingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_UpdateUrlPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).UpdateUrlPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/UpdateUrlPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).UpdateUrlPreview(ctx, req.(*UrlPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_GetUrlInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).GetUrlInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/GetUrlInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).GetUrlInfo(ctx, req.(*UrlInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_GetUrlInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).GetUrlInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/GetUrlInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).GetUrlInfos(ctx, req.(*UrlInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_GetUrlHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).GetUrlHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/GetUrlHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).GetUrlHealth(ctx, req.(*UrlHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_ExpandUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).ExpandUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/ExpandUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).ExpandUrl(ctx, req.(*UrlInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_GetUrlAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).GetUrlAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/GetUrlAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).GetUrlAuditLog(ctx, req.(*UrlAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_DeleteUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).DeleteUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/DeleteUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).DeleteUrl(ctx, req.(*UrlInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_RestoreUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrlInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).RestoreUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/RestoreUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).RestoreUrl(ctx, req.(*UrlInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Url_EraseUrlData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUrlDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlServer).EraseUrlData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/url.Url/EraseUrlData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlServer).EraseUrlData(ctx, req.(*EraseUrlDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Url_ServiceDesc is the grpc.ServiceDesc for Url service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +519,54 @@ var Url_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FollowUrl",
 			Handler:    _Url_FollowUrl_Handler,
 		},
+		{
+			MethodName: "ListUrls",
+			Handler:    _Url_ListUrls_Handler,
+		},
+		{
+			MethodName: "CreateLandingPage",
+			Handler:    _Url_CreateLandingPage_Handler,
+		},
+		{
+			MethodName: "FollowLandingItem",
+			Handler:    _Url_FollowLandingItem_Handler,
+		},
+		{
+			MethodName: "UpdateUrlPreview",
+			Handler:    _Url_UpdateUrlPreview_Handler,
+		},
+		{
+			MethodName: "GetUrlInfo",
+			Handler:    _Url_GetUrlInfo_Handler,
+		},
+		{
+			MethodName: "GetUrlInfos",
+			Handler:    _Url_GetUrlInfos_Handler,
+		},
+		{
+			MethodName: "GetUrlHealth",
+			Handler:    _Url_GetUrlHealth_Handler,
+		},
+		{
+			MethodName: "ExpandUrl",
+			Handler:    _Url_ExpandUrl_Handler,
+		},
+		{
+			MethodName: "GetUrlAuditLog",
+			Handler:    _Url_GetUrlAuditLog_Handler,
+		},
+		{
+			MethodName: "DeleteUrl",
+			Handler:    _Url_DeleteUrl_Handler,
+		},
+		{
+			MethodName: "RestoreUrl",
+			Handler:    _Url_RestoreUrl_Handler,
+		},
+		{
+			MethodName: "EraseUrlData",
+			Handler:    _Url_EraseUrlData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "url.proto",
@@ -0,0 +1,57 @@
+package shortener
+
+import "strings"
+
+const (
+	base36Alphabet = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+	// crockford32Alphabet drops i, l, o and u, so codes can't be misread
+	crockford32Alphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+)
+
+type caseInsensitiveUrlShortener struct {
+	alphabet string
+	replacer *strings.Replacer
+}
+
+// NewBase36UrlShortener generates lowercase base36 codes and resolves them ignoring case
+func NewBase36UrlShortener() URLShortener {
+	return &caseInsensitiveUrlShortener{
+		alphabet: base36Alphabet,
+	}
+}
+
+// NewCrockford32UrlShortener generates lowercase Crockford base32 codes.
+// Codes are resolved ignoring case, o is read as 0 and i, l are read as 1.
+func NewCrockford32UrlShortener() URLShortener {
+	return &caseInsensitiveUrlShortener{
+		alphabet: crockford32Alphabet,
+		replacer: strings.NewReplacer("o", "0", "i", "1", "l", "1"),
+	}
+}
+
+func (s *caseInsensitiveUrlShortener) ShortenURL(params ShortenParams) (string, error) {
+	id := params.ID
+	base := uint32(len(s.alphabet))
+
+	var sb strings.Builder
+	for id > 0 {
+		sb.WriteByte(s.alphabet[id%base])
+		id /= base
+	}
+
+	return sb.String(), nil
+}
+
+func (s *caseInsensitiveUrlShortener) Deterministic() bool {
+	return false
+}
+
+func (s *caseInsensitiveUrlShortener) NormalizeCode(code string) string {
+	code = strings.ToLower(code)
+	if s.replacer != nil {
+		code = s.replacer.Replace(code)
+	}
+
+	return code
+}
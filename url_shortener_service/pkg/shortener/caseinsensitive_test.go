@@ -0,0 +1,57 @@
+package shortener
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase36UrlShortener(t *testing.T) {
+	base36UrlShortener := NewBase36UrlShortener()
+
+	t.Run("generates lowercase codes", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			code := mustShorten(t, base36UrlShortener, ShortenParams{ID: uuid.New().ID()})
+			assert.Equal(t, strings.ToLower(code), code)
+		}
+	})
+
+	t.Run("normalizes case", func(t *testing.T) {
+		code := mustShorten(t, base36UrlShortener, ShortenParams{ID: uuid.New().ID()})
+		assert.Equal(t, code, base36UrlShortener.NormalizeCode(strings.ToUpper(code)))
+	})
+}
+
+func TestCrockford32UrlShortener(t *testing.T) {
+	crockford32UrlShortener := NewCrockford32UrlShortener()
+
+	t.Run("generates codes without ambiguous characters", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			code := mustShorten(t, crockford32UrlShortener, ShortenParams{ID: uuid.New().ID()})
+			assert.NotContains(t, code, "i")
+			assert.NotContains(t, code, "l")
+			assert.NotContains(t, code, "o")
+			assert.NotContains(t, code, "u")
+			assert.Equal(t, code, crockford32UrlShortener.NormalizeCode(code))
+		}
+	})
+
+	testCases := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{name: "lowercase code", code: "ab01z", expected: "ab01z"},
+		{name: "uppercase code", code: "AB01Z", expected: "ab01z"},
+		{name: "o is read as zero", code: "abOoz", expected: "ab00z"},
+		{name: "i and l are read as one", code: "aIlLz", expected: "a111z"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, crockford32UrlShortener.NormalizeCode(tc.code))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package shortener
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	maxFilterAttempts = 32
+
+	// rehashMultiplier is odd, so multiplying by it permutes uint32 values.
+	rehashMultiplier = 2654435761
+)
+
+// ErrNoAllowedCode is returned when every regenerated code was rejected by the filter
+var ErrNoAllowedCode = errors.New("no allowed short code within the filter attempts")
+
+//go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name CodeFilter
+type CodeFilter interface {
+	IsAllowed(code string) bool
+}
+
+type wordListCodeFilter struct {
+	normalizer    CodeNormalizer
+	blockedWords  []string
+	reservedCodes map[string]struct{}
+}
+
+// NewWordListCodeFilter rejects codes that contain any of blockedWords
+// or are equal to one of reservedCodes. Matching ignores case and compares
+// the forms normalizer resolves words and codes to, so a variant that resolves
+// to a blocked code is blocked too.
+func NewWordListCodeFilter(blockedWords []string, reservedCodes []string, normalizer CodeNormalizer) CodeFilter {
+	f := &wordListCodeFilter{
+		normalizer:    normalizer,
+		blockedWords:  make([]string, 0, len(blockedWords)),
+		reservedCodes: make(map[string]struct{}, len(reservedCodes)),
+	}
+
+	for _, word := range blockedWords {
+		word = f.normalize(strings.TrimSpace(word))
+		if word != "" {
+			f.blockedWords = append(f.blockedWords, word)
+		}
+	}
+
+	for _, code := range reservedCodes {
+		code = f.normalize(strings.TrimSpace(code))
+		if code != "" {
+			f.reservedCodes[code] = struct{}{}
+		}
+	}
+
+	return f
+}
+
+func (f *wordListCodeFilter) normalize(code string) string {
+	return strings.ToLower(f.normalizer.NormalizeCode(code))
+}
+
+func (f *wordListCodeFilter) IsAllowed(code string) bool {
+	code = f.normalize(code)
+	if _, ok := f.reservedCodes[code]; ok {
+		return false
+	}
+
+	for _, word := range f.blockedWords {
+		if strings.Contains(code, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type filteredUrlShortener struct {
+	next   URLShortener
+	filter CodeFilter
+}
+
+// NewFilteredUrlShortener wraps next and regenerates codes rejected by filter
+func NewFilteredUrlShortener(next URLShortener, filter CodeFilter) URLShortener {
+	return &filteredUrlShortener{
+		next:   next,
+		filter: filter,
+	}
+}
+
+func (s *filteredUrlShortener) ShortenURL(params ShortenParams) (string, error) {
+	for i := 0; i <= maxFilterAttempts; i++ {
+		code, err := s.next.ShortenURL(params)
+		if err != nil {
+			return "", err
+		}
+		if s.filter.IsAllowed(code) {
+			return code, nil
+		}
+
+		// Random shorteners depend on the id, deterministic ones on the attempt
+		params.ID = params.ID*rehashMultiplier + 1
+		params.Attempt++
+	}
+
+	return "", ErrNoAllowedCode
+}
+
+func (s *filteredUrlShortener) Deterministic() bool {
+	return s.next.Deterministic()
+}
+
+func (s *filteredUrlShortener) NormalizeCode(code string) string {
+	return s.next.NormalizeCode(code)
+}
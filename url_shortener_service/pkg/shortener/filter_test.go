@@ -0,0 +1,101 @@
+package shortener
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordListCodeFilter(t *testing.T) {
+	filter := NewWordListCodeFilter([]string{"bad", " Ugly "}, []string{"api", "docs"}, NewBase62UrlShortener())
+
+	testCases := []struct {
+		name      string
+		code      string
+		isAllowed bool
+	}{
+		{name: "regular code", code: "aZ3kQ", isAllowed: true},
+		{name: "reserved code", code: "api", isAllowed: false},
+		{name: "reserved code in other case", code: "DoCs", isAllowed: false},
+		{name: "reserved code as part of code", code: "apis", isAllowed: true},
+		{name: "blocked word", code: "bad", isAllowed: false},
+		{name: "blocked word inside code", code: "x1BaDz", isAllowed: false},
+		{name: "blocked word with spaces in config", code: "UGLY1", isAllowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isAllowed, filter.IsAllowed(tc.code))
+		})
+	}
+}
+
+func TestWordListCodeFilterNormalizesCodes(t *testing.T) {
+	filter := NewWordListCodeFilter([]string{"fool"}, []string{"api"}, NewCrockford32UrlShortener())
+
+	testCases := []struct {
+		name      string
+		code      string
+		isAllowed bool
+	}{
+		{name: "regular code", code: "ab01z", isAllowed: true},
+		{name: "reserved code", code: "api", isAllowed: false},
+		{name: "reserved code read as its stored form", code: "AP1", isAllowed: false},
+		{name: "reserved code with look-alike letters", code: "apL", isAllowed: false},
+		{name: "blocked word with zeros for o", code: "xF00L", isAllowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.isAllowed, filter.IsAllowed(tc.code))
+		})
+	}
+}
+
+func TestFilteredUrlShortener(t *testing.T) {
+	base62UrlShortener := NewBase62UrlShortener()
+
+	t.Run("keeps allowed codes", func(t *testing.T) {
+		filter := NewWordListCodeFilter(nil, nil, base62UrlShortener)
+		urlShortener := NewFilteredUrlShortener(base62UrlShortener, filter)
+
+		assert.Equal(t, mustShorten(t, base62UrlShortener, ShortenParams{ID: 12345}), mustShorten(t, urlShortener, ShortenParams{ID: 12345}))
+	})
+
+	t.Run("regenerates blocked codes", func(t *testing.T) {
+		var id uint32 = 12345
+		blocked := mustShorten(t, base62UrlShortener, ShortenParams{ID: id})
+
+		filter := NewWordListCodeFilter(nil, []string{blocked}, base62UrlShortener)
+		urlShortener := NewFilteredUrlShortener(base62UrlShortener, filter)
+
+		code := mustShorten(t, urlShortener, ShortenParams{ID: id})
+		assert.NotEqual(t, blocked, code)
+		assert.True(t, filter.IsAllowed(code))
+		assert.Equal(t, code, mustShorten(t, urlShortener, ShortenParams{ID: id}))
+	})
+	t.Run("regenerates blocked hash codes", func(t *testing.T) {
+		hashUrlShortener := NewHashUrlShortener()
+		testLongURL := "https://example.com/p/1181"
+		blocked := mustShorten(t, hashUrlShortener, ShortenParams{LongURL: testLongURL})
+
+		// The word is inside the first code, every code extending it would keep it
+		filter := NewWordListCodeFilter([]string{blocked[2:5]}, nil, hashUrlShortener)
+		urlShortener := NewFilteredUrlShortener(hashUrlShortener, filter)
+
+		code := mustShorten(t, urlShortener, ShortenParams{LongURL: testLongURL})
+		assert.NotEqual(t, blocked, code)
+		assert.Len(t, code, defaultHashCodeLen)
+		assert.True(t, filter.IsAllowed(code))
+		assert.Equal(t, code, mustShorten(t, urlShortener, ShortenParams{LongURL: testLongURL}))
+	})
+	t.Run("fails when every code is blocked", func(t *testing.T) {
+		filter := NewWordListCodeFilter(strings.Split("abcdefghijklmnopqrstuvwxyz0123456789", ""), nil, base62UrlShortener)
+		urlShortener := NewFilteredUrlShortener(base62UrlShortener, filter)
+
+		code, err := urlShortener.ShortenURL(ShortenParams{ID: 12345})
+		assert.ErrorIs(t, err, ErrNoAllowedCode)
+		assert.Empty(t, code)
+	})
+}
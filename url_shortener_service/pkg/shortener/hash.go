@@ -0,0 +1,94 @@
+package shortener
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultHashCodeLen is the length of every attempt, url_data.short_url holds 10 characters
+	defaultHashCodeLen = 7
+)
+
+var (
+	defaultPorts = map[string]string{
+		"http":  "80",
+		"https": "443",
+	}
+)
+
+type hashUrlShortener struct {
+	codeLen int
+}
+
+// NewHashUrlShortener derives codes from the SHA-256 of the canonical long url,
+// so the same url yields the same code on every instance. Each taken or filtered
+// attempt hashes the url with the attempt number, so the retry is a new code of the same length.
+func NewHashUrlShortener() URLShortener {
+	return &hashUrlShortener{
+		codeLen: defaultHashCodeLen,
+	}
+}
+
+func (s *hashUrlShortener) ShortenURL(params ShortenParams) (string, error) {
+	return HashCode(params.LongURL, s.codeLen, params.Attempt), nil
+}
+
+func (s *hashUrlShortener) Deterministic() bool {
+	return true
+}
+
+func (s *hashUrlShortener) NormalizeCode(code string) string {
+	return code
+}
+
+// HashCode returns the first codeLen characters of the base62 encoded SHA-256
+// of the canonical longURL, followed by the attempt number from the first retry on.
+// It can be used to compute codes offline.
+func HashCode(longURL string, codeLen int, attempt int) string {
+	input := CanonicalURL(longURL)
+	if attempt > 0 {
+		input += "#" + strconv.Itoa(attempt)
+	}
+	sum := sha256.Sum256([]byte(input))
+
+	num := new(big.Int).SetBytes(sum[:])
+	base := big.NewInt(int64(alphabetLen))
+	rem := new(big.Int)
+
+	var sb strings.Builder
+	for sb.Len() < codeLen && num.Sign() > 0 {
+		num.DivMod(num, base, rem)
+		sb.WriteByte(alphabet[rem.Int64()])
+	}
+
+	return sb.String()
+}
+
+// CanonicalURL lowercases scheme and host, drops the default port
+// and replaces an empty path with "/". Urls that can't be parsed are returned as is.
+func CanonicalURL(longURL string) string {
+	u, err := url.Parse(strings.TrimSpace(longURL))
+	if err != nil || u.Host == "" {
+		return longURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" && port != defaultPorts[u.Scheme] {
+		host = host + ":" + port
+	}
+	u.Host = host
+
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	return u.String()
+}
@@ -0,0 +1,64 @@
+package shortener
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashUrlShortener(t *testing.T) {
+	hashUrlShortener := NewHashUrlShortener()
+	testLongURL := "https://example.com/some/path?q=1"
+
+	t.Run("same url yields same code", func(t *testing.T) {
+		expectedCode := mustShorten(t, hashUrlShortener, ShortenParams{ID: 1, LongURL: testLongURL})
+		assert.Len(t, expectedCode, defaultHashCodeLen)
+
+		code := mustShorten(t, NewHashUrlShortener(), ShortenParams{ID: 2, LongURL: testLongURL})
+		assert.Equal(t, expectedCode, code)
+	})
+
+	t.Run("collision yields a new code of the same length", func(t *testing.T) {
+		code := mustShorten(t, hashUrlShortener, ShortenParams{LongURL: testLongURL})
+
+		for attempt := 1; attempt <= maxFilterAttempts; attempt++ {
+			nextCode := mustShorten(t, hashUrlShortener, ShortenParams{LongURL: testLongURL, Attempt: attempt})
+
+			assert.Len(t, nextCode, defaultHashCodeLen)
+			assert.False(t, strings.HasPrefix(nextCode, code[:3]), "attempt %d reuses the digest", attempt)
+		}
+	})
+
+	t.Run("equivalent urls yield same code", func(t *testing.T) {
+		code := mustShorten(t, hashUrlShortener, ShortenParams{LongURL: "https://example.com"})
+		assert.Equal(t, code, mustShorten(t, hashUrlShortener, ShortenParams{LongURL: "HTTPS://Example.COM:443/"}))
+	})
+
+	t.Run("different urls yield different codes", func(t *testing.T) {
+		code := mustShorten(t, hashUrlShortener, ShortenParams{LongURL: "https://example.com/a"})
+		assert.NotEqual(t, code, mustShorten(t, hashUrlShortener, ShortenParams{LongURL: "https://example.com/b"}))
+	})
+}
+
+func TestCanonicalURL(t *testing.T) {
+	testCases := []struct {
+		name     string
+		longURL  string
+		expected string
+	}{
+		{name: "canonical url", longURL: "https://example.com/path", expected: "https://example.com/path"},
+		{name: "uppercase scheme and host", longURL: "HTTP://EXAMPLE.com/Path", expected: "http://example.com/Path"},
+		{name: "default port", longURL: "http://example.com:80/path", expected: "http://example.com/path"},
+		{name: "custom port", longURL: "https://example.com:8443/path", expected: "https://example.com:8443/path"},
+		{name: "empty path", longURL: "https://example.com?q=1", expected: "https://example.com/?q=1"},
+		{name: "ipv6 host", longURL: "http://[::1]:80/path", expected: "http://[::1]/path"},
+		{name: "not a url", longURL: "not a url", expected: "not a url"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CanonicalURL(tc.longURL))
+		})
+	}
+}
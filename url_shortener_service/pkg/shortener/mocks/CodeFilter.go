@@ -0,0 +1,42 @@
+// Code generated by mockery v2.42.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// CodeFilter is an autogenerated mock type for the CodeFilter type
+type CodeFilter struct {
+	mock.Mock
+}
+
+// IsAllowed provides a mock function with given fields: code
+func (_m *CodeFilter) IsAllowed(code string) bool {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAllowed")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NewCodeFilter creates a new instance of CodeFilter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCodeFilter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CodeFilter {
+	mock := &CodeFilter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,24 +2,46 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	shortener "CoolUrlShortener/pkg/shortener"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // URLShortener is an autogenerated mock type for the URLShortener type
 type URLShortener struct {
 	mock.Mock
 }
 
-// ShortenURL provides a mock function with given fields: id
-func (_m *URLShortener) ShortenURL(id uint32) string {
-	ret := _m.Called(id)
+// Deterministic provides a mock function with given fields:
+func (_m *URLShortener) Deterministic() bool {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ShortenURL")
+		panic("no return value specified for Deterministic")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NormalizeCode provides a mock function with given fields: code
+func (_m *URLShortener) NormalizeCode(code string) string {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizeCode")
 	}
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(uint32) string); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(code)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
@@ -27,6 +49,34 @@ func (_m *URLShortener) ShortenURL(id uint32) string {
 	return r0
 }
 
+// ShortenURL provides a mock function with given fields: params
+func (_m *URLShortener) ShortenURL(params shortener.ShortenParams) (string, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShortenURL")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(shortener.ShortenParams) (string, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(shortener.ShortenParams) string); ok {
+		r0 = rf(params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(shortener.ShortenParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewURLShortener creates a new instance of URLShortener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewURLShortener(t interface {
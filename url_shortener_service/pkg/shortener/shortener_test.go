@@ -12,10 +12,10 @@ func TestBase62UrlShortener(t *testing.T) {
 	t.Run("is idempotent", func(t *testing.T) {
 
 		id := uuid.New().ID()
-		expectedLongURL := base62UrlShortener.ShortenURL(id)
+		expectedLongURL := mustShorten(t, base62UrlShortener, ShortenParams{ID: id})
 
 		for i := 0; i < 1000; i++ {
-			longURL := base62UrlShortener.ShortenURL(id)
+			longURL := mustShorten(t, base62UrlShortener, ShortenParams{ID: id})
 			assert.Equal(t, expectedLongURL, longURL)
 		}
 	})
@@ -26,6 +26,15 @@ func BenchmarkNewBase62UrlShortener(b *testing.B) {
 	id := uuid.New().ID()
 
 	for n := 0; n < b.N; n++ {
-		_ = base62UrlShortener.ShortenURL(id)
+		_ = mustShorten(b, base62UrlShortener, ShortenParams{ID: id})
 	}
 }
+
+func mustShorten(tb testing.TB, urlShortener URLShortener, params ShortenParams) string {
+	tb.Helper()
+
+	code, err := urlShortener.ShortenURL(params)
+	assert.NoError(tb, err)
+
+	return code
+}
@@ -10,9 +10,27 @@ var (
 	alphabetLen = len(alphabet)
 )
 
+// CodeNormalizer is implemented by every URLShortener, storages use it
+// to resolve codes the same way they were generated
+type CodeNormalizer interface {
+	NormalizeCode(code string) string
+}
+
+// ShortenParams are the inputs a URLShortener may derive a code from
+type ShortenParams struct {
+	ID      uint32
+	LongURL string
+	// Attempt counts codes for this url that were already taken
+	Attempt int
+}
+
 //go:generate go run github.com/vektra/mockery/v2@v2.42.1 --name URLShortener
 type URLShortener interface {
-	ShortenURL(id uint32) string
+	ShortenURL(params ShortenParams) (string, error)
+	// Deterministic reports whether codes depend only on LongURL and Attempt
+	Deterministic() bool
+	// NormalizeCode maps a code typed by a user to the form it was stored in
+	NormalizeCode(code string) string
 }
 
 type base62UrlShortener struct {
@@ -22,7 +40,8 @@ func NewBase62UrlShortener() URLShortener {
 	return &base62UrlShortener{}
 }
 
-func (s *base62UrlShortener) ShortenURL(id uint32) string {
+func (s *base62UrlShortener) ShortenURL(params ShortenParams) (string, error) {
+	id := params.ID
 	nums := make([]int, 0)
 	for id > 0 {
 		rem := int(id) % alphabetLen
@@ -37,5 +56,13 @@ func (s *base62UrlShortener) ShortenURL(id uint32) string {
 		sb.WriteByte(alphabet[idx])
 	}
 
-	return sb.String()
+	return sb.String(), nil
+}
+
+func (s *base62UrlShortener) Deterministic() bool {
+	return false
+}
+
+func (s *base62UrlShortener) NormalizeCode(code string) string {
+	return code
 }